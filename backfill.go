@@ -0,0 +1,395 @@
+package twelvedata
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/soulgarden/twelvedata/dictionary"
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+// maxTimeSeriesPoints is the largest OutputSize the time series endpoint accepts.
+const maxTimeSeriesPoints = 5000
+
+const (
+	dateLayout     = "2006-01-02"
+	dateTimeLayout = "2006-01-02 15:04:05"
+)
+
+// BackfillWindow is a downloaded window of a backfill.
+type BackfillWindow struct {
+	Key    string                     `json:"key"` // identifies the backfilled series
+	Start  string                     `json:"start"`
+	End    string                     `json:"end"`
+	Meta   response.TimeSeriesMeta    `json:"meta"`
+	Values []response.TimeSeriesValue `json:"values"`
+}
+
+// BackfillCheckpoint stores the windows of a backfill so an interrupted backfill can resume.
+type BackfillCheckpoint interface {
+	// Windows returns the saved windows in the order they were saved.
+	Windows() ([]BackfillWindow, error)
+	// Save records a downloaded window.
+	Save(window BackfillWindow) error
+}
+
+// FileCheckpoint is a BackfillCheckpoint appending every window as a JSON line to a file.
+type FileCheckpoint struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileCheckpoint creates a checkpoint stored in the file at path, created on the first save.
+func NewFileCheckpoint(path string) *FileCheckpoint {
+	return &FileCheckpoint{path: path}
+}
+
+// Windows returns the saved windows. A truncated last line, left by an interrupted save, is ignored.
+func (c *FileCheckpoint) Windows() ([]BackfillWindow, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	file, err := os.Open(c.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	var windows []BackfillWindow
+
+	reader := bufio.NewReader(file)
+
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+
+		if len(bytes.TrimSpace(line)) > 0 {
+			var window BackfillWindow
+			if jsonErr := json.Unmarshal(line, &window); jsonErr != nil {
+				if errors.Is(err, io.EOF) {
+					return windows, nil
+				}
+
+				return nil, fmt.Errorf("checkpoint %s: %w", c.path, jsonErr)
+			}
+
+			windows = append(windows, window)
+		}
+
+		if errors.Is(err, io.EOF) {
+			return windows, nil
+		}
+	}
+}
+
+// Save appends window to the file.
+func (c *FileCheckpoint) Save(window BackfillWindow) error {
+	line, err := json.Marshal(window)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	file, err := os.OpenFile(c.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(append(line, '\n'))
+
+	return errors.Join(err, file.Close())
+}
+
+// BackfillOption configures BackfillTimeSeries.
+type BackfillOption func(*backfillConfig)
+
+type backfillConfig struct {
+	points     int
+	checkpoint BackfillCheckpoint
+	now        func() time.Time
+}
+
+// WithBackfillWindow sets the number of bars one request covers, between 2 and 5000, 5000 by default.
+func WithBackfillWindow(points int) BackfillOption {
+	return func(c *backfillConfig) {
+		c.points = min(max(points, 2), maxTimeSeriesPoints)
+	}
+}
+
+// WithBackfillCheckpoint saves every downloaded window to checkpoint and resumes at its last window.
+func WithBackfillCheckpoint(checkpoint BackfillCheckpoint) BackfillOption {
+	return func(c *backfillConfig) {
+		c.checkpoint = checkpoint
+	}
+}
+
+// BackfillTimeSeries downloads the time series of req between StartDate and EndDate in windows of as many bars
+// as the 5000 points limit of a single call allows. Every window starts at the last bar returned by the previous
+// one, so gaps such as nights, weekends and holidays cost no extra calls. Without a StartDate the series starts
+// at GetEarliestTimestamp, without an EndDate it ends a day from now, so the latest bars are included whatever
+// the exchange timezone. Windows share their boundary bar, bars are deduplicated by datetime and ordered
+// ascending, or descending when req.Order is "desc". The returned credits sum the credits used by every call.
+// With a checkpoint, windows saved by an earlier run are reused and downloading resumes at the start of
+// the last saved window, which may have been incomplete, so an interrupted backfill or a later update
+// only fetches the rest.
+func (cli client) BackfillTimeSeries(
+	ctx context.Context,
+	req request.GetTimeSeries,
+	opts ...BackfillOption,
+) (response.TimeSeries, response.Credits, error) {
+	cfg := backfillConfig{points: maxTimeSeriesPoints, now: time.Now}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	creds := &response.CreditsImpl{}
+
+	step, err := intervalDuration(req.Interval)
+	if err != nil {
+		return response.TimeSeries{}, creds, err
+	}
+
+	layout := dateTimeLayout
+	if step >= 24*time.Hour {
+		layout = dateLayout
+	}
+
+	key := backfillKey(req)
+
+	var windows []BackfillWindow
+
+	if cfg.checkpoint != nil {
+		if windows, err = cfg.checkpoint.Windows(); err != nil {
+			return response.TimeSeries{}, creds, fmt.Errorf("load checkpoint: %w", err)
+		}
+
+		for _, window := range windows {
+			if window.Key != key {
+				return response.TimeSeries{}, creds, fmt.Errorf("checkpoint is for %q, not %q", window.Key, key)
+			}
+		}
+	}
+
+	end := cfg.now().Add(24 * time.Hour)
+	if req.EndDate != "" {
+		if end, err = parseDateTime(req.EndDate); err != nil {
+			return response.TimeSeries{}, creds, fmt.Errorf("end date: %w", err)
+		}
+	}
+
+	var start time.Time
+
+	switch {
+	case len(windows) > 0:
+		start, err = parseDateTime(windows[len(windows)-1].Start)
+	case req.StartDate != "":
+		start, err = parseDateTime(req.StartDate)
+	default:
+		start, err = cli.earliestTimestamp(ctx, req, creds)
+	}
+
+	if err != nil {
+		return response.TimeSeries{}, creds, fmt.Errorf("start date: %w", err)
+	}
+
+	for windowStart := start; windowStart.Before(end); {
+		window, err := cli.backfillWindow(ctx, req, windowStart.Format(layout), end.Format(layout), cfg.points, creds)
+		if err != nil {
+			return mergeWindows(windows, req.Order), creds, err
+		}
+
+		window.Key = key
+		windows = append(windows, window)
+
+		if cfg.checkpoint != nil {
+			if err := cfg.checkpoint.Save(window); err != nil {
+				return mergeWindows(windows, req.Order), creds, fmt.Errorf("save checkpoint: %w", err)
+			}
+		}
+
+		last, ok := lastBar(window.Values)
+		if len(window.Values) < cfg.points || !ok || !last.After(windowStart) {
+			break
+		}
+
+		windowStart = last
+	}
+
+	return mergeWindows(windows, req.Order), creds, nil
+}
+
+// earliestTimestamp returns the datetime of the first bar available for the series of req.
+func (cli client) earliestTimestamp(ctx context.Context, req request.GetTimeSeries, creds *response.CreditsImpl) (time.Time, error) {
+	earliest, earliestCreds, err := cli.GetEarliestTimestampCtx(ctx, request.GetEarliestTimestamp{
+		APIKey:   req.APIKey,
+		Symbol:   req.Symbol,
+		Figi:     req.FIGI,
+		Isin:     req.ISIN,
+		Cusip:    req.CUSIP,
+		Interval: req.Interval,
+		Exchange: req.Exchange,
+		MicCode:  req.MicCode,
+		Timezone: req.TimeZone,
+	})
+	addCredits(creds, earliestCreds)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return parseDateTime(earliest.Datetime)
+}
+
+// backfillWindow downloads the first points bars between start and end. A window without data is empty.
+func (cli client) backfillWindow(
+	ctx context.Context,
+	req request.GetTimeSeries,
+	start, end string,
+	points int,
+	creds *response.CreditsImpl,
+) (BackfillWindow, error) {
+	req.StartDate, req.EndDate, req.OutputSize, req.Order, req.Date = start, end, points, request.OrderAsc, ""
+
+	series, windowCreds, err := cli.GetTimeSeriesCtx(ctx, req)
+	addCredits(creds, windowCreds)
+
+	if err != nil && !strings.Contains(strings.ToLower(err.Error()), dictionary.NoDataAvailableMsg) {
+		return BackfillWindow{}, err
+	}
+
+	return BackfillWindow{Start: start, End: end, Meta: series.Meta, Values: series.Values}, nil
+}
+
+// lastBar returns the datetime of the latest bar of values.
+func lastBar(values []response.TimeSeriesValue) (time.Time, bool) {
+	var last time.Time
+
+	for _, value := range values {
+		if bar, err := parseDateTime(value.Datetime); err == nil && bar.After(last) {
+			last = bar
+		}
+	}
+
+	return last, !last.IsZero()
+}
+
+// mergeWindows returns the bars of windows deduplicated by datetime and ordered by order.
+// A bar downloaded again by a later window replaces the earlier one.
+func mergeWindows(windows []BackfillWindow, order request.Order) response.TimeSeries {
+	var series response.TimeSeries
+
+	index := map[string]int{}
+
+	for _, window := range windows {
+		if series.Meta.Symbol == "" {
+			series.Meta = window.Meta
+		}
+
+		for _, value := range window.Values {
+			if i, ok := index[value.Datetime]; ok {
+				series.Values[i] = value
+
+				continue
+			}
+
+			index[value.Datetime] = len(series.Values)
+			series.Values = append(series.Values, value)
+		}
+	}
+
+	slices.SortFunc(series.Values, func(a, b response.TimeSeriesValue) int {
+		return strings.Compare(a.Datetime, b.Datetime)
+	})
+
+	if strings.EqualFold(string(order), string(request.OrderDesc)) {
+		slices.Reverse(series.Values)
+	}
+
+	if len(series.Values) > 0 {
+		series.Status = "ok"
+	}
+
+	return series
+}
+
+func addCredits(total *response.CreditsImpl, creds response.Credits) {
+	if creds == nil {
+		return
+	}
+
+	total.CreditsUsed += creds.GetCreditsUsed()
+	total.CreditsLeft = creds.GetCreditsLeft()
+}
+
+// backfillKey identifies the series of req in a checkpoint, whatever its dates and API key.
+func backfillKey(req request.GetTimeSeries) string {
+	req.APIKey, req.StartDate, req.EndDate, req.Date, req.OutputSize, req.Order = request.APIKey{}, "", "", "", 0, ""
+
+	values, err := buildQueryParams(req)
+	if err != nil {
+		return req.Symbol + " " + string(req.Interval)
+	}
+
+	return values.Encode()
+}
+
+// intervalDuration returns the length of an interval such as "5min", "1h", "1day", "1week" or "1month",
+// ignoring case. A month counts as 31 days so that windows never hold more bars than planned.
+func intervalDuration(interval request.Interval) (time.Duration, error) {
+	interval, err := request.ParseInterval(string(interval))
+	if err != nil {
+		return 0, err
+	}
+
+	unit := strings.TrimLeft(string(interval), "0123456789")
+
+	count, err := strconv.Atoi(strings.TrimSuffix(string(interval), unit))
+	if err != nil || count <= 0 {
+		return 0, fmt.Errorf("unsupported interval %q", interval)
+	}
+
+	units := map[string]time.Duration{
+		"min":   time.Minute,
+		"h":     time.Hour,
+		"day":   24 * time.Hour,
+		"week":  7 * 24 * time.Hour,
+		"month": 31 * 24 * time.Hour,
+	}
+
+	step, ok := units[unit]
+	if !ok {
+		return 0, fmt.Errorf("unsupported interval %q", interval)
+	}
+
+	return time.Duration(count) * step, nil
+}
+
+// parseDateTime parses a date or datetime as used by the API.
+func parseDateTime(value string) (time.Time, error) {
+	if t, err := time.Parse(dateTimeLayout, value); err == nil {
+		return t, nil
+	}
+
+	return time.Parse(dateLayout, value)
+}
@@ -0,0 +1,247 @@
+package twelvedata
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/soulgarden/twelvedata/request"
+)
+
+// backfillServer serves the first outputsize one minute bars from the start_date of every time series call,
+// up to its end_date, and records the start dates requested.
+type backfillServer struct {
+	mu        sync.Mutex
+	windows   []string
+	earliest  int
+	failAfter int       // time series calls answered before failing with 500, unlimited when zero
+	gapFrom   time.Time // bars strictly between gapFrom and gapTo are missing
+	gapTo     time.Time
+}
+
+func (s *backfillServer) start(t *testing.T) string {
+	t.Helper()
+
+	return newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Api-credits-left", "100")
+		w.Header().Set("Api-credits-used", "1")
+
+		if r.URL.Path == "/earliest_timestamp" {
+			s.mu.Lock()
+			s.earliest++
+			s.mu.Unlock()
+
+			writeJSON(t, w, map[string]any{"datetime": "2024-01-01 00:00:00", "unix_time": 1704067200})
+
+			return
+		}
+
+		query := r.URL.Query()
+
+		s.mu.Lock()
+		s.windows = append(s.windows, query.Get("start_date"))
+		calls := len(s.windows)
+		s.mu.Unlock()
+
+		if s.failAfter > 0 && calls > s.failAfter {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		if query.Get("order") != "asc" || query.Get("end_date") != backfillRequest.EndDate {
+			t.Errorf("expected ascending bars up to the end date, got %s", r.URL.RawQuery)
+		}
+
+		start, _ := time.Parse(dateTimeLayout, query.Get("start_date"))
+		end, _ := time.Parse(dateTimeLayout, query.Get("end_date"))
+		outputSize, _ := strconv.Atoi(query.Get("outputsize"))
+
+		var values []map[string]any
+		for bar := start; !bar.After(end) && len(values) < outputSize; bar = bar.Add(time.Minute) {
+			if bar.After(s.gapFrom) && bar.Before(s.gapTo) {
+				continue
+			}
+
+			values = append(values, map[string]any{"datetime": bar.Format(dateTimeLayout), "close": "1"})
+		}
+
+		if len(values) == 0 {
+			writeJSON(t, w, map[string]any{
+				"code": 400, "status": "error",
+				"message": "No data is available on the specified dates. Try setting different start/end dates.",
+			})
+
+			return
+		}
+
+		writeJSON(t, w, map[string]any{"meta": map[string]any{"symbol": "BTC/USD", "interval": "1min"}, "values": values, "status": "ok"})
+	})
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, body any) {
+	t.Helper()
+
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		t.Error(err)
+	}
+}
+
+var backfillConf = Conf{
+	CoreData:      CoreData{TimeSeriesURL: "/time_series"},
+	ReferenceData: ReferenceData{EarliestTimestampURL: "/earliest_timestamp"},
+}
+
+var backfillRequest = request.GetTimeSeries{Symbol: "BTC/USD", Interval: "1min", EndDate: "2024-01-01 00:30:00"}
+
+func TestBackfillTimeSeries(t *testing.T) {
+	server := &backfillServer{}
+	cli := newTestClient(t, server.start(t), backfillConf)
+
+	series, creds, err := cli.BackfillTimeSeries(context.Background(), backfillRequest, WithBackfillWindow(10))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantWindows := []string{"2024-01-01 00:00:00", "2024-01-01 00:09:00", "2024-01-01 00:18:00", "2024-01-01 00:27:00"}
+
+	if len(server.windows) != len(wantWindows) {
+		t.Fatalf("expected windows %v, got %v", wantWindows, server.windows)
+	}
+
+	for i, want := range wantWindows {
+		if server.windows[i] != want {
+			t.Errorf("window %d = %q, want %q", i, server.windows[i], want)
+		}
+	}
+
+	if len(series.Values) != 31 || series.Values[0].Datetime != "2024-01-01 00:00:00" ||
+		series.Values[30].Datetime != "2024-01-01 00:30:00" || series.Meta.Symbol != "BTC/USD" {
+		t.Fatalf("expected 31 ascending bars, got %d: %+v", len(series.Values), series.Values)
+	}
+
+	if creds.GetCreditsUsed() != 5 || server.earliest != 1 {
+		t.Errorf("expected 5 credits with 1 earliest timestamp call, got %d, %d", creds.GetCreditsUsed(), server.earliest)
+	}
+}
+
+func TestBackfillTimeSeries_GapsAndOrder(t *testing.T) {
+	server := &backfillServer{
+		gapFrom: time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC),
+		gapTo:   time.Date(2024, 1, 1, 0, 25, 0, 0, time.UTC),
+	}
+	cli := newTestClient(t, server.start(t), backfillConf)
+
+	req := backfillRequest
+	req.StartDate, req.Order = "2024-01-01 00:00:00", "desc"
+
+	series, creds, err := cli.BackfillTimeSeries(context.Background(), req, WithBackfillWindow(10))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The first window skips the gap, the next one starts at its last bar.
+	if len(server.windows) != 2 || server.windows[1] != "2024-01-01 00:28:00" || creds.GetCreditsUsed() != 2 {
+		t.Errorf("expected 2 windows, the second at 00:28, got %v for %d credits", server.windows, creds.GetCreditsUsed())
+	}
+
+	// Bars 00:06 to 00:24 are missing.
+	if len(series.Values) != 12 || series.Values[0].Datetime != "2024-01-01 00:30:00" || server.earliest != 0 {
+		t.Errorf("expected 12 descending bars without an earliest timestamp call, got %d: %+v", len(series.Values), series.Values)
+	}
+}
+
+func TestBackfillTimeSeries_NoData(t *testing.T) {
+	server := &backfillServer{
+		gapFrom: time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+		gapTo:   time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	cli := newTestClient(t, server.start(t), backfillConf)
+
+	series, _, err := cli.BackfillTimeSeries(context.Background(), backfillRequest, WithBackfillWindow(10))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(series.Values) != 0 || len(server.windows) != 1 {
+		t.Errorf("expected no bars after 1 window, got %d after %v", len(series.Values), server.windows)
+	}
+}
+
+func TestBackfillTimeSeries_ResumeFromCheckpoint(t *testing.T) {
+	checkpoint := NewFileCheckpoint(filepath.Join(t.TempDir(), "btc.jsonl"))
+
+	failing := &backfillServer{failAfter: 2}
+
+	partial, _, err := newTestClient(t, failing.start(t), backfillConf).BackfillTimeSeries(context.Background(),
+		backfillRequest, WithBackfillWindow(10), WithBackfillCheckpoint(checkpoint))
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if len(partial.Values) != 19 {
+		t.Errorf("expected the 19 bars downloaded before the error, got %d", len(partial.Values))
+	}
+
+	saved, err := checkpoint.Windows()
+	if err != nil || len(saved) != 2 {
+		t.Fatalf("expected 2 saved windows, got %d, %v", len(saved), err)
+	}
+
+	server := &backfillServer{}
+
+	cli := newTestClient(t, server.start(t), backfillConf)
+
+	series, _, err := cli.BackfillTimeSeries(context.Background(), backfillRequest,
+		WithBackfillWindow(10), WithBackfillCheckpoint(checkpoint))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if server.earliest != 0 || len(server.windows) != 3 || server.windows[0] != "2024-01-01 00:09:00" {
+		t.Errorf("expected to resume at the last saved window, got %v", server.windows)
+	}
+
+	if len(series.Values) != 31 {
+		t.Errorf("expected 31 bars, got %d", len(series.Values))
+	}
+
+	other := backfillRequest
+	other.Symbol = "ETH/USD"
+
+	if _, _, err := cli.BackfillTimeSeries(context.Background(), other, WithBackfillCheckpoint(checkpoint)); err == nil {
+		t.Error("expected an error for a checkpoint of another series")
+	}
+}
+
+func TestIntervalDuration(t *testing.T) {
+	tests := []struct {
+		interval request.Interval
+		want     time.Duration
+		wantErr  bool
+	}{
+		{interval: "1min", want: time.Minute},
+		{interval: "45min", want: 45 * time.Minute},
+		{interval: "4h", want: 4 * time.Hour},
+		{interval: "1day", want: 24 * time.Hour},
+		{interval: "1week", want: 7 * 24 * time.Hour},
+		{interval: "1month", want: 31 * 24 * time.Hour},
+		{interval: "1H", want: time.Hour},
+		{interval: "1Day", want: 24 * time.Hour},
+		{interval: "", wantErr: true},
+		{interval: "day", wantErr: true},
+		{interval: "5sec", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := intervalDuration(tt.interval)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("intervalDuration(%q) = %v, %v, want %v, error %v", tt.interval, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
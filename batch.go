@@ -0,0 +1,267 @@
+package twelvedata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/soulgarden/twelvedata/dictionary"
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+// defaultBatchSize is the number of requests sent in one call to the batch endpoint when no size is set.
+const defaultBatchSize = 100
+
+var errBatchPending = errors.New("batch: item not sent, call Run first")
+
+// Batch bundles typed client calls into calls to the batch endpoint. Calls are added with AddToBatch,
+// sent with Run and read back from the returned BatchItem. Batches larger than the batch size are split
+// into several batch calls. A Batch is not safe for concurrent use.
+type Batch struct {
+	cli     ClientCtx
+	size    int
+	entries []batchEntry
+	sent    int // entries before this index have been sent
+}
+
+// BatchOption configures a Batch.
+type BatchOption func(*Batch)
+
+// WithBatchSize sets the maximum number of requests per batch call, 100 by default.
+func WithBatchSize(size int) BatchOption {
+	return func(b *Batch) {
+		if size > 0 {
+			b.size = size
+		}
+	}
+}
+
+// NewBatch creates an empty batch sent through cli.GetBatchesCtx.
+func NewBatch(cli ClientCtx, opts ...BatchOption) *Batch {
+	batch := &Batch{cli: cli, size: defaultBatchSize}
+
+	for _, opt := range opts {
+		opt(batch)
+	}
+
+	return batch
+}
+
+// BatchItem is the typed result of a call added to a Batch.
+type BatchItem[Response any] struct {
+	url      string
+	response Response
+	err      error
+}
+
+// URL returns the path and query of the item sent to the batch endpoint.
+func (item *BatchItem[Response]) URL() string {
+	return item.url
+}
+
+// Result returns the decoded response of the item, or its error. Failed items carry the same domain
+// errors as a direct call, e.g. a SymbolNotFoundError. Before Run the error is non-nil.
+func (item *BatchItem[Response]) Result() (Response, error) {
+	return item.response, item.err
+}
+
+// AddToBatch adds the call fn would make for req to b, e.g. AddToBatch(batch, cli.GetQuoteCtx, req).
+// The request is encoded exactly like a direct call; fn is not sent to the API.
+// A request that cannot be encoded or batched fails its item without being sent.
+func AddToBatch[Req any, Resp any](
+	b *Batch,
+	fn func(context.Context, Req) (Resp, response.Credits, error),
+	req Req,
+) *BatchItem[Resp] {
+	item := &BatchItem[Resp]{err: errBatchPending}
+
+	call := &batchCall{}
+
+	if _, _, err := fn(context.WithValue(context.Background(), batchCallKey{}, call), req); err != nil {
+		item.err = err
+
+		return item
+	}
+
+	if call.decode == nil {
+		item.err = errors.New("batch: fn is not a client call")
+
+		return item
+	}
+
+	item.url = call.url
+
+	b.entries = append(b.entries, batchEntry{
+		call: call,
+		settle: func(body []byte, err error) {
+			if err != nil {
+				item.err = err
+
+				return
+			}
+
+			resp, err := call.decode(body)
+			if err != nil {
+				item.err = err
+
+				return
+			}
+
+			item.response, item.err = resp.(Resp), nil //nolint:forcetypeassert // fn returns the endpoint response
+		},
+	})
+
+	return item
+}
+
+// Len returns the number of calls added to the batch and not sent yet.
+func (b *Batch) Len() int {
+	return len(b.entries) - b.sent
+}
+
+// BatchCredits holds the credits of every batch call made by Run.
+type BatchCredits []response.Credits
+
+// CreditsUsed returns the credits used by all batch calls.
+func (c BatchCredits) CreditsUsed() int64 {
+	var used int64
+
+	for _, creds := range c {
+		used += creds.GetCreditsUsed()
+	}
+
+	return used
+}
+
+// Run sends the calls added since the last Run, splitting them into batch calls of the batch size,
+// and settles every BatchItem. A failed batch call fails all its items; Run carries on with the next
+// batch call and returns the first such error.
+func (b *Batch) Run(ctx context.Context) (BatchCredits, error) {
+	var (
+		credits  BatchCredits
+		firstErr error
+	)
+
+	pending := b.entries[b.sent:]
+	b.sent = len(b.entries)
+
+	for start := 0; start < len(pending); start += b.size {
+		chunk := pending[start:min(start+b.size, len(pending))]
+
+		creds, err := b.send(ctx, chunk)
+		if creds != nil {
+			credits = append(credits, creds)
+		}
+
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return credits, firstErr
+}
+
+// send makes one batch call for entries and settles them.
+func (b *Batch) send(ctx context.Context, entries []batchEntry) (response.Credits, error) {
+	req := request.GetBatches{Requests: make(map[string]request.BatchRequest, len(entries))}
+
+	ids := make([]string, len(entries))
+	for i, entry := range entries {
+		ids[i] = "req_" + strconv.Itoa(i+1)
+		req.Requests[ids[i]] = request.BatchRequest{URL: entry.call.url}
+
+		if req.APIKey.APIKey == "" {
+			req.APIKey.APIKey = entry.call.apiKey
+		}
+	}
+
+	resp, creds, err := b.cli.GetBatchesCtx(ctx, req)
+	if err != nil {
+		for _, entry := range entries {
+			entry.settle(nil, err)
+		}
+
+		return creds, err
+	}
+
+	for i, entry := range entries {
+		item, ok := resp.Data[ids[i]]
+		if !ok {
+			entry.settle(nil, fmt.Errorf("batch: no response for %s", entry.call.url))
+
+			continue
+		}
+
+		if item.Status == "error" && !isErrorBody(item.Response) {
+			entry.settle(nil, fmt.Errorf("batch: %s failed", entry.call.url))
+
+			continue
+		}
+
+		entry.settle(item.Response, nil)
+	}
+
+	return creds, nil
+}
+
+// batchEntry is a call waiting in a Batch; settle receives its response body or the error of its batch call.
+type batchEntry struct {
+	call   *batchCall
+	settle func(body []byte, err error)
+}
+
+// batchCall is an endpoint call captured by AddToBatch instead of being sent.
+type batchCall struct {
+	url    string // path and query, without the API key
+	apiKey string // key of the request, used to authenticate the batch call
+	decode func(body []byte) (any, error)
+}
+
+type batchCallKey struct{}
+
+// isErrorBody reports whether body is an API error response.
+func isErrorBody(body []byte) bool {
+	var apiError response.Error
+
+	return json.Unmarshal(body, &apiError) == nil && apiError.Status == "error"
+}
+
+func batchCallFromContext(ctx context.Context) *batchCall {
+	call, _ := ctx.Value(batchCallKey{}).(*batchCall)
+
+	return call
+}
+
+// capture records built in call instead of sending it. Only GET requests can be batched.
+func (endpoint Endpoint[Request, Response, Credits, ErrorResponse]) capture(call *batchCall, built builtRequest) error {
+	if built.method != http.MethodGet {
+		return fmt.Errorf("batch: %s %s cannot be batched", built.method, endpoint.name())
+	}
+
+	values := cloneValues(built.values)
+
+	call.apiKey = values.Get("apikey")
+	if call.apiKey == "" {
+		call.apiKey = strings.TrimPrefix(built.headers[dictionary.Authorization], "apikey ")
+	}
+
+	values.Del("apikey")
+
+	call.url = built.uri.EscapedPath()
+	if query := values.Encode(); query != "" {
+		call.url += "?" + query
+	}
+
+	call.decode = func(body []byte) (any, error) {
+		resp, err := endpoint.decode(http.StatusOK, body, call.url, values)
+
+		return resp, err
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package twelvedata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+// newBatchServer answers batch calls item by item, recording the URLs of every call.
+// Items for the symbol FAIL get a symbol not found error.
+func newBatchServer(t *testing.T, calls *[][]string) string {
+	t.Helper()
+
+	var mu sync.Mutex
+
+	return newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/batch" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		var items map[string]request.BatchRequest
+		if err := json.NewDecoder(r.Body).Decode(&items); err != nil {
+			t.Error(err)
+		}
+
+		data := map[string]any{}
+		urls := make([]string, len(items))
+
+		for id, item := range items {
+			i, err := strconv.Atoi(strings.TrimPrefix(id, "req_"))
+			if err != nil || i < 1 || i > len(items) {
+				t.Errorf("unexpected item id %q", id)
+
+				continue
+			}
+
+			urls[i-1] = item.URL
+
+			uri, err := url.Parse(item.URL)
+			if err != nil {
+				t.Error(err)
+
+				continue
+			}
+
+			symbol := uri.Query().Get("symbol")
+			if symbol == "FAIL" {
+				data[id] = map[string]any{"status": "error", "response": map[string]any{
+					"code": 404, "message": "**symbol** not found: FAIL. Please specify it correctly", "status": "error",
+				}}
+
+				continue
+			}
+
+			data[id] = map[string]any{"status": "success", "response": map[string]any{
+				"symbol": symbol,
+				"meta":   map[string]any{"symbol": symbol},
+			}}
+		}
+
+		mu.Lock()
+		*calls = append(*calls, urls)
+		mu.Unlock()
+
+		w.Header().Set("Api-credits-left", "100")
+		w.Header().Set("Api-credits-used", "1")
+
+		if err := json.NewEncoder(w).Encode(map[string]any{"code": 200, "status": "success", "data": data}); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+var batchConf = Conf{
+	CoreData:            CoreData{QuotesURL: "/quote", TimeSeriesURL: "/time_series"},
+	TechnicalIndicators: TechnicalIndicators{RSIURL: "/rsi"},
+	Advanced:            Advanced{BatchesURL: "/batch"},
+}
+
+func TestBatch_TypedItems(t *testing.T) {
+	var calls [][]string
+
+	cli := newTestClient(t, newBatchServer(t, &calls), batchConf)
+	batch := NewBatch(cli, WithBatchSize(2))
+
+	quote := AddToBatch(batch, cli.GetQuoteCtx, request.GetQuote{APIKey: request.APIKey{APIKey: "key"}, Symbol: "AAPL"})
+	series := AddToBatch(batch, cli.GetTimeSeriesCtx, request.GetTimeSeries{Symbol: "MSFT", Interval: "1day", OutputSize: 2})
+	rsi := AddToBatch(batch, cli.GetRSICtx, request.GetRSI{Symbol: "TSLA", Interval: "1h", TimePeriod: 14})
+	failed := AddToBatch(batch, cli.GetQuoteCtx, request.GetQuote{Symbol: "FAIL"})
+
+	if _, err := quote.Result(); err == nil {
+		t.Fatal("expected an error before Run")
+	}
+
+	if batch.Len() != 4 {
+		t.Fatalf("Len() = %d, want 4", batch.Len())
+	}
+
+	credits, err := batch.Run(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantCalls := [][]string{
+		{"/quote?symbol=AAPL", "/time_series?interval=1day&outputsize=2&symbol=MSFT"},
+		{"/rsi?interval=1h&symbol=TSLA&time_period=14", "/quote?symbol=FAIL"},
+	}
+
+	if len(calls) != len(wantCalls) {
+		t.Fatalf("expected %d batch calls, got %v", len(wantCalls), calls)
+	}
+
+	for i, want := range wantCalls {
+		if strings.Join(calls[i], " ") != strings.Join(want, " ") {
+			t.Errorf("batch call %d sent %v, want %v", i, calls[i], want)
+		}
+	}
+
+	if credits.CreditsUsed() != 2 || batch.Len() != 0 {
+		t.Errorf("unexpected credits %d or pending calls %d", credits.CreditsUsed(), batch.Len())
+	}
+
+	if resp, err := quote.Result(); err != nil || resp.Symbol != "AAPL" {
+		t.Errorf("unexpected quote: %+v, %v", resp, err)
+	}
+
+	if resp, err := series.Result(); err != nil || resp.Meta.Symbol != "MSFT" {
+		t.Errorf("unexpected time series: %+v, %v", resp, err)
+	}
+
+	if resp, err := rsi.Result(); err != nil || resp.Meta.Symbol != "TSLA" {
+		t.Errorf("unexpected RSI: %+v, %v", resp, err)
+	}
+
+	if _, err := failed.Result(); !IsSymbolNotFoundError(err) {
+		t.Errorf("expected SymbolNotFoundError, got %v", err)
+	}
+}
+
+func TestBatch_FailedBatchCall(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusUnauthorized, 0, 0,
+		`{"code":401,"message":"**apikey** parameter is incorrect or not specified.","status":"error"}`, "/batch")
+
+	cli := newTestClient(t, serverURL, batchConf)
+	batch := NewBatch(cli)
+
+	quote := AddToBatch(batch, cli.GetQuoteCtx, request.GetQuote{Symbol: "AAPL"})
+
+	_, err := batch.Run(context.Background())
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if _, itemErr := quote.Result(); !errors.Is(itemErr, err) {
+		t.Errorf("expected the batch call error on the item, got %v", itemErr)
+	}
+}
+
+func TestBatch_NotBatchable(t *testing.T) {
+	cli := newTestClient(t, "http://127.0.0.1:1", batchConf)
+	batch := NewBatch(cli)
+
+	item := AddToBatch(batch, cli.GetBatchesCtx, request.GetBatches{})
+	if _, err := item.Result(); err == nil || !strings.Contains(err.Error(), "cannot be batched") {
+		t.Errorf("expected a not batchable error, got %v", err)
+	}
+
+	notClient := AddToBatch(batch, func(context.Context, request.GetQuote) (struct{}, response.Credits, error) {
+		return struct{}{}, nil, nil
+	}, request.GetQuote{})
+	if _, err := notClient.Result(); err == nil {
+		t.Error("expected an error for a function that is not a client call")
+	}
+
+	if batch.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", batch.Len())
+	}
+}
@@ -0,0 +1,329 @@
+package twelvedata
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheBackend stores raw response bodies by cache key. Implementations must be safe for concurrent use.
+type CacheBackend interface {
+	// Get returns the value stored under key, or false when it is missing or expired.
+	Get(key string) ([]byte, bool)
+	// Set stores value under key for ttl.
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+// CacheClass groups endpoints whose responses go stale at a similar pace.
+type CacheClass int
+
+const (
+	// CacheNone disables caching.
+	CacheNone CacheClass = iota
+	// CacheQuotes covers real-time prices and quotes.
+	CacheQuotes
+	// CacheIntraday covers time series and technical indicators with an intraday interval.
+	CacheIntraday
+	// CacheDaily covers time series and technical indicators with a daily or longer interval.
+	CacheDaily
+	// CacheFundamentals covers company, fund and regulatory data.
+	CacheFundamentals
+	// CacheCatalogs covers reference data such as symbol lists, exchanges and countries.
+	CacheCatalogs
+	// CacheSeries resolves to CacheIntraday or CacheDaily by the interval query parameter.
+	CacheSeries
+)
+
+// CachePolicy maps endpoint paths to cache classes and cache classes to TTLs.
+// Endpoints without a class and classes without a positive TTL are not cached.
+type CachePolicy struct {
+	Endpoints map[string]CacheClass // keyed by Conf path, e.g. "/stocks" or "/market_movers/{market}"
+	TTLs      map[CacheClass]time.Duration
+}
+
+// DefaultCachePolicy returns TTLs of 15s for quotes, 1m for intraday series, 1h for daily series and 24h
+// for fundamentals and catalogs, with the endpoints classified by their default Conf paths and every
+// indicator of the generated catalog classified as a series.
+func DefaultCachePolicy() CachePolicy {
+	endpoints := map[string]CacheClass{}
+
+	classify := func(class CacheClass, paths ...string) {
+		for _, path := range paths {
+			endpoints[path] = class
+		}
+	}
+
+	classify(CacheQuotes,
+		"/quote", "/price", "/eod", "/market_movers/{market}", "/market_state",
+		"/exchange_rate", "/currency_conversion",
+	)
+	classify(CacheSeries, "/time_series", "/time_series/cross")
+	classify(CacheSeries, indicatorPaths...)
+	classify(CacheFundamentals,
+		"/logo", "/profile", "/dividends", "/dividends_calendar", "/earnings", "/earnings_calendar",
+		"/ipo_calendar", "/splits", "/splits_calendar", "/statistics", "/press_releases",
+		"/income_statement", "/income_statement/consolidated", "/balance_sheet", "/balance_sheet/consolidated",
+		"/cash_flow", "/cash_flow/consolidated", "/key_executives", "/market_cap", "/last_change/{endpoint}",
+		"/earnings_estimate", "/revenue_estimate", "/eps_trend", "/eps_revisions", "/growth_estimates",
+		"/recommendations", "/price_target", "/analyst_ratings/light", "/analyst_ratings/us_equities",
+		"/edgar_filings/archive", "/insider_transactions", "/institutional_holders", "/fund_holders",
+		"/direct_holders", "/tax_info", "/sanctions/{source}",
+		"/etfs/world", "/etfs/world/summary", "/etfs/world/performance", "/etfs/world/risk", "/etfs/world/composition",
+		"/mutual_funds/world", "/mutual_funds/world/summary", "/mutual_funds/world/performance",
+		"/mutual_funds/world/risk", "/mutual_funds/world/ratings", "/mutual_funds/world/composition",
+		"/mutual_funds/world/purchase_info", "/mutual_funds/world/sustainability",
+	)
+	classify(CacheCatalogs,
+		"/stocks", "/forex_pairs", "/cryptocurrencies", "/etfs", "/funds", "/commodities", "/bonds",
+		"/symbol_search", "/cross_listings", "/earliest_timestamp", "/exchanges", "/exchange_schedule",
+		"/cryptocurrency_exchanges", "/countries", "/instrument_type", "/technical_indicators",
+		"/etfs/list", "/etfs/family", "/etfs/type", "/mutual_funds/list", "/mutual_funds/family", "/mutual_funds/type",
+	)
+
+	return CachePolicy{
+		Endpoints: endpoints,
+		TTLs: map[CacheClass]time.Duration{
+			CacheQuotes:       15 * time.Second,
+			CacheIntraday:     time.Minute,
+			CacheDaily:        time.Hour,
+			CacheFundamentals: 24 * time.Hour,
+			CacheCatalogs:     24 * time.Hour,
+		},
+	}
+}
+
+// ttl returns the TTL of a call to the endpoint path with the given query, zero when it is not cached.
+func (p CachePolicy) ttl(path string, values url.Values) time.Duration {
+	class := p.Endpoints[path]
+	if class == CacheSeries {
+		class = CacheDaily
+		if isIntradayInterval(values.Get("interval")) {
+			class = CacheIntraday
+		}
+	}
+
+	return p.TTLs[class]
+}
+
+// isIntradayInterval reports whether interval is shorter than a day. A missing interval counts as intraday.
+func isIntradayInterval(interval string) bool {
+	unit := strings.TrimLeft(interval, "0123456789")
+
+	return interval == "" || unit == "min" || unit == "h"
+}
+
+type responseCache struct {
+	backend CacheBackend
+	policy  CachePolicy
+}
+
+// WithCache serves repeated GET calls from backend according to policy.
+// Cache hits do not reach the API and report zero credits.
+func WithCache(backend CacheBackend, policy CachePolicy) HTTPCliOption {
+	return func(c *HTTPCli) {
+		c.cache = &responseCache{backend: backend, policy: policy}
+	}
+}
+
+// ttl returns how long a call may be cached, zero when the cache is disabled or the call is not cacheable.
+// Only GET requests are cached.
+func (c *responseCache) ttl(endpoint, method string, values url.Values) time.Duration {
+	if c == nil || method != http.MethodGet {
+		return 0
+	}
+
+	return c.policy.ttl(endpoint, values)
+}
+
+// key builds the canonical key of a call from its resolved path and query. The API key, from the query or the
+// Authorization header, is replaced by a hash of it, so clients of different keys sharing a backend do not serve
+// each other's responses. Keys picked from a key pool when the request is sent share their entries.
+func (c *responseCache) key(path string, values url.Values, headers map[string]string) string {
+	hash := apiKeyHash(values, headers)
+	if values.Has("apikey") {
+		values = cloneValues(values)
+		values.Del("apikey")
+	}
+
+	key := path + "?" + values.Encode()
+	if hash == "" {
+		return key
+	}
+
+	return key + "#" + hash
+}
+
+func cloneValues(values url.Values) url.Values {
+	clone := make(url.Values, len(values))
+	for key, vals := range values {
+		clone[key] = append([]string(nil), vals...)
+	}
+
+	return clone
+}
+
+// LRUCache is an in-memory CacheBackend that evicts the least recently used entry above its capacity.
+type LRUCache struct {
+	mu      sync.Mutex
+	max     int
+	entries map[string]*list.Element
+	order   *list.List
+	now     func() time.Time
+}
+
+type lruEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewLRUCache creates an in-memory cache holding at most maxEntries responses.
+func NewLRUCache(maxEntries int) *LRUCache {
+	return &LRUCache{
+		max:     maxEntries,
+		entries: map[string]*list.Element{},
+		order:   list.New(),
+		now:     time.Now,
+	}
+}
+
+// Get implements CacheBackend.
+func (c *LRUCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*lruEntry) //nolint:forcetypeassert // the list only holds *lruEntry
+	if !c.now().Before(entry.expiresAt) {
+		c.order.Remove(elem)
+		delete(c.entries, key)
+
+		return nil, false
+	}
+
+	c.order.MoveToFront(elem)
+
+	return entry.value, true
+}
+
+// Set implements CacheBackend.
+func (c *LRUCache) Set(key string, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.now().Add(ttl)
+
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*lruEntry) //nolint:forcetypeassert // the list only holds *lruEntry
+		entry.value, entry.expiresAt = value, expiresAt
+		c.order.MoveToFront(elem)
+
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+
+	for c.max > 0 && c.order.Len() > c.max {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry).key) //nolint:forcetypeassert // the list only holds *lruEntry
+	}
+}
+
+// Len returns the number of cached entries, including expired ones not evicted yet.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// DiskCache is a CacheBackend storing every response in its own file under a directory.
+// File names are SHA-256 hashes of the cache keys. Write errors are ignored, a failed write is a cache miss later.
+type DiskCache struct {
+	dir string
+	now func() time.Time
+}
+
+// NewDiskCache creates a disk cache in dir, creating the directory when needed.
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("create cache dir: %w", err)
+	}
+
+	return &DiskCache{dir: dir, now: time.Now}, nil
+}
+
+func (c *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:]))
+}
+
+// Get implements CacheBackend.
+func (c *DiskCache) Get(key string) ([]byte, bool) {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	// The first line holds the expiry as Unix nanoseconds, the rest is the response body.
+	header, value, ok := bytes.Cut(data, []byte("\n"))
+	if !ok {
+		return nil, false
+	}
+
+	expiresAt, err := strconv.ParseInt(string(header), 10, 64)
+	if err != nil || c.now().UnixNano() >= expiresAt {
+		_ = os.Remove(c.path(key))
+
+		return nil, false
+	}
+
+	return value, true
+}
+
+// Set implements CacheBackend.
+func (c *DiskCache) Set(key string, value []byte, ttl time.Duration) {
+	_ = c.set(key, value, ttl)
+}
+
+func (c *DiskCache) set(key string, value []byte, ttl time.Duration) error {
+	tmp, err := os.CreateTemp(c.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+
+	header := strconv.FormatInt(c.now().Add(ttl).UnixNano(), 10) + "\n"
+
+	_, err = tmp.WriteString(header)
+	if err == nil {
+		_, err = tmp.Write(value)
+	}
+
+	err = errors.Join(err, tmp.Close())
+	if err == nil {
+		err = os.Rename(tmp.Name(), c.path(key))
+	}
+
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+
+	return err
+}
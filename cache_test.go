@@ -0,0 +1,262 @@
+package twelvedata
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+func newCountingServer(t *testing.T, calls *atomic.Int32, body string) string {
+	t.Helper()
+
+	return newTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+
+		w.Header().Set("Api-credits-left", "99")
+		w.Header().Set("Api-credits-used", "1")
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+var cacheConf = Conf{CoreData: CoreData{QuotesURL: "/quote", TimeSeriesURL: "/time_series"}}
+
+func TestCache_HitReportsZeroCredits(t *testing.T) {
+	var calls atomic.Int32
+
+	serverURL := newCountingServer(t, &calls, `{"symbol":"AAPL","name":"Apple Inc"}`)
+	cli := newTestClient(t, serverURL, cacheConf, WithCache(NewLRUCache(10), DefaultCachePolicy()))
+
+	_, creds, err := cli.GetQuote(request.GetQuote{APIKey: request.APIKey{APIKey: "one"}, Symbol: "AAPL"})
+	if err != nil || creds.GetCreditsUsed() != 1 {
+		t.Fatalf("first call: creds %+v, err %v", creds, err)
+	}
+
+	quote, creds, err := cli.GetQuote(request.GetQuote{APIKey: request.APIKey{APIKey: "one"}, Symbol: "AAPL"})
+	if err != nil {
+		t.Fatalf("second call: %v", err)
+	}
+
+	if quote.Name != "Apple Inc" || creds.GetCreditsUsed() != 0 || creds.GetCreditsLeft() != 0 {
+		t.Fatalf("unexpected cached result: %+v, %+v", quote, creds)
+	}
+
+	if calls.Load() != 1 {
+		t.Fatalf("expected 1 server call, got %d", calls.Load())
+	}
+
+	if _, _, err := cli.GetQuote(request.GetQuote{Symbol: "MSFT"}); err != nil {
+		t.Fatalf("third call: %v", err)
+	}
+
+	if calls.Load() != 2 {
+		t.Fatalf("expected a miss for another symbol, got %d calls", calls.Load())
+	}
+}
+
+func TestCache_ErrorsAreNotCached(t *testing.T) {
+	var calls atomic.Int32
+
+	serverURL := newCountingServer(t, &calls,
+		`{"code":404,"message":"**symbol** not found: NOPE. Please specify it correctly","status":"error"}`)
+	cli := newTestClient(t, serverURL, cacheConf, WithCache(NewLRUCache(10), DefaultCachePolicy()))
+
+	for range 2 {
+		if _, _, err := cli.GetQuote(request.GetQuote{Symbol: "NOPE"}); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	}
+
+	if calls.Load() != 2 {
+		t.Fatalf("expected 2 server calls, got %d", calls.Load())
+	}
+}
+
+func TestCache_NonGETIsNotCached(t *testing.T) {
+	var calls atomic.Int32
+
+	serverURL := newCountingServer(t, &calls, `{"status":"ok"}`)
+
+	httpCli := newTestHTTPCli(serverURL)
+	WithCache(NewLRUCache(10), CachePolicy{
+		Endpoints: map[string]CacheClass{"/": CacheCatalogs},
+		TTLs:      map[CacheClass]time.Duration{CacheCatalogs: time.Hour},
+	})(httpCli)
+
+	endpoint := NewEndpoint[methodRequest, testResponse, response.Credits, error](httpCli, serverURL+"/")
+
+	for range 2 {
+		if _, _, err := endpoint.Call(methodRequest{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if calls.Load() != 2 {
+		t.Fatalf("expected 2 server calls, got %d", calls.Load())
+	}
+}
+
+func TestCachePolicy_TTL(t *testing.T) {
+	policy := DefaultCachePolicy()
+
+	tests := []struct {
+		path     string
+		interval string
+		want     time.Duration
+	}{
+		{path: "/quote", want: 15 * time.Second},
+		{path: "/time_series", interval: "5min", want: time.Minute},
+		{path: "/time_series", interval: "4h", want: time.Minute},
+		{path: "/rsi", interval: "1day", want: time.Hour},
+		{path: "/time_series", interval: "1month", want: time.Hour},
+		{path: "/profile", want: 24 * time.Hour},
+		{path: "/stocks", want: 24 * time.Hour},
+		{path: "/api_usage", want: 0},
+		{path: "/batch", want: 0},
+	}
+
+	for _, tt := range tests {
+		values := url.Values{}
+		if tt.interval != "" {
+			values.Set("interval", tt.interval)
+		}
+
+		if got := policy.ttl(tt.path, values); got != tt.want {
+			t.Errorf("ttl(%s, %q) = %v, want %v", tt.path, tt.interval, got, tt.want)
+		}
+	}
+}
+
+func TestDefaultCachePolicy_ClassifiesEveryIndicator(t *testing.T) {
+	policy := DefaultCachePolicy()
+
+	paths := slices.Clone(indicatorPaths)
+
+	fields := reflect.TypeFor[TechnicalIndicators]()
+	for i := range fields.NumField() {
+		if path := fields.Field(i).Tag.Get("default"); path != "/{indicator}" {
+			paths = append(paths, path)
+		}
+	}
+
+	for _, path := range paths {
+		if class, ok := policy.Endpoints[path]; !ok || class != CacheSeries {
+			t.Errorf("expected %s to be classified as a series, got %v, %v", path, class, ok)
+		}
+	}
+}
+
+func TestCache_KeyHashesAPIKey(t *testing.T) {
+	var cache *responseCache
+
+	values := url.Values{"symbol": {"AAPL"}, "apikey": {"secret"}}
+
+	key := cache.key("/quote", values, nil)
+	if !strings.HasPrefix(key, "/quote?symbol=AAPL#") || strings.Contains(key, "secret") {
+		t.Fatalf("unexpected key %q", key)
+	}
+
+	if values.Get("apikey") != "secret" {
+		t.Fatal("key() modified the query values")
+	}
+
+	inHeader := cache.key("/quote", url.Values{"symbol": {"AAPL"}}, map[string]string{"Authorization": "apikey secret"})
+	if inHeader != key {
+		t.Errorf("expected the key in the header to give %q, got %q", key, inHeader)
+	}
+
+	if other := cache.key("/quote", url.Values{"symbol": {"AAPL"}, "apikey": {"other"}}, nil); other == key {
+		t.Errorf("expected another API key to give another key, got %q", other)
+	}
+
+	if got := cache.key("/quote", url.Values{"symbol": {"AAPL"}}, nil); got != "/quote?symbol=AAPL" {
+		t.Errorf("unexpected key without an API key %q", got)
+	}
+}
+
+func TestCache_SharedBackendSeparatesAPIKeys(t *testing.T) {
+	var calls atomic.Int32
+
+	serverURL := newCountingServer(t, &calls, `{"symbol":"AAPL","name":"Apple Inc"}`)
+	backend := NewLRUCache(10)
+
+	for _, apiKey := range []string{"one", "two", "one"} {
+		cli := newTestClient(t, serverURL, cacheConf, WithCache(backend, DefaultCachePolicy()))
+
+		if _, _, err := cli.GetQuote(request.GetQuote{APIKey: request.APIKey{APIKey: apiKey}, Symbol: "AAPL"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if calls.Load() != 2 {
+		t.Fatalf("expected one server call per API key, got %d", calls.Load())
+	}
+}
+
+func TestLRUCache(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cache := NewLRUCache(2)
+	cache.now = func() time.Time { return now }
+
+	cache.Set("a", []byte("1"), time.Minute)
+	cache.Set("b", []byte("2"), time.Minute)
+
+	if _, ok := cache.Get("a"); !ok {
+		t.Fatal("expected a to be cached")
+	}
+
+	cache.Set("c", []byte("3"), time.Minute)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Fatal("expected b to be evicted as least recently used")
+	}
+
+	if cache.Len() != 2 {
+		t.Fatalf("expected 2 entries, got %d", cache.Len())
+	}
+
+	now = now.Add(time.Minute)
+
+	if _, ok := cache.Get("a"); ok {
+		t.Fatal("expected a to expire")
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cache, err := NewDiskCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewDiskCache() error = %v", err)
+	}
+
+	cache.now = func() time.Time { return now }
+
+	cache.Set("/quote?symbol=AAPL", []byte("{\"symbol\":\"AAPL\"}\n"), time.Minute)
+
+	got, ok := cache.Get("/quote?symbol=AAPL")
+	if !ok || string(got) != "{\"symbol\":\"AAPL\"}\n" {
+		t.Fatalf("Get() = %q, %v", got, ok)
+	}
+
+	if _, ok := cache.Get("/quote?symbol=MSFT"); ok {
+		t.Fatal("unexpected hit for a missing key")
+	}
+
+	now = now.Add(time.Minute)
+
+	if _, ok := cache.Get("/quote?symbol=AAPL"); ok {
+		t.Fatal("expected the entry to expire")
+	}
+}
@@ -32,7 +32,7 @@ func Test_client_GetEarningsEstimate(t *testing.T) {
 		url string
 	}
 
-	expectedURL := "/earnings_estimate?country=US&cusip=594918104&exchange=NASDAQ&figi=BBG01293F5X4&isin=US0378331005&symbol=AAPL"
+	expectedURL := "/earnings_estimate?country=US&exchange=NASDAQ&symbol=AAPL"
 
 	tests := []struct {
 		name        string
@@ -48,9 +48,6 @@ func Test_client_GetEarningsEstimate(t *testing.T) {
 				req: request.GetEarningsEstimate{
 					APIKey:   request.APIKey{APIKey: ""},
 					Symbol:   "AAPL",
-					Figi:     "BBG01293F5X4",
-					Isin:     "US0378331005",
-					Cusip:    "594918104",
 					Exchange: "NASDAQ",
 					Country:  "US",
 				},
@@ -110,9 +107,6 @@ func Test_client_GetEarningsEstimate(t *testing.T) {
 				req: request.GetEarningsEstimate{
 					APIKey:   request.APIKey{APIKey: ""},
 					Symbol:   "AAPL",
-					Figi:     "BBG01293F5X4",
-					Isin:     "US0378331005",
-					Cusip:    "594918104",
 					Exchange: "NASDAQ",
 					Country:  "US",
 				},
@@ -161,7 +155,7 @@ func Test_client_GetRevenueEstimate(t *testing.T) {
 		url string
 	}
 
-	expectedURL := "/revenue_estimate?country=US&cusip=594918104&dp=2&exchange=NASDAQ&figi=BBG01293F5X4&isin=US0378331005&symbol=AAPL"
+	expectedURL := "/revenue_estimate?country=US&dp=2&exchange=NASDAQ&symbol=AAPL"
 
 	tests := []struct {
 		name        string
@@ -177,9 +171,6 @@ func Test_client_GetRevenueEstimate(t *testing.T) {
 				req: request.GetRevenueEstimate{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					Figi:          "BBG01293F5X4",
-					Isin:          "US0378331005",
-					Cusip:         "594918104",
 					Exchange:      "NASDAQ",
 					Country:       "US",
 					DecimalPlaces: 2,
@@ -242,9 +233,6 @@ func Test_client_GetRevenueEstimate(t *testing.T) {
 				req: request.GetRevenueEstimate{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					Figi:          "BBG01293F5X4",
-					Isin:          "US0378331005",
-					Cusip:         "594918104",
 					Exchange:      "NASDAQ",
 					Country:       "US",
 					DecimalPlaces: 2,
@@ -295,7 +283,7 @@ func Test_client_GetEPSTrend(t *testing.T) {
 		url string
 	}
 
-	expectedURL := "/eps_trend?country=US&cusip=594918104&exchange=NASDAQ&figi=BBG01293F5X4&isin=US0378331005&symbol=AAPL"
+	expectedURL := "/eps_trend?country=US&exchange=NASDAQ&symbol=AAPL"
 
 	tests := []struct {
 		name        string
@@ -311,9 +299,6 @@ func Test_client_GetEPSTrend(t *testing.T) {
 				req: request.GetEPSTrend{
 					APIKey:   request.APIKey{APIKey: ""},
 					Symbol:   "AAPL",
-					Figi:     "BBG01293F5X4",
-					Isin:     "US0378331005",
-					Cusip:    "594918104",
 					Exchange: "NASDAQ",
 					Country:  "US",
 				},
@@ -373,9 +358,6 @@ func Test_client_GetEPSTrend(t *testing.T) {
 				req: request.GetEPSTrend{
 					APIKey:   request.APIKey{APIKey: ""},
 					Symbol:   "AAPL",
-					Figi:     "BBG01293F5X4",
-					Isin:     "US0378331005",
-					Cusip:    "594918104",
 					Exchange: "NASDAQ",
 					Country:  "US",
 				},
@@ -424,7 +406,7 @@ func Test_client_GetEPSRevisions(t *testing.T) {
 		url string
 	}
 
-	expectedURL := "/eps_revisions?country=US&cusip=594918104&exchange=NASDAQ&figi=BBG01293F5X4&isin=US0378331005&symbol=AAPL"
+	expectedURL := "/eps_revisions?country=US&exchange=NASDAQ&symbol=AAPL"
 
 	tests := []struct {
 		name        string
@@ -440,9 +422,6 @@ func Test_client_GetEPSRevisions(t *testing.T) {
 				req: request.GetEPSRevisions{
 					APIKey:   request.APIKey{APIKey: ""},
 					Symbol:   "AAPL",
-					Figi:     "BBG01293F5X4",
-					Isin:     "US0378331005",
-					Cusip:    "594918104",
 					Exchange: "NASDAQ",
 					Country:  "US",
 				},
@@ -500,9 +479,6 @@ func Test_client_GetEPSRevisions(t *testing.T) {
 				req: request.GetEPSRevisions{
 					APIKey:   request.APIKey{APIKey: ""},
 					Symbol:   "AAPL",
-					Figi:     "BBG01293F5X4",
-					Isin:     "US0378331005",
-					Cusip:    "594918104",
 					Exchange: "NASDAQ",
 					Country:  "US",
 				},
@@ -551,7 +527,7 @@ func Test_client_GetGrowthEstimates(t *testing.T) {
 		url string
 	}
 
-	expectedURL := "/growth_estimates?country=US&cusip=594918104&exchange=NASDAQ&figi=BBG01293F5X4&isin=US0378331005&symbol=AAPL"
+	expectedURL := "/growth_estimates?country=US&exchange=NASDAQ&symbol=AAPL"
 
 	tests := []struct {
 		name        string
@@ -567,9 +543,6 @@ func Test_client_GetGrowthEstimates(t *testing.T) {
 				req: request.GetGrowthEstimates{
 					APIKey:   request.APIKey{APIKey: ""},
 					Symbol:   "AAPL",
-					Figi:     "BBG01293F5X4",
-					Isin:     "US0378331005",
-					Cusip:    "594918104",
 					Exchange: "NASDAQ",
 					Country:  "US",
 				},
@@ -623,9 +596,6 @@ func Test_client_GetGrowthEstimates(t *testing.T) {
 				req: request.GetGrowthEstimates{
 					APIKey:   request.APIKey{APIKey: ""},
 					Symbol:   "AAPL",
-					Figi:     "BBG01293F5X4",
-					Isin:     "US0378331005",
-					Cusip:    "594918104",
 					Exchange: "NASDAQ",
 					Country:  "US",
 				},
@@ -674,7 +644,7 @@ func Test_client_GetRecommendations(t *testing.T) {
 		url string
 	}
 
-	expectedURL := "/recommendations?country=US&cusip=594918104&exchange=NASDAQ&figi=BBG01293F5X4&isin=US0378331005&symbol=AAPL"
+	expectedURL := "/recommendations?country=US&exchange=NASDAQ&symbol=AAPL"
 
 	tests := []struct {
 		name        string
@@ -690,9 +660,6 @@ func Test_client_GetRecommendations(t *testing.T) {
 				req: request.GetRecommendations{
 					APIKey:   request.APIKey{APIKey: ""},
 					Symbol:   "AAPL",
-					Figi:     "BBG01293F5X4",
-					Isin:     "US0378331005",
-					Cusip:    "594918104",
 					Exchange: "NASDAQ",
 					Country:  "US",
 				},
@@ -792,9 +759,6 @@ func Test_client_GetRecommendations(t *testing.T) {
 				req: request.GetRecommendations{
 					APIKey:   request.APIKey{APIKey: ""},
 					Symbol:   "AAPL",
-					Figi:     "BBG01293F5X4",
-					Isin:     "US0378331005",
-					Cusip:    "594918104",
 					Exchange: "NASDAQ",
 					Country:  "US",
 				},
@@ -843,7 +807,7 @@ func Test_client_GetPriceTarget(t *testing.T) {
 		url string
 	}
 
-	expectedURL := "/price_target?country=US&cusip=594918104&exchange=NASDAQ&figi=BBG01293F5X4&isin=US0378331005&symbol=AAPL"
+	expectedURL := "/price_target?country=US&exchange=NASDAQ&symbol=AAPL"
 
 	tests := []struct {
 		name        string
@@ -859,9 +823,6 @@ func Test_client_GetPriceTarget(t *testing.T) {
 				req: request.GetPriceTarget{
 					APIKey:   request.APIKey{APIKey: ""},
 					Symbol:   "AAPL",
-					Figi:     "BBG01293F5X4",
-					Isin:     "US0378331005",
-					Cusip:    "594918104",
 					Exchange: "NASDAQ",
 					Country:  "US",
 				},
@@ -915,9 +876,6 @@ func Test_client_GetPriceTarget(t *testing.T) {
 				req: request.GetPriceTarget{
 					APIKey:   request.APIKey{APIKey: ""},
 					Symbol:   "AAPL",
-					Figi:     "BBG01293F5X4",
-					Isin:     "US0378331005",
-					Cusip:    "594918104",
 					Exchange: "NASDAQ",
 					Country:  "US",
 				},
@@ -966,7 +924,7 @@ func Test_client_GetAnalystRatingsSnapshot(t *testing.T) {
 		url string
 	}
 
-	expectedURL := "/analyst_ratings/light?country=US&cusip=594918104&exchange=NASDAQ&figi=BBG01293F5X4&isin=US0378331005&outputsize=30&rating_change=Maintains&symbol=AAPL"
+	expectedURL := "/analyst_ratings/light?country=US&exchange=NASDAQ&outputsize=30&rating_change=Maintains&symbol=AAPL"
 
 	tests := []struct {
 		name        string
@@ -982,9 +940,6 @@ func Test_client_GetAnalystRatingsSnapshot(t *testing.T) {
 				req: request.GetAnalystRatingsSnapshot{
 					APIKey:       request.APIKey{APIKey: ""},
 					Symbol:       "AAPL",
-					Figi:         "BBG01293F5X4",
-					Isin:         "US0378331005",
-					Cusip:        "594918104",
 					Exchange:     "NASDAQ",
 					Country:      "US",
 					RatingChange: "Maintains",
@@ -1042,9 +997,6 @@ func Test_client_GetAnalystRatingsSnapshot(t *testing.T) {
 				req: request.GetAnalystRatingsSnapshot{
 					APIKey:       request.APIKey{APIKey: ""},
 					Symbol:       "AAPL",
-					Figi:         "BBG01293F5X4",
-					Isin:         "US0378331005",
-					Cusip:        "594918104",
 					Exchange:     "NASDAQ",
 					Country:      "US",
 					RatingChange: "Maintains",
@@ -1095,7 +1047,7 @@ func Test_client_GetAnalystRatingsUSEquities(t *testing.T) {
 		url string
 	}
 
-	expectedURL := "/analyst_ratings/us_equities?cusip=594918104&exchange=NASDAQ&figi=BBG01293F5X4&isin=US0378331005&outputsize=30&rating_change=Maintains&symbol=AAPL"
+	expectedURL := "/analyst_ratings/us_equities?exchange=NASDAQ&outputsize=30&rating_change=Maintains&symbol=AAPL"
 
 	tests := []struct {
 		name        string
@@ -1111,9 +1063,6 @@ func Test_client_GetAnalystRatingsUSEquities(t *testing.T) {
 				req: request.GetAnalystRatingsUSEquities{
 					APIKey:       request.APIKey{APIKey: ""},
 					Symbol:       "AAPL",
-					Figi:         "BBG01293F5X4",
-					Isin:         "US0378331005",
-					Cusip:        "594918104",
 					Exchange:     "NASDAQ",
 					RatingChange: "Maintains",
 					OutputSize:   30,
@@ -1180,9 +1129,6 @@ func Test_client_GetAnalystRatingsUSEquities(t *testing.T) {
 				req: request.GetAnalystRatingsUSEquities{
 					APIKey:       request.APIKey{APIKey: ""},
 					Symbol:       "AAPL",
-					Figi:         "BBG01293F5X4",
-					Isin:         "US0378331005",
-					Cusip:        "594918104",
 					Exchange:     "NASDAQ",
 					RatingChange: "Maintains",
 					OutputSize:   30,
@@ -86,6 +86,7 @@ func Test_client_GetMarketMovers(t *testing.T) {
 			args: args{
 				req: request.GetMarketMovers{
 					APIKey: request.APIKey{APIKey: ""},
+					Market: "stocks",
 				},
 				url: mockServerWithURL(
 					t,
@@ -93,7 +94,7 @@ func Test_client_GetMarketMovers(t *testing.T) {
 					100,
 					100,
 					`{"code":401,"message":"**apikey** parameter is incorrect or not specified. You can get your free API key instantly following this link: https://twelvedata.com/pricing. If you believe that everything is correct, you can contact us at https://twelvedata.com/contact/customer","status":"error"}`,
-					"/market_movers/",
+					"/market_movers/stocks",
 				),
 			},
 			want:  response.MarketMovers{},
@@ -149,6 +150,7 @@ func Test_client_GetQuote(t *testing.T) {
 					APIKey: request.APIKey{
 						APIKey: "",
 					},
+					Symbol: "AAPL",
 				},
 				url: mockServerWithURL(
 					t,
@@ -191,7 +193,7 @@ func Test_client_GetQuote(t *testing.T) {
 					  "extended_price": "125.22",
 					  "extended_timestamp": "1649845281"
 					}`,
-					"/quote",
+					"/quote?symbol=AAPL",
 				),
 			},
 			want: response.Quote{
@@ -241,6 +243,7 @@ func Test_client_GetQuote(t *testing.T) {
 					APIKey: request.APIKey{
 						APIKey: "",
 					},
+					Symbol: "AAPL",
 				},
 				url: mockServerWithURL(
 					t,
@@ -248,7 +251,7 @@ func Test_client_GetQuote(t *testing.T) {
 					100,
 					100,
 					`{"code":401,"message":"**apikey** parameter is incorrect or not specified. You can get your free API key instantly following this link: https://twelvedata.com/pricing. If you believe that everything is correct, you can contact us at https://twelvedata.com/contact/customer","status":"error"}`,
-					"/quote",
+					"/quote?symbol=AAPL",
 				),
 			},
 			want:  response.Quote{},
@@ -303,6 +306,8 @@ func Test_client_GetTimeSeries(t *testing.T) {
 					APIKey: request.APIKey{
 						APIKey: "",
 					},
+					Symbol:   "AAPL",
+					Interval: "1min",
 				},
 				url: mockServerWithURL(
 					t,
@@ -331,7 +336,7 @@ func Test_client_GetTimeSeries(t *testing.T) {
 					  ],
 					  "status": "ok"
 					}`,
-					"/",
+					"/?interval=1min&symbol=AAPL",
 				),
 			},
 			wantTimeSeries: response.TimeSeries{
@@ -367,6 +372,8 @@ func Test_client_GetTimeSeries(t *testing.T) {
 					APIKey: request.APIKey{
 						APIKey: "",
 					},
+					Symbol:   "AAPL",
+					Interval: "1min",
 				},
 				url: mockServerWithURL(
 					t,
@@ -374,7 +381,7 @@ func Test_client_GetTimeSeries(t *testing.T) {
 					100,
 					100,
 					`{"code":401,"message":"**apikey** parameter is incorrect or not specified. You can get your free API key instantly following this link: https://twelvedata.com/pricing. If you believe that everything is correct, you can contact us at https://twelvedata.com/contact/customer","status":"error"}`,
-					"/",
+					"/?interval=1min&symbol=AAPL",
 				),
 			},
 			wantTimeSeries: response.TimeSeries{
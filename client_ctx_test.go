@@ -0,0 +1,156 @@
+package twelvedata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+func newSlowServer(t *testing.T, delay time.Duration) string {
+	t.Helper()
+
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		select {
+		case <-time.After(delay):
+		case <-release:
+		}
+
+		w.Header().Set("Api-credits-left", "100")
+		w.Header().Set("Api-credits-used", "1")
+
+		if _, err := w.Write([]byte(`{"status":"ok"}`)); err != nil {
+			t.Error(err)
+		}
+	}))
+
+	t.Cleanup(func() {
+		close(release)
+		server.Close()
+	})
+
+	return server.URL
+}
+
+func TestEndpoint_CallCtx_Success(t *testing.T) {
+	serverURL := mockServerWithRequest(t, http.StatusOK, 100, 1, `{"status":"ok"}`, expectedRequest{
+		Method: http.MethodGet,
+		URL:    "/",
+	})
+
+	endpoint := NewEndpoint[headerRequest, testResponse, response.Credits, error](newTestHTTPCli(serverURL), serverURL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, creds, err := endpoint.CallCtx(ctx, headerRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.Status != "ok" {
+		t.Fatalf("unexpected status: %s", resp.Status)
+	}
+
+	if creds.GetCreditsLeft() != 100 || creds.GetCreditsUsed() != 1 {
+		t.Fatalf("unexpected credits: %+v", creds)
+	}
+}
+
+func TestEndpoint_CallCtx_Cancel(t *testing.T) {
+	serverURL := newSlowServer(t, 5*time.Second)
+	httpCli := newTestHTTPCli(serverURL)
+	httpCli.cfg.Timeout = 10
+
+	endpoint := NewEndpoint[headerRequest, testResponse, response.Credits, error](httpCli, serverURL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+
+	_, _, err := endpoint.CallCtx(ctx, headerRequest{})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("cancellation did not abort the request, took %s", elapsed)
+	}
+}
+
+func TestEndpoint_CallCtx_AlreadyCancelled(t *testing.T) {
+	endpoint := NewEndpoint[headerRequest, testResponse, response.Credits, error](newTestHTTPCli("http://127.0.0.1:1"), "http://127.0.0.1:1")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _, err := endpoint.CallCtx(ctx, headerRequest{})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestEndpoint_CallCtx_Deadline(t *testing.T) {
+	serverURL := newSlowServer(t, 5*time.Second)
+	httpCli := newTestHTTPCli(serverURL)
+	httpCli.cfg.Timeout = 10
+
+	endpoint := NewEndpoint[headerRequest, testResponse, response.Credits, error](httpCli, serverURL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	_, _, err := endpoint.CallCtx(ctx, headerRequest{})
+	if !IsTimeoutError(err) {
+		t.Fatalf("expected TimeoutError, got %v", err)
+	}
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded in chain, got %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("deadline did not abort the request, took %s", elapsed)
+	}
+}
+
+func TestClient_CtxMethods(t *testing.T) {
+	serverURL := mockServerWithRequest(t, http.StatusOK, 99, 1, `{"symbol":"AAPL","name":"Apple Inc"}`, expectedRequest{
+		Method: http.MethodGet,
+		URL:    "/quote?apikey=demo&symbol=AAPL",
+	})
+
+	cfg := &Conf{BaseURL: serverURL, Timeout: 1, CoreData: CoreData{QuotesURL: "/quote"}}
+	cli := NewClient(newTestHTTPCli(serverURL), cfg)
+
+	resp, creds, err := cli.GetQuoteCtx(context.Background(), request.GetQuote{
+		APIKey: request.APIKey{APIKey: "demo"},
+		Symbol: "AAPL",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.Symbol != "AAPL" || resp.Name != "Apple Inc" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+
+	if creds.GetCreditsLeft() != 99 || creds.GetCreditsUsed() != 1 {
+		t.Fatalf("unexpected credits: %+v", creds)
+	}
+}
@@ -1,7 +1,6 @@
 package twelvedata
 
 import (
-	"fmt"
 	"net/http"
 	"testing"
 
@@ -30,9 +29,6 @@ func Test_client_GetBalanceSheet(t *testing.T) {
 				req: request.GetBalanceSheet{
 					APIKey:     request.APIKey{APIKey: ""},
 					Symbol:     "AAPL",
-					Figi:       "BBG000B9XRY4",
-					Isin:       "US0378331005",
-					Cusip:      "037833100",
 					Exchange:   "NASDAQ",
 					MicCode:    "XNAS",
 					Country:    "US",
@@ -126,7 +122,7 @@ func Test_client_GetBalanceSheet(t *testing.T) {
 					    }
 					  ]
 					}`,
-					"/?country=US&cusip=037833100&end_date=2024-01-31&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
+					"/?country=US&end_date=2024-01-31&exchange=NASDAQ&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
 				),
 			},
 			want: response.BalanceSheets{
@@ -211,7 +207,7 @@ func Test_client_GetBalanceSheet(t *testing.T) {
 			},
 			want1:       response.NewCreditsImpl(100, 100),
 			wantErr:     "",
-			expectedURL: "/?country=US&cusip=037833100&end_date=2024-01-31&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
+			expectedURL: "/?country=US&end_date=2024-01-31&exchange=NASDAQ&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
 		},
 		{
 			name: "wrong api key",
@@ -219,9 +215,6 @@ func Test_client_GetBalanceSheet(t *testing.T) {
 				req: request.GetBalanceSheet{
 					APIKey:     request.APIKey{APIKey: ""},
 					Symbol:     "AAPL",
-					Figi:       "BBG000B9XRY4",
-					Isin:       "US0378331005",
-					Cusip:      "037833100",
 					Exchange:   "NASDAQ",
 					MicCode:    "XNAS",
 					Country:    "US",
@@ -236,7 +229,7 @@ func Test_client_GetBalanceSheet(t *testing.T) {
 					100,
 					100,
 					`{"code":401,"message":"**apikey** parameter is incorrect or not specified. You can get your free API key instantly following this link: https://twelvedata.com/pricing. If you believe that everything is correct, you can contact us at https://twelvedata.com/contact/customer","status":"error"}`,
-					"/?country=US&cusip=037833100&end_date=2024-01-31&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
+					"/?country=US&end_date=2024-01-31&exchange=NASDAQ&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
 				),
 			},
 			want:  response.BalanceSheets{},
@@ -244,7 +237,7 @@ func Test_client_GetBalanceSheet(t *testing.T) {
 			wantErr: "error received: code: 401, message: **apikey** parameter is incorrect or not specified. " +
 				"You can get your free API key instantly following this link: https://twelvedata.com/pricing. " +
 				"If you believe that everything is correct, you can contact us at https://twelvedata.com/contact/customer, status: error",
-			expectedURL: "/?country=US&cusip=037833100&end_date=2024-01-31&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
+			expectedURL: "/?country=US&end_date=2024-01-31&exchange=NASDAQ&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
 		},
 	}
 	for _, tt := range tests {
@@ -290,9 +283,6 @@ func Test_client_GetCashFlow(t *testing.T) {
 				req: request.GetCashFlow{
 					APIKey:     request.APIKey{APIKey: ""},
 					Symbol:     "AAPL",
-					Figi:       "BBG000B9XRY4",
-					Isin:       "US0378331005",
-					Cusip:      "037833100",
 					Exchange:   "NASDAQ",
 					MicCode:    "XNAS",
 					Country:    "US",
@@ -357,7 +347,7 @@ func Test_client_GetCashFlow(t *testing.T) {
 					    }
 					  ]
 					}`,
-					"/?country=US&cusip=037833100&end_date=2024-01-31&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
+					"/?country=US&end_date=2024-01-31&exchange=NASDAQ&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
 				),
 			},
 			want: response.CashFlows{
@@ -413,7 +403,7 @@ func Test_client_GetCashFlow(t *testing.T) {
 			},
 			want1:       response.NewCreditsImpl(100, 100),
 			wantErr:     "",
-			expectedURL: "/?country=US&cusip=037833100&end_date=2024-01-31&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
+			expectedURL: "/?country=US&end_date=2024-01-31&exchange=NASDAQ&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
 		},
 		{
 			name: "wrong api key",
@@ -421,9 +411,6 @@ func Test_client_GetCashFlow(t *testing.T) {
 				req: request.GetCashFlow{
 					APIKey:     request.APIKey{APIKey: ""},
 					Symbol:     "AAPL",
-					Figi:       "BBG000B9XRY4",
-					Isin:       "US0378331005",
-					Cusip:      "037833100",
 					Exchange:   "NASDAQ",
 					MicCode:    "XNAS",
 					Country:    "US",
@@ -438,7 +425,7 @@ func Test_client_GetCashFlow(t *testing.T) {
 					100,
 					100,
 					`{"code":401,"message":"**apikey** parameter is incorrect or not specified. You can get your free API key instantly following this link: https://twelvedata.com/pricing. If you believe that everything is correct, you can contact us at https://twelvedata.com/contact/customer","status":"error"}`,
-					"/?country=US&cusip=037833100&end_date=2024-01-31&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
+					"/?country=US&end_date=2024-01-31&exchange=NASDAQ&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
 				),
 			},
 			want:  response.CashFlows{},
@@ -446,7 +433,7 @@ func Test_client_GetCashFlow(t *testing.T) {
 			wantErr: "error received: code: 401, message: **apikey** parameter is incorrect or not specified. " +
 				"You can get your free API key instantly following this link: https://twelvedata.com/pricing. " +
 				"If you believe that everything is correct, you can contact us at https://twelvedata.com/contact/customer, status: error",
-			expectedURL: "/?country=US&cusip=037833100&end_date=2024-01-31&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
+			expectedURL: "/?country=US&end_date=2024-01-31&exchange=NASDAQ&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
 		},
 	}
 	for _, tt := range tests {
@@ -492,9 +479,6 @@ func Test_client_GetDividends(t *testing.T) {
 				req: request.GetDividends{
 					APIKey:    request.APIKey{APIKey: ""},
 					Symbol:    "AAPL",
-					Figi:      "BBG000B9XRY4",
-					Isin:      "US0378331005",
-					Cusip:     "037833100",
 					Exchange:  "NASDAQ",
 					MicCode:   "XNAS",
 					Country:   "US",
@@ -524,7 +508,7 @@ func Test_client_GetDividends(t *testing.T) {
 					    }
 					  ]
 					}`,
-					"/?adjust=true&country=US&cusip=037833100&end_date=2024-01-31&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&range=1y&start_date=2024-01-01&symbol=AAPL",
+					"/?adjust=true&country=US&end_date=2024-01-31&exchange=NASDAQ&mic_code=XNAS&range=1y&start_date=2024-01-01&symbol=AAPL",
 				),
 			},
 			want: response.Dividends{
@@ -545,7 +529,7 @@ func Test_client_GetDividends(t *testing.T) {
 			},
 			want1:       response.NewCreditsImpl(100, 100),
 			wantErr:     "",
-			expectedURL: "/?adjust=true&country=US&cusip=037833100&end_date=2024-01-31&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&range=1y&start_date=2024-01-01&symbol=AAPL",
+			expectedURL: "/?adjust=true&country=US&end_date=2024-01-31&exchange=NASDAQ&mic_code=XNAS&range=1y&start_date=2024-01-01&symbol=AAPL",
 		},
 		{
 			name: "wrong api key",
@@ -553,9 +537,6 @@ func Test_client_GetDividends(t *testing.T) {
 				req: request.GetDividends{
 					APIKey:    request.APIKey{APIKey: ""},
 					Symbol:    "AAPL",
-					Figi:      "BBG000B9XRY4",
-					Isin:      "US0378331005",
-					Cusip:     "037833100",
 					Exchange:  "NASDAQ",
 					MicCode:   "XNAS",
 					Country:   "US",
@@ -570,13 +551,13 @@ func Test_client_GetDividends(t *testing.T) {
 					100,
 					100,
 					`{"code":401,"message":"**apikey** parameter is incorrect or not specified. You can get your free API key instantly following this link: https://twelvedata.com/pricing. If you believe that everything is correct, you can contact us at https://twelvedata.com/contact/customer","status":"error"}`,
-					"/?adjust=true&country=US&cusip=037833100&end_date=2024-01-31&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&range=1y&start_date=2024-01-01&symbol=AAPL",
+					"/?adjust=true&country=US&end_date=2024-01-31&exchange=NASDAQ&mic_code=XNAS&range=1y&start_date=2024-01-01&symbol=AAPL",
 				),
 			},
 			want:        response.Dividends{},
 			want1:       response.NewCreditsImpl(100, 100),
 			wantErr:     "error received: code: 401, message: **apikey** parameter is incorrect or not specified. You can get your free API key instantly following this link: https://twelvedata.com/pricing. If you believe that everything is correct, you can contact us at https://twelvedata.com/contact/customer, status: error",
-			expectedURL: "/?adjust=true&country=US&cusip=037833100&end_date=2024-01-31&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&range=1y&start_date=2024-01-01&symbol=AAPL",
+			expectedURL: "/?adjust=true&country=US&end_date=2024-01-31&exchange=NASDAQ&mic_code=XNAS&range=1y&start_date=2024-01-01&symbol=AAPL",
 		},
 	}
 	for _, tt := range tests {
@@ -1346,9 +1327,6 @@ func Test_client_GetIncomeStatement(t *testing.T) {
 				req: request.GetIncomeStatement{
 					APIKey:     request.APIKey{APIKey: ""},
 					Symbol:     "AAPL",
-					Figi:       "BBG000B9XRY4",
-					Isin:       "US0378331005",
-					Cusip:      "037833100",
 					Exchange:   "NASDAQ",
 					MicCode:    "XNAS",
 					Country:    "US",
@@ -1405,7 +1383,7 @@ func Test_client_GetIncomeStatement(t *testing.T) {
 					    }
 					  ]
 					}`,
-					"/?country=US&cusip=037833100&end_date=2024-01-31&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
+					"/?country=US&end_date=2024-01-31&exchange=NASDAQ&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
 				),
 			},
 			want: response.IncomeStatements{
@@ -1453,7 +1431,7 @@ func Test_client_GetIncomeStatement(t *testing.T) {
 			},
 			want1:       response.NewCreditsImpl(100, 100),
 			wantErr:     "",
-			expectedURL: "/?country=US&cusip=037833100&end_date=2024-01-31&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
+			expectedURL: "/?country=US&end_date=2024-01-31&exchange=NASDAQ&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
 		},
 		{
 			name: "wrong api key",
@@ -1461,9 +1439,6 @@ func Test_client_GetIncomeStatement(t *testing.T) {
 				req: request.GetIncomeStatement{
 					APIKey:     request.APIKey{APIKey: ""},
 					Symbol:     "AAPL",
-					Figi:       "BBG000B9XRY4",
-					Isin:       "US0378331005",
-					Cusip:      "037833100",
 					Exchange:   "NASDAQ",
 					MicCode:    "XNAS",
 					Country:    "US",
@@ -1478,7 +1453,7 @@ func Test_client_GetIncomeStatement(t *testing.T) {
 					100,
 					100,
 					`{"code":401,"message":"**apikey** parameter is incorrect or not specified. You can get your free API key instantly following this link: https://twelvedata.com/pricing. If you believe that everything is correct, you can contact us at https://twelvedata.com/contact/customer","status":"error"}`,
-					"/?country=US&cusip=037833100&end_date=2024-01-31&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
+					"/?country=US&end_date=2024-01-31&exchange=NASDAQ&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
 				),
 			},
 			want:  response.IncomeStatements{},
@@ -1486,7 +1461,7 @@ func Test_client_GetIncomeStatement(t *testing.T) {
 			wantErr: "error received: code: 401, message: **apikey** parameter is incorrect or not specified. " +
 				"You can get your free API key instantly following this link: https://twelvedata.com/pricing. " +
 				"If you believe that everything is correct, you can contact us at https://twelvedata.com/contact/customer, status: error",
-			expectedURL: "/?country=US&cusip=037833100&end_date=2024-01-31&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
+			expectedURL: "/?country=US&end_date=2024-01-31&exchange=NASDAQ&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL",
 		},
 	}
 	for _, tt := range tests {
@@ -1835,9 +1810,6 @@ func Test_client_GetProfile(t *testing.T) {
 						APIKey: "",
 					},
 					Symbol:   "AAPL",
-					Figi:     "BBG000B9XRY4",
-					Isin:     "US0378331005",
-					Cusip:    "037833100",
 					Exchange: "NASDAQ",
 					MicCode:  "XNAS",
 					Country:  "US",
@@ -1867,7 +1839,7 @@ func Test_client_GetProfile(t *testing.T) {
 					  "country": "US",
 					  "phone": "408-996-1010"
 					}`,
-					"/?country=US&cusip=037833100&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&symbol=AAPL",
+					"/?country=US&exchange=NASDAQ&mic_code=XNAS&symbol=AAPL",
 				),
 			},
 			want: response.Profile{
@@ -1892,7 +1864,7 @@ func Test_client_GetProfile(t *testing.T) {
 			},
 			want1:       response.NewCreditsImpl(100, 100),
 			wantErr:     "",
-			expectedURL: "/?country=US&cusip=037833100&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&symbol=AAPL",
+			expectedURL: "/?country=US&exchange=NASDAQ&mic_code=XNAS&symbol=AAPL",
 		},
 		{
 			name: "wrong api key",
@@ -1902,9 +1874,6 @@ func Test_client_GetProfile(t *testing.T) {
 						APIKey: "",
 					},
 					Symbol:   "AAPL",
-					Figi:     "BBG000B9XRY4",
-					Isin:     "US0378331005",
-					Cusip:    "037833100",
 					Exchange: "NASDAQ",
 					MicCode:  "XNAS",
 					Country:  "US",
@@ -1915,7 +1884,7 @@ func Test_client_GetProfile(t *testing.T) {
 					100,
 					100,
 					`{"code":401,"message":"**apikey** parameter is incorrect or not specified. You can get your free API key instantly following this link: https://twelvedata.com/pricing. If you believe that everything is correct, you can contact us at https://twelvedata.com/contact/customer","status":"error"}`,
-					"/?country=US&cusip=037833100&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&symbol=AAPL",
+					"/?country=US&exchange=NASDAQ&mic_code=XNAS&symbol=AAPL",
 				),
 			},
 			want:  response.Profile{},
@@ -1923,7 +1892,7 @@ func Test_client_GetProfile(t *testing.T) {
 			wantErr: "error received: code: 401, message: **apikey** parameter is incorrect or not specified. " +
 				"You can get your free API key instantly following this link: https://twelvedata.com/pricing. " +
 				"If you believe that everything is correct, you can contact us at https://twelvedata.com/contact/customer, status: error",
-			expectedURL: "/?country=US&cusip=037833100&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&symbol=AAPL",
+			expectedURL: "/?country=US&exchange=NASDAQ&mic_code=XNAS&symbol=AAPL",
 		},
 	}
 	for _, tt := range tests {
@@ -1971,9 +1940,6 @@ func Test_client_GetStatistics(t *testing.T) {
 						APIKey: "",
 					},
 					Symbol:   "AAPL",
-					Figi:     "BBG000B9XRY4",
-					Isin:     "US0378331005",
-					Cusip:    "037833100",
 					Exchange: "NASDAQ",
 					MicCode:  "XNAS",
 					Country:  "US",
@@ -2070,7 +2036,7 @@ func Test_client_GetStatistics(t *testing.T) {
 					    }
 					  }
 					}`,
-					"/?country=US&cusip=037833100&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&symbol=AAPL",
+					"/?country=US&exchange=NASDAQ&mic_code=XNAS&symbol=AAPL",
 				),
 			},
 			want: response.Statistics{
@@ -2162,7 +2128,7 @@ func Test_client_GetStatistics(t *testing.T) {
 			},
 			want1:       response.NewCreditsImpl(100, 100),
 			wantErr:     "",
-			expectedURL: "/?country=US&cusip=037833100&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&symbol=AAPL",
+			expectedURL: "/?country=US&exchange=NASDAQ&mic_code=XNAS&symbol=AAPL",
 		},
 		{
 			name: "wrong api key",
@@ -2172,9 +2138,6 @@ func Test_client_GetStatistics(t *testing.T) {
 						APIKey: "",
 					},
 					Symbol:   "AAPL",
-					Figi:     "BBG000B9XRY4",
-					Isin:     "US0378331005",
-					Cusip:    "037833100",
 					Exchange: "NASDAQ",
 					MicCode:  "XNAS",
 					Country:  "US",
@@ -2185,7 +2148,7 @@ func Test_client_GetStatistics(t *testing.T) {
 					100,
 					100,
 					`{"code":401,"message":"**apikey** parameter is incorrect or not specified. You can get your free API key instantly following this link: https://twelvedata.com/pricing. If you believe that everything is correct, you can contact us at https://twelvedata.com/contact/customer","status":"error"}`,
-					"/?country=US&cusip=037833100&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&symbol=AAPL",
+					"/?country=US&exchange=NASDAQ&mic_code=XNAS&symbol=AAPL",
 				),
 			},
 			want:  response.Statistics{},
@@ -2193,7 +2156,7 @@ func Test_client_GetStatistics(t *testing.T) {
 			wantErr: "error received: code: 401, message: **apikey** parameter is incorrect or not specified. " +
 				"You can get your free API key instantly following this link: https://twelvedata.com/pricing. " +
 				"If you believe that everything is correct, you can contact us at https://twelvedata.com/contact/customer, status: error",
-			expectedURL: "/?country=US&cusip=037833100&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&symbol=AAPL",
+			expectedURL: "/?country=US&exchange=NASDAQ&mic_code=XNAS&symbol=AAPL",
 		},
 	}
 
@@ -2241,9 +2204,6 @@ func Test_client_GetEarnings(t *testing.T) {
 						APIKey: "",
 					},
 					Symbol:        "AAPL",
-					Figi:          "BBG000B9XRY4",
-					Isin:          "US0378331005",
-					Cusip:         "037833100",
 					Exchange:      "NASDAQ",
 					MicCode:       "XNAS",
 					Country:       "US",
@@ -2290,7 +2250,7 @@ func Test_client_GetEarnings(t *testing.T) {
 					  ],
 					  "status": "ok"
 					}`,
-					"/?country=US&cusip=037833100&delimiter=%2C&dp=2&end_date=2024-12-31&exchange=NASDAQ&figi=BBG000B9XRY4&format=json&isin=US0378331005&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL&type=actual",
+					"/?country=US&delimiter=%2C&dp=2&end_date=2024-12-31&exchange=NASDAQ&format=json&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL&type=actual",
 				),
 			},
 			want: response.Earnings{
@@ -2324,7 +2284,7 @@ func Test_client_GetEarnings(t *testing.T) {
 			},
 			want1:       response.NewCreditsImpl(100, 20),
 			wantErr:     "",
-			expectedURL: "/?country=US&cusip=037833100&delimiter=%2C&dp=2&end_date=2024-12-31&exchange=NASDAQ&figi=BBG000B9XRY4&format=json&isin=US0378331005&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL&type=actual",
+			expectedURL: "/?country=US&delimiter=%2C&dp=2&end_date=2024-12-31&exchange=NASDAQ&format=json&mic_code=XNAS&outputsize=2&period=quarterly&start_date=2024-01-01&symbol=AAPL&type=actual",
 		},
 		{
 			name: "success with multiple parameters",
@@ -2833,9 +2793,6 @@ func Test_client_GetMarketCap(t *testing.T) {
 						APIKey: "",
 					},
 					Symbol:     "AAPL",
-					Figi:       "BBG000B9XRY4",
-					Isin:       "US0378331005",
-					Cusip:      "037833100",
 					Exchange:   "NASDAQ",
 					MicCode:    "XNAS",
 					Country:    "US",
@@ -2873,7 +2830,7 @@ func Test_client_GetMarketCap(t *testing.T) {
 						}
 					  ]
 					}`,
-					"/?country=US&cusip=037833100&end_date=2025-01-10&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&outputsize=5&page=2&start_date=2025-01-01&symbol=AAPL",
+					"/?country=US&end_date=2025-01-10&exchange=NASDAQ&mic_code=XNAS&outputsize=5&page=2&start_date=2025-01-01&symbol=AAPL",
 				),
 			},
 			want: response.MarketCap{
@@ -2902,7 +2859,7 @@ func Test_client_GetMarketCap(t *testing.T) {
 			},
 			want1:       response.NewCreditsImpl(100, 5),
 			wantErr:     "",
-			expectedURL: "/?country=US&cusip=037833100&end_date=2025-01-10&exchange=NASDAQ&figi=BBG000B9XRY4&isin=US0378331005&mic_code=XNAS&outputsize=5&page=2&start_date=2025-01-01&symbol=AAPL",
+			expectedURL: "/?country=US&end_date=2025-01-10&exchange=NASDAQ&mic_code=XNAS&outputsize=5&page=2&start_date=2025-01-01&symbol=AAPL",
 		},
 		{
 			name: "success with additional parameters",
@@ -3032,7 +2989,7 @@ func Test_client_GetLastChange(t *testing.T) {
 					APIKey: request.APIKey{
 						APIKey: "",
 					},
-					Endpoint:   "time_series",
+					Endpoint:   request.LastChangeStatistics,
 					Symbol:     "AAPL",
 					MicCode:    "XNAS",
 					StartDate:  "2024-01-01",
@@ -3045,7 +3002,7 @@ func Test_client_GetLastChange(t *testing.T) {
 					100,
 					50,
 					`{"pagination":{"current_page":1,"per_page":30},"data":[]}`,
-					"/last_change/time_series?mic_code=XNAS&outputsize=30&page=1&start_date=2024-01-01&symbol=AAPL",
+					"/last_change/statistics?mic_code=XNAS&outputsize=30&page=1&start_date=2024-01-01&symbol=AAPL",
 				),
 			},
 			want: response.LastChange{
@@ -3057,16 +3014,16 @@ func Test_client_GetLastChange(t *testing.T) {
 			},
 			want1:       response.NewCreditsImpl(100, 50),
 			wantErr:     "",
-			expectedURL: "/last_change/time_series?mic_code=XNAS&outputsize=30&page=1&start_date=2024-01-01&symbol=AAPL",
+			expectedURL: "/last_change/statistics?mic_code=XNAS&outputsize=30&page=1&start_date=2024-01-01&symbol=AAPL",
 		},
 		{
-			name: "success with quote endpoint",
+			name: "success with profile endpoint",
 			args: args{
 				req: request.GetLastChange{
 					APIKey: request.APIKey{
 						APIKey: "",
 					},
-					Endpoint:   "quote",
+					Endpoint:   request.LastChangeProfile,
 					Symbol:     "MSFT",
 					Exchange:   "NASDAQ",
 					MicCode:    "XNAS",
@@ -3079,8 +3036,8 @@ func Test_client_GetLastChange(t *testing.T) {
 					http.StatusOK,
 					90,
 					50,
-					`{"pagination":{"current_page":1,"per_page":30},"data":[{"symbol":"MSFT","exchange":"NASDAQ","mic_code":"XNAS","country":"United States","endpoint":"quote","last_change":"2025-08-21T10:30:00Z","change_type":"update","description":"Quote data updated","timestamp":"2025-08-21T10:30:00Z"}]}`,
-					"/last_change/quote?country=US&exchange=NASDAQ&mic_code=XNAS&outputsize=30&page=1&symbol=MSFT",
+					`{"pagination":{"current_page":1,"per_page":30},"data":[{"symbol":"MSFT","exchange":"NASDAQ","mic_code":"XNAS","country":"United States","endpoint":"profile","last_change":"2025-08-21T10:30:00Z","change_type":"update","description":"Profile data updated","timestamp":"2025-08-21T10:30:00Z"}]}`,
+					"/last_change/profile?country=US&exchange=NASDAQ&mic_code=XNAS&outputsize=30&page=1&symbol=MSFT",
 				),
 			},
 			want: response.LastChange{
@@ -3094,17 +3051,17 @@ func Test_client_GetLastChange(t *testing.T) {
 						Exchange:    "NASDAQ",
 						MicCode:     "XNAS",
 						Country:     "United States",
-						Endpoint:    "quote",
+						Endpoint:    "profile",
 						LastChange:  "2025-08-21T10:30:00Z",
 						ChangeType:  "update",
-						Description: "Quote data updated",
+						Description: "Profile data updated",
 						Timestamp:   "2025-08-21T10:30:00Z",
 					},
 				},
 			},
 			want1:       response.NewCreditsImpl(90, 50),
 			wantErr:     "",
-			expectedURL: "/last_change/quote?country=US&exchange=NASDAQ&mic_code=XNAS&outputsize=30&page=1&symbol=MSFT",
+			expectedURL: "/last_change/profile?country=US&exchange=NASDAQ&mic_code=XNAS&outputsize=30&page=1&symbol=MSFT",
 		},
 		{
 			name: "error - invalid endpoint parameter",
@@ -3119,8 +3076,8 @@ func Test_client_GetLastChange(t *testing.T) {
 				url: invalidEndpointURL,
 			},
 			want:        response.LastChange{},
-			want1:       response.NewCreditsImpl(100, 50),
-			wantErr:     fmt.Sprintf("HTTP 400 Bad Request: code: 400, message: Invalid endpoint parameter: invalid_endpoint, status: error (URL: %s/last_change/invalid_endpoint?symbol=AAPL)", invalidEndpointURL),
+			want1:       nil,
+			wantErr:     request.GetLastChange{Endpoint: "invalid_endpoint", Symbol: "AAPL"}.Validate().Error(),
 			expectedURL: "/last_change/invalid_endpoint?symbol=AAPL",
 		},
 	}
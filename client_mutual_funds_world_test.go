@@ -629,9 +629,6 @@ func Test_client_GetMutualFundFullData(t *testing.T) {
 		request.GetMutualFundFullData{
 			APIKey:        request.APIKey{APIKey: ""},
 			Symbol:        "0P0001LCQ3",
-			FIGI:          "BBG00HMMLCH1",
-			ISIN:          "LU1206782309",
-			CUSIP:         "120678230",
 			Country:       "United States",
 			DecimalPlaces: 5,
 		},
@@ -816,7 +813,7 @@ func Test_client_GetMutualFundFullData(t *testing.T) {
 			},
 			"status": "ok"
 		}`,
-		"/?country=United+States&cusip=120678230&dp=5&figi=BBG00HMMLCH1&isin=LU1206782309&symbol=0P0001LCQ3",
+		"/?country=United+States&dp=5&symbol=0P0001LCQ3",
 		func(httpCli *HTTPCli, url string) interface{} {
 			return client{
 				getMutualFundFullData: NewEndpoint[request.GetMutualFundFullData, response.MutualFundFullData, response.Credits, error](httpCli, url),
@@ -29,12 +29,8 @@ func Test_client_GetPressReleases(t *testing.T) {
 			name: "success with all filters",
 			args: args{
 				req: request.GetPressReleases{
-					APIKey: request.APIKey{APIKey: ""},
-					Symbol: "AAPL",
-					Figi:   "BBG000B9Y5X2",
-					Isin:   "US0378331005",
-					Cusip:  "037833100",
-
+					APIKey:     request.APIKey{APIKey: ""},
+					Symbol:     "AAPL",
 					Exchange:   "NASDAQ",
 					MicCode:    "XNAS",
 					StartDate:  "2025-12-01T00:00:00",
@@ -61,7 +57,7 @@ func Test_client_GetPressReleases(t *testing.T) {
 					  ],
 					  "status": "ok"
 					}`,
-					"/?cusip=037833100&end_date=2025-12-31T23%3A59%3A00&exchange=NASDAQ&figi=BBG000B9Y5X2&isin=US0378331005&language=en%2Cen-US&mic_code=XNAS&outputsize=3&start_date=2025-12-01T00%3A00%3A00&symbol=AAPL&timezone=America%2FNew_York",
+					"/?end_date=2025-12-31T23%3A59%3A00&exchange=NASDAQ&language=en%2Cen-US&mic_code=XNAS&outputsize=3&start_date=2025-12-01T00%3A00%3A00&symbol=AAPL&timezone=America%2FNew_York",
 				),
 			},
 			want: response.PressReleases{
@@ -79,7 +75,7 @@ func Test_client_GetPressReleases(t *testing.T) {
 			},
 			want1:       response.NewCreditsImpl(100, 50),
 			wantErr:     "",
-			expectedURL: "/?cusip=037833100&end_date=2025-12-31T23%3A59%3A00&exchange=NASDAQ&figi=BBG000B9Y5X2&isin=US0378331005&language=en%2Cen-US&mic_code=XNAS&outputsize=3&start_date=2025-12-01T00%3A00%3A00&symbol=AAPL&timezone=America%2FNew_York",
+			expectedURL: "/?end_date=2025-12-31T23%3A59%3A00&exchange=NASDAQ&language=en%2Cen-US&mic_code=XNAS&outputsize=3&start_date=2025-12-01T00%3A00%3A00&symbol=AAPL&timezone=America%2FNew_York",
 		},
 		{
 			name: "success with figi only and empty list",
@@ -187,7 +183,7 @@ func TestNewClient_GetPressReleases(t *testing.T) {
 		},
 	}
 	logger := zerolog.Nop()
-	httpCli := NewHTTPCli(&fasthttp.Client{}, cfg, &logger)
+	httpCli := withTestTransport(NewHTTPCli(&fasthttp.Client{}, cfg, &logger))
 	cli := NewClient(httpCli, cfg)
 
 	got, credits, err := cli.GetPressReleases(request.GetPressReleases{
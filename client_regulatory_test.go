@@ -147,9 +147,6 @@ func Test_client_GetInsiderTransactions(t *testing.T) {
 				req: request.GetInsiderTransactions{
 					APIKey:   request.APIKey{APIKey: ""},
 					Symbol:   "AAPL",
-					Figi:     "BBG01293F5X4",
-					Isin:     "US0378331005",
-					Cusip:    "594918104",
 					Exchange: "NASDAQ",
 					MicCode:  "XNAS",
 					Country:  "United States",
@@ -180,7 +177,7 @@ func Test_client_GetInsiderTransactions(t *testing.T) {
 					    }
 					  ]
 					}`,
-					"/insider_transactions?country=United+States&cusip=594918104&exchange=NASDAQ&figi=BBG01293F5X4&isin=US0378331005&mic_code=XNAS&symbol=AAPL",
+					"/insider_transactions?country=United+States&exchange=NASDAQ&mic_code=XNAS&symbol=AAPL",
 				),
 			},
 			want: response.InsiderTransactions{
@@ -252,9 +249,6 @@ func Test_client_GetInstitutionalHolders(t *testing.T) {
 				req: request.GetInstitutionalHolders{
 					APIKey:   request.APIKey{APIKey: ""},
 					Symbol:   "AAPL",
-					Figi:     "BBG01293F5X4",
-					Isin:     "US0378331005",
-					Cusip:    "594918104",
 					Exchange: "NASDAQ",
 					MicCode:  "XNAS",
 					Country:  "United States",
@@ -283,7 +277,7 @@ func Test_client_GetInstitutionalHolders(t *testing.T) {
 					    }
 					  ]
 					}`,
-					"/institutional_holders?country=United+States&cusip=594918104&exchange=NASDAQ&figi=BBG01293F5X4&isin=US0378331005&mic_code=XNAS&symbol=AAPL",
+					"/institutional_holders?country=United+States&exchange=NASDAQ&mic_code=XNAS&symbol=AAPL",
 				),
 			},
 			want: response.InstitutionalHolders{
@@ -353,9 +347,6 @@ func Test_client_GetFundHolders(t *testing.T) {
 				req: request.GetFundHolders{
 					APIKey:   request.APIKey{APIKey: ""},
 					Symbol:   "AAPL",
-					Figi:     "BBG01293F5X4",
-					Isin:     "US0378331005",
-					Cusip:    "594918104",
 					Exchange: "NASDAQ",
 					MicCode:  "XNAS",
 					Country:  "United States",
@@ -384,7 +375,7 @@ func Test_client_GetFundHolders(t *testing.T) {
 					    }
 					  ]
 					}`,
-					"/fund_holders?country=United+States&cusip=594918104&exchange=NASDAQ&figi=BBG01293F5X4&isin=US0378331005&mic_code=XNAS&symbol=AAPL",
+					"/fund_holders?country=United+States&exchange=NASDAQ&mic_code=XNAS&symbol=AAPL",
 				),
 			},
 			want: response.FundHolders{
@@ -454,9 +445,6 @@ func Test_client_GetDirectHolders(t *testing.T) {
 				req: request.GetDirectHolders{
 					APIKey:   request.APIKey{APIKey: ""},
 					Symbol:   "7203",
-					Figi:     "BBG01293F5X4",
-					Isin:     "US0378331005",
-					Cusip:    "594918104",
 					Exchange: "Tadawul",
 					MicCode:  "XSAU",
 					Country:  "Saudi Arabia",
@@ -485,7 +473,7 @@ func Test_client_GetDirectHolders(t *testing.T) {
 					    }
 					  ]
 					}`,
-					"/direct_holders?country=Saudi+Arabia&cusip=594918104&exchange=Tadawul&figi=BBG01293F5X4&isin=US0378331005&mic_code=XSAU&symbol=7203",
+					"/direct_holders?country=Saudi+Arabia&exchange=Tadawul&mic_code=XSAU&symbol=7203",
 				),
 			},
 			want: response.DirectHolders{
@@ -554,9 +542,6 @@ func Test_client_GetTaxInformation(t *testing.T) {
 				req: request.GetTaxInformation{
 					APIKey:   request.APIKey{APIKey: ""},
 					Symbol:   "SKYQ",
-					Figi:     "BBG019XJT9D6",
-					Cusip:    "594918104",
-					Isin:     "US5949181045",
 					Exchange: "Nasdaq",
 					MicCode:  "XNAS",
 				},
@@ -578,7 +563,7 @@ func Test_client_GetTaxInformation(t *testing.T) {
 					  },
 					  "status": "ok"
 					}`,
-					"/tax_info?cusip=594918104&exchange=Nasdaq&figi=BBG019XJT9D6&isin=US5949181045&mic_code=XNAS&symbol=SKYQ",
+					"/tax_info?exchange=Nasdaq&mic_code=XNAS&symbol=SKYQ",
 				),
 			},
 			want: response.TaxInformation{
@@ -22,17 +22,14 @@ func technicalIndicatorParams(extra url.Values) url.Values {
 	params := url.Values{
 		"adjust":         []string{"splits"},
 		"country":        []string{"United States"},
-		"cusip":          []string{"037833100"},
 		"date":           []string{"2024-01-02"},
 		"delimiter":      []string{"comma"},
 		"dp":             []string{"4"},
 		"end_date":       []string{"2024-02-01"},
 		"exchange":       []string{"NASDAQ"},
-		"figi":           []string{"BBG000B9XRY4"},
 		"format":         []string{"json"},
 		"include_ohlc":   []string{"true"},
 		"interval":       []string{"1day"},
-		"isin":           []string{"US0378331005"},
 		"mic_code":       []string{"XNGS"},
 		"order":          []string{"asc"},
 		"outputsize":     []string{"120"},
@@ -59,9 +56,6 @@ func Test_client_GetBBands(t *testing.T) {
 				req: request.GetBBands{
 					APIKey:             request.APIKey{APIKey: ""},
 					Symbol:             "AAPL",
-					FIGI:               "BBG000B9XRY4",
-					ISIN:               "US0378331005",
-					CUSIP:              "037833100",
 					Interval:           "1day",
 					Exchange:           "NASDAQ",
 					MICCode:            "XNGS",
@@ -184,9 +178,6 @@ func Test_client_GetSMA(t *testing.T) {
 				req: request.GetSMA{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
@@ -297,9 +288,6 @@ func Test_client_GetEMA(t *testing.T) {
 				req: request.GetEMA{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
@@ -410,9 +398,6 @@ func Test_client_GetMACD(t *testing.T) {
 				req: request.GetMACD{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
@@ -535,9 +520,6 @@ func Test_client_GetRSI(t *testing.T) {
 				req: request.GetRSI{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
@@ -648,9 +630,6 @@ func Test_client_GetATR(t *testing.T) {
 				req: request.GetATR{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
@@ -757,9 +736,6 @@ func Test_client_GetCCI(t *testing.T) {
 				req: request.GetCCI{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
@@ -866,9 +842,6 @@ func Test_client_GetDEMA(t *testing.T) {
 				req: request.GetDEMA{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
@@ -979,9 +952,6 @@ func Test_client_GetKAMA(t *testing.T) {
 				req: request.GetKAMA{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
@@ -1092,9 +1062,6 @@ func Test_client_GetMA(t *testing.T) {
 				req: request.GetMA{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
@@ -1209,9 +1176,6 @@ func Test_client_GetSAR(t *testing.T) {
 				req: request.GetSAR{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
@@ -1322,9 +1286,6 @@ func Test_client_GetTEMA(t *testing.T) {
 				req: request.GetTEMA{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
@@ -1435,9 +1396,6 @@ func Test_client_GetTRMA(t *testing.T) {
 				req: request.GetTRMA{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
@@ -1548,9 +1506,6 @@ func Test_client_GetVWAP(t *testing.T) {
 				req: request.GetVWAP{
 					APIKey:             request.APIKey{APIKey: ""},
 					Symbol:             "AAPL",
-					FIGI:               "BBG000B9XRY4",
-					ISIN:               "US0378331005",
-					CUSIP:              "037833100",
 					Interval:           "1day",
 					Exchange:           "NASDAQ",
 					MICCode:            "XNGS",
@@ -1665,9 +1620,6 @@ func Test_client_GetWMA(t *testing.T) {
 				req: request.GetWMA{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
@@ -1778,9 +1730,6 @@ func Test_client_GetADX(t *testing.T) {
 				req: request.GetADX{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
@@ -1887,9 +1836,6 @@ func Test_client_GetStoch(t *testing.T) {
 				req: request.GetStoch{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
@@ -2014,9 +1960,6 @@ func Test_client_GetPercentB(t *testing.T) {
 				req: request.GetPercentB{
 					APIKey:             request.APIKey{APIKey: ""},
 					Symbol:             "AAPL",
-					FIGI:               "BBG000B9XRY4",
-					ISIN:               "US0378331005",
-					CUSIP:              "037833100",
 					Interval:           "1day",
 					Exchange:           "NASDAQ",
 					MICCode:            "XNGS",
@@ -2135,9 +2078,6 @@ func Test_client_GetWillR(t *testing.T) {
 				req: request.GetWillR{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
@@ -2244,9 +2184,6 @@ func Test_client_GetROC(t *testing.T) {
 				req: request.GetROC{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
@@ -2357,9 +2294,6 @@ func Test_client_GetMOM(t *testing.T) {
 				req: request.GetMOM{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
@@ -2470,9 +2404,6 @@ func Test_client_GetOBV(t *testing.T) {
 				req: request.GetOBV{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
@@ -2579,9 +2510,6 @@ func Test_client_GetAD(t *testing.T) {
 				req: request.GetAD{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
@@ -2680,9 +2608,6 @@ func Test_client_GetNATR(t *testing.T) {
 				req: request.GetNATR{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
@@ -2789,9 +2714,6 @@ func Test_client_GetTR(t *testing.T) {
 				req: request.GetTR{
 					APIKey:        request.APIKey{APIKey: ""},
 					Symbol:        "AAPL",
-					FIGI:          "BBG000B9XRY4",
-					ISIN:          "US0378331005",
-					CUSIP:         "037833100",
 					Interval:      "1day",
 					Exchange:      "NASDAQ",
 					MICCode:       "XNGS",
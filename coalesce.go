@@ -0,0 +1,65 @@
+package twelvedata
+
+import (
+	"context"
+	"errors"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// WithCoalescing makes identical concurrent calls share one upstream request. Calls are identical when their
+// method, resolved URL, body and API key match. Every caller gets the same response or error, and only the caller that
+// sent the request reports the credits used, so credits are attributed once.
+// The shared request runs with the context of the first caller, later callers stop waiting when their own
+// context is done.
+func WithCoalescing() HTTPCliOption {
+	return func(c *HTTPCli) {
+		c.flights = &singleflight.Group{}
+	}
+}
+
+// coalesce fetches a built request, joining an identical in-flight request when coalescing is enabled.
+// shared reports whether the result came from a request sent by another caller.
+func (endpoint Endpoint[Request, Response, Credits, ErrorResponse]) coalesce(
+	ctx context.Context,
+	req Request,
+	built builtRequest,
+	cost int64,
+) (result upstreamResult, shared bool, err error) {
+	flights := endpoint.httpCli.flights
+	if flights == nil {
+		result, err = endpoint.fetch(ctx, req, built, cost)
+
+		return result, false, err
+	}
+
+	key := built.method + " " + built.uri.String() + "\n" + string(built.body)
+	if hash := apiKeyHash(built.values, built.headers); hash != "" {
+		key += "#" + hash
+	}
+
+	var leader bool
+
+	ch := flights.DoChan(key, func() (any, error) {
+		leader = true
+
+		return endpoint.fetch(ctx, req, built, cost)
+	})
+
+	select {
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return result, false, &TimeoutError{Message: ctx.Err().Error(), Cause: ctx.Err()}
+		}
+
+		return result, false, ctx.Err()
+	case res := <-ch:
+		result, _ = res.Val.(upstreamResult)
+
+		if !leader {
+			result.creditsUsed, result.creditsRequest = 0, 0
+		}
+
+		return result, !leader, res.Err
+	}
+}
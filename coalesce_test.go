@@ -0,0 +1,159 @@
+package twelvedata
+
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+// newBlockingServer answers every request with body once release is closed.
+func newBlockingServer(t *testing.T, calls *atomic.Int32, release <-chan struct{}, body string) string {
+	t.Helper()
+
+	return newTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+
+		<-release
+
+		w.Header().Set("Api-credits-left", "90")
+		w.Header().Set("Api-credits-used", "1")
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+type quoteResult struct {
+	quote response.Quote
+	creds response.Credits
+	err   error
+}
+
+func callQuotes(cli Client, symbols ...string) []quoteResult {
+	results := make([]quoteResult, len(symbols))
+
+	var wg sync.WaitGroup
+
+	for i, symbol := range symbols {
+		wg.Go(func() {
+			quote, creds, err := cli.GetQuote(request.GetQuote{Symbol: symbol})
+			results[i] = quoteResult{quote: quote, creds: creds, err: err}
+		})
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+func TestCoalescing_SharesOneRequest(t *testing.T) {
+	var calls atomic.Int32
+
+	release := make(chan struct{})
+	serverURL := newBlockingServer(t, &calls, release, `{"symbol":"AAPL","name":"Apple Inc"}`)
+	cli := newTestClient(t, serverURL, quoteConf, WithCoalescing())
+
+	time.AfterFunc(200*time.Millisecond, func() { close(release) })
+
+	results := callQuotes(cli, "AAPL", "AAPL", "AAPL", "AAPL", "AAPL")
+
+	if calls.Load() != 1 {
+		t.Fatalf("expected 1 upstream call, got %d", calls.Load())
+	}
+
+	var creditsUsed int64
+
+	for _, result := range results {
+		if result.err != nil {
+			t.Fatalf("unexpected error: %v", result.err)
+		}
+
+		if result.quote.Name != "Apple Inc" || result.creds.GetCreditsLeft() != 90 {
+			t.Errorf("unexpected result: %+v, %+v", result.quote, result.creds)
+		}
+
+		creditsUsed += result.creds.GetCreditsUsed()
+	}
+
+	if creditsUsed != 1 {
+		t.Errorf("expected credits to be attributed once, got %d in total", creditsUsed)
+	}
+}
+
+func TestCoalescing_SharesErrors(t *testing.T) {
+	var calls atomic.Int32
+
+	release := make(chan struct{})
+	serverURL := newBlockingServer(t, &calls, release,
+		`{"code":404,"message":"**symbol** not found: NOPE. Please specify it correctly","status":"error"}`)
+	cli := newTestClient(t, serverURL, quoteConf, WithCoalescing())
+
+	time.AfterFunc(200*time.Millisecond, func() { close(release) })
+
+	for _, result := range callQuotes(cli, "NOPE", "NOPE", "NOPE") {
+		if !IsSymbolNotFoundError(result.err) {
+			t.Errorf("expected SymbolNotFoundError, got %v", result.err)
+		}
+	}
+
+	if calls.Load() != 1 {
+		t.Fatalf("expected 1 upstream call, got %d", calls.Load())
+	}
+}
+
+func TestCoalescing_DistinctRequests(t *testing.T) {
+	var calls atomic.Int32
+
+	release := make(chan struct{})
+	close(release)
+
+	serverURL := newBlockingServer(t, &calls, release, `{"symbol":"AAPL"}`)
+	cli := newTestClient(t, serverURL, quoteConf, WithCoalescing())
+
+	for _, result := range callQuotes(cli, "AAPL", "MSFT") {
+		if result.err != nil || result.creds.GetCreditsUsed() != 1 {
+			t.Errorf("unexpected result: %+v", result)
+		}
+	}
+
+	if calls.Load() != 2 {
+		t.Fatalf("expected 2 upstream calls, got %d", calls.Load())
+	}
+}
+
+func TestCoalescing_SeparatesAPIKeysInHeader(t *testing.T) {
+	var calls atomic.Int32
+
+	release := make(chan struct{})
+	serverURL := newBlockingServer(t, &calls, release, `{"symbol":"AAPL","name":"Apple Inc"}`)
+
+	conf := quoteConf
+	conf.APIKeyInHeader = true
+
+	cli := newTestClient(t, serverURL, conf, WithCoalescing())
+
+	time.AfterFunc(200*time.Millisecond, func() { close(release) })
+
+	var wg sync.WaitGroup
+
+	for _, key := range []string{"first-key", "second-key"} {
+		wg.Go(func() {
+			_, creds, err := cli.GetQuote(request.GetQuote{APIKey: request.APIKey{APIKey: key}, Symbol: "AAPL"})
+			if err != nil || creds.GetCreditsUsed() != 1 {
+				t.Errorf("unexpected result for %s: %v, %v", key, creds, err)
+			}
+		})
+	}
+
+	wg.Wait()
+
+	if calls.Load() != 2 {
+		t.Fatalf("expected 2 upstream calls, got %d", calls.Load())
+	}
+}
@@ -0,0 +1,145 @@
+package twelvedata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/soulgarden/twelvedata/dictionary"
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+const complexDataBody = `{
+  "data": [
+    {
+      "meta": {"symbol": "AAPL", "interval": "1day", "currency": "USD", "exchange": "NASDAQ", "type": "Common Stock"},
+      "values": [
+        {"datetime": "2024-01-02", "open": "187.15", "high": "188.44", "low": "183.89", "close": "185.64", "volume": "82488700"}
+      ],
+      "status": "ok"
+    },
+    {
+      "meta": {
+        "symbol": "AAPL",
+        "interval": "1day",
+        "indicator": {"name": "RSI - Relative Strength Index", "series_type": "close", "time_period": 14}
+      },
+      "values": [{"datetime": "2024-01-02", "rsi": "38.54"}],
+      "status": "ok"
+    },
+    {
+      "meta": {"symbol": "MSFT", "interval": "1day"},
+      "code": 400,
+      "message": "**symbol** not found",
+      "status": "error"
+    }
+  ],
+  "status": "ok"
+}`
+
+var complexDataConf = Conf{
+	CoreData:            CoreData{TimeSeriesURL: "/time_series"},
+	TechnicalIndicators: TechnicalIndicators{RSIURL: "/rsi"},
+	Advanced:            Advanced{ComplexDataURL: "/complex_data"},
+}
+
+func TestClient_GetComplexData(t *testing.T) {
+	serverURL := mockServerWithRequest(t, http.StatusOK, 90, 10, complexDataBody, expectedRequest{
+		Method:  http.MethodPost,
+		URL:     "/complex_data?apikey=demo",
+		Headers: map[string]string{"Content-Type": "application/json"},
+		Body: map[string]any{
+			"symbols":    []string{"AAPL", "MSFT"},
+			"intervals":  []string{"1day"},
+			"methods":    []any{"time_series", map[string]any{"name": "rsi", "time_period": 14}},
+			"outputsize": 1,
+		},
+	})
+
+	data, creds, err := newTestClient(t, serverURL, complexDataConf).GetComplexData(request.GetComplexData{
+		APIKey:    request.APIKey{APIKey: "demo"},
+		Symbols:   []string{"AAPL", "MSFT"},
+		Intervals: []request.Interval{request.Interval1Day},
+		Methods: []request.ComplexDataMethod{
+			request.ComplexTimeSeries(),
+			request.ComplexIndicator(request.RSIParams{TimePeriod: 14}),
+		},
+		OutputSize: 1,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if creds.GetCreditsUsed() != 10 {
+		t.Errorf("credits used = %d", creds.GetCreditsUsed())
+	}
+
+	aapl, ok := data.Symbol("AAPL")
+	if !ok {
+		t.Fatalf("expected AAPL in %+v", data)
+	}
+
+	daily, ok := aapl.Interval("1day")
+	if !ok || daily.TimeSeries == nil || len(daily.TimeSeries.Values) != 1 {
+		t.Fatalf("expected the daily time series of AAPL, got %+v", aapl)
+	}
+
+	if got := daily.TimeSeries.Values[0]; got.Datetime != "2024-01-02" || got.Close != response.FloatStringFrom(185.64) ||
+		got.Volume != response.FloatStringFrom(82488700) {
+		t.Errorf("unexpected value %+v", got)
+	}
+
+	rsi, ok := daily.Indicator("rsi")
+	if !ok || len(rsi.Values) != 1 || rsi.Values[0].Values["rsi"] != 38.54 {
+		t.Errorf("unexpected rsi %+v", rsi)
+	}
+
+	msft, ok := data.Symbol("MSFT")
+	if !ok || len(msft.Intervals) != 1 || len(msft.Intervals[0].Errors) != 1 ||
+		msft.Intervals[0].Errors[0].Message != "**symbol** not found" {
+		t.Errorf("expected the error of MSFT, got %+v", msft)
+	}
+}
+
+func TestClient_GetComplexDataDryRun(t *testing.T) {
+	ctx, dryRun := WithDryRun(context.Background())
+
+	_, _, err := newTestClient(t, "http://localhost", complexDataConf).GetComplexDataCtx(ctx, request.GetComplexData{
+		Symbols:   []string{"AAPL", "MSFT"},
+		Intervals: []request.Interval{request.Interval1H, request.Interval1Day},
+		Methods: []request.ComplexDataMethod{
+			request.ComplexTimeSeries(),
+			request.ComplexIndicator(request.RSIParams{}),
+			request.ComplexIndicator(request.AroonParams{}),
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	requests := dryRun.Requests()
+	want := int64(4 * (dictionary.TimeSeries + dictionary.RSI + dictionary.IndividualIndicators))
+
+	if len(requests) != 1 || requests[0].Method != http.MethodPost || requests[0].EstimatedCost != want {
+		t.Fatalf("expected one POST at cost %d, got %+v", want, requests)
+	}
+}
+
+func TestClient_GetComplexDataInvalid(t *testing.T) {
+	_, creds, err := newTestClient(t, "http://localhost", complexDataConf).GetComplexData(request.GetComplexData{
+		Intervals: []request.Interval{"1d"},
+		Methods:   []request.ComplexDataMethod{{}},
+	})
+
+	var validationErr *request.ValidationError
+	if !errors.As(err, &validationErr) || !validationErr.Has("Symbols") || !validationErr.Has("Intervals") ||
+		!validationErr.Has("Methods") {
+		t.Fatalf("expected Symbols, Intervals and Methods to be invalid, got %v", err)
+	}
+
+	if creds != nil {
+		t.Errorf("expected no credits, got %v", creds)
+	}
+}
@@ -0,0 +1,107 @@
+package twelvedata
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// costRegistry maps endpoint paths to their credit cost per symbol.
+// Paths may contain {placeholders} that match any single path segment.
+type costRegistry struct {
+	mu    sync.RWMutex
+	costs map[string]int64
+}
+
+func (r *costRegistry) register(path string, cost int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.costs == nil {
+		r.costs = make(map[string]int64)
+	}
+
+	r.costs[path] = cost
+}
+
+func (r *costRegistry) lookup(path string) (int64, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if cost, ok := r.costs[path]; ok {
+		return cost, true
+	}
+
+	for template, cost := range r.costs {
+		if strings.Contains(template, "{") && matchPathTemplate(template, path) {
+			return cost, true
+		}
+	}
+
+	return 0, false
+}
+
+// costOf returns the cost of an API URL such as "/time_series?symbol=AAPL,MSFT&interval=1min".
+// Unknown paths cost nothing.
+func (r *costRegistry) costOf(uri string) int64 {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return 0
+	}
+
+	path := parsed.Path
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	cost, ok := r.lookup(path)
+	if !ok {
+		return 0
+	}
+
+	return cost * symbolCount(parsed.Query())
+}
+
+// estimate returns the credits a request is expected to consume.
+// Multi-symbol requests are charged per symbol, batches are charged for every bundled request.
+func (r *costRegistry) estimate(base int64, req any, values url.Values) int64 {
+	if coster, ok := req.(RequestCoster); ok {
+		return base + coster.Cost(r.costOf)
+	}
+
+	return base * symbolCount(values)
+}
+
+// symbolCount returns the number of comma-separated symbols in a query, at least 1.
+func symbolCount(values url.Values) int64 {
+	var count int64
+
+	for _, symbol := range strings.Split(values.Get("symbol"), ",") {
+		if strings.TrimSpace(symbol) != "" {
+			count++
+		}
+	}
+
+	return max(count, 1)
+}
+
+func matchPathTemplate(template string, path string) bool {
+	templateParts := strings.Split(strings.Trim(template, "/"), "/")
+	pathParts := strings.Split(strings.Trim(path, "/"), "/")
+
+	if len(templateParts) != len(pathParts) {
+		return false
+	}
+
+	for i, part := range templateParts {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			continue
+		}
+
+		if part != pathParts[i] {
+			return false
+		}
+	}
+
+	return true
+}
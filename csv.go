@@ -0,0 +1,210 @@
+package twelvedata
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/guregu/null/v6"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+// defaultCSVDelimiter is the delimiter the API uses for CSV responses when none is requested.
+const defaultCSVDelimiter = ';'
+
+// csvDelimiter reports whether values request the CSV format and returns its delimiter.
+func csvDelimiter(values url.Values) (rune, bool) {
+	if !strings.EqualFold(values.Get("format"), "csv") {
+		return 0, false
+	}
+
+	delimiter, size := utf8.DecodeRuneInString(values.Get("delimiter"))
+	if size == 0 || delimiter == utf8.RuneError {
+		return defaultCSVDelimiter, true
+	}
+
+	return delimiter, true
+}
+
+// isJSONBody reports whether body holds a JSON object or array, which some endpoints send whatever the format.
+func isJSONBody(body []byte) bool {
+	body = bytes.TrimSpace(body)
+
+	return len(body) > 0 && (body[0] == '{' || body[0] == '[')
+}
+
+// decodeCSV fills target from a CSV body whose header row holds the JSON field names of target.
+// Rows fill target when it is a slice, or the first slice of structs field of a struct target, such as
+// TimeSeries.Values or Stocks.Data. Any other struct target is filled from the first row.
+// Columns without a matching field are ignored.
+func decodeCSV(body []byte, delimiter rune, target any) error {
+	reader := csv.NewReader(bytes.NewReader(body))
+	reader.Comma = delimiter
+	reader.LazyQuotes = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return err
+	}
+
+	if len(records) == 0 {
+		return nil
+	}
+
+	header, rows := records[0], records[1:]
+
+	targetType := reflect.TypeOf(target)
+	if targetType == nil || targetType.Kind() != reflect.Pointer {
+		return errors.New("target must be a pointer")
+	}
+
+	targetType = targetType.Elem()
+
+	var doc any
+
+	switch {
+	case targetType.Kind() == reflect.Slice && indirectType(targetType.Elem()).Kind() == reflect.Struct:
+		doc = csvRows(header, rows, targetType.Elem())
+	case targetType.Kind() == reflect.Struct:
+		if name, rowType, ok := csvRowsField(targetType); ok {
+			doc = map[string]any{name: csvRows(header, rows, rowType)}
+		} else if len(rows) > 0 {
+			doc = csvRow(header, rows[0], targetType)
+		}
+	default:
+		return errors.New("unsupported target " + targetType.String())
+	}
+
+	if doc == nil {
+		return nil
+	}
+
+	encoded, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(encoded, target)
+}
+
+// csvRowsField returns the JSON name and element type of the first slice of structs field of t.
+func csvRowsField(t reflect.Type) (string, reflect.Type, bool) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() || field.Type.Kind() != reflect.Slice {
+			continue
+		}
+
+		if elem := indirectType(field.Type.Elem()); elem.Kind() == reflect.Struct {
+			return jsonFieldName(field), field.Type.Elem(), true
+		}
+	}
+
+	return "", nil, false
+}
+
+func csvRows(header []string, rows [][]string, rowType reflect.Type) []map[string]json.RawMessage {
+	docs := make([]map[string]json.RawMessage, 0, len(rows))
+	for _, row := range rows {
+		docs = append(docs, csvRow(header, row, rowType))
+	}
+
+	return docs
+}
+
+// csvRow maps the cells of row to the JSON fields of rowType named by header. Cells are kept as JSON
+// literals for number and boolean fields when valid, and encoded as JSON strings for the other fields.
+func csvRow(header []string, row []string, rowType reflect.Type) map[string]json.RawMessage {
+	fields := map[string]reflect.Type{}
+
+	if rowType = indirectType(rowType); rowType.Kind() == reflect.Struct {
+		for i := range rowType.NumField() {
+			if field := rowType.Field(i); field.IsExported() {
+				fields[jsonFieldName(field)] = field.Type
+			}
+		}
+	}
+
+	doc := make(map[string]json.RawMessage, len(header))
+
+	for i, name := range header {
+		if i >= len(row) {
+			break
+		}
+
+		name = strings.TrimSpace(name)
+
+		fieldType, ok := fields[name]
+		if !ok {
+			continue
+		}
+
+		doc[name] = csvValue(row[i], fieldType)
+	}
+
+	return doc
+}
+
+func csvValue(cell string, fieldType reflect.Type) json.RawMessage {
+	if cell == "" && indirectType(fieldType).Kind() != reflect.String {
+		return json.RawMessage("null")
+	}
+
+	if csvLiteral(fieldType) && json.Valid([]byte(cell)) {
+		return json.RawMessage(cell)
+	}
+
+	encoded, _ := json.Marshal(cell) //nolint:errchkjson // a string always encodes
+
+	return encoded
+}
+
+// csvLiteralTypes are the nullable types whose cells are numbers or booleans.
+var csvLiteralTypes = map[reflect.Type]bool{
+	reflect.TypeFor[response.FloatString](): true,
+	reflect.TypeFor[null.Float]():           true,
+	reflect.TypeFor[null.Int]():             true,
+	reflect.TypeFor[null.Bool]():            true,
+}
+
+// csvLiteral reports whether the cells of fieldType are kept as JSON literals: numbers and booleans, nullable
+// ones included. Cells of other types, such as null.String, are encoded as JSON strings.
+func csvLiteral(fieldType reflect.Type) bool {
+	fieldType = indirectType(fieldType)
+	if csvLiteralTypes[fieldType] {
+		return true
+	}
+
+	switch fieldType.Kind() { //nolint:exhaustive // other kinds are strings or not CSV cells
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t
+}
+
+// jsonFieldName returns the name encoding/json uses for field.
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" {
+		return field.Name
+	}
+
+	return name
+}
@@ -0,0 +1,125 @@
+package twelvedata
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/guregu/null/v6"
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+func TestCSVDelimiter(t *testing.T) {
+	tests := []struct {
+		query  string
+		want   rune
+		wantOK bool
+	}{
+		{query: "", wantOK: false},
+		{query: "format=JSON", wantOK: false},
+		{query: "format=CSV", want: ';', wantOK: true},
+		{query: "format=csv&delimiter=,", want: ',', wantOK: true},
+		{query: "format=CSV&delimiter=%09", want: '\t', wantOK: true},
+	}
+
+	for _, tt := range tests {
+		values, err := url.ParseQuery(tt.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, ok := csvDelimiter(values)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("csvDelimiter(%q) = %q, %v, want %q, %v", tt.query, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestGetTimeSeries_CSV(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusOK, 99, 1,
+		"datetime;open;high;low;close;volume\n2024-01-03;184.22;185.88;183.43;184.25;58414500\n2024-01-02;187.15;188.44;183.89;185.64;82488700\n",
+		"/time_series?format=CSV&interval=1day&symbol=AAPL")
+
+	cli := newTestClient(t, serverURL, Conf{CoreData: CoreData{TimeSeriesURL: "/time_series"}})
+
+	series, _, err := cli.GetTimeSeries(request.GetTimeSeries{Symbol: "AAPL", Interval: "1day", Format: "CSV"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []response.TimeSeriesValue{
+		{Datetime: "2024-01-03", Open: "184.22", High: "185.88", Low: "183.43", Close: "184.25", Volume: "58414500"},
+		{Datetime: "2024-01-02", Open: "187.15", High: "188.44", Low: "183.89", Close: "185.64", Volume: "82488700"},
+	}
+
+	if len(series.Values) != len(want) {
+		t.Fatalf("expected %d values, got %+v", len(want), series.Values)
+	}
+
+	for i := range want {
+		if series.Values[i] != want[i] {
+			t.Errorf("value %d = %+v, want %+v", i, series.Values[i], want[i])
+		}
+	}
+}
+
+func TestGetStocks_CSVDelimiter(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusOK, 99, 1,
+		"symbol,name,currency,exchange,mic_code,country,type,unknown\nAAPL,\"Apple, Inc.\",USD,NASDAQ,XNGS,United States,Common Stock,x\n",
+		"/stocks?delimiter=%2C&format=CSV")
+
+	cli := newTestClient(t, serverURL, Conf{ReferenceData: ReferenceData{StocksURL: "/stocks"}})
+
+	stocks, _, err := cli.GetStocks(request.GetStock{Format: "CSV", Delimiter: ","})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(stocks.Data) != 1 || stocks.Data[0].Name != "Apple, Inc." || stocks.Data[0].MicCode != "XNGS" {
+		t.Errorf("unexpected stocks: %+v", stocks.Data)
+	}
+}
+
+func TestGetQuote_CSV(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusOK, 99, 1,
+		"symbol;name;timestamp;close;is_market_open;extended_timestamp\nAAPL;Apple Inc;1704229200;185.64;false;\n", "")
+
+	cli := newTestClient(t, serverURL, quoteConf)
+
+	quote, _, err := cli.GetQuote(request.GetQuote{Symbol: "AAPL", Format: "csv"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if quote.Symbol != "AAPL" || quote.Timestamp.Int64 != 1704229200 || quote.Close != "185.64" || quote.ExtendedTimestamp.Valid {
+		t.Errorf("unexpected quote: %+v", quote)
+	}
+}
+
+func TestGetQuote_CSVExtendedTimestamp(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusOK, 99, 1,
+		"symbol;timestamp;close;is_market_open;extended_timestamp\nAAPL;1704229200;185.64;false;1700000000\n", "")
+
+	cli := newTestClient(t, serverURL, quoteConf)
+
+	quote, _, err := cli.GetQuote(request.GetQuote{Symbol: "AAPL", Format: "CSV"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if quote.ExtendedTimestamp != null.StringFrom("1700000000") || quote.Timestamp.Int64 != 1704229200 || quote.IsMarketOpen {
+		t.Errorf("unexpected quote: %+v", quote)
+	}
+}
+
+func TestGetQuote_CSVError(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusOK, 99, 1,
+		`{"code":404,"message":"**symbol** not found: XXX. Please specify it correctly","status":"error"}`, "")
+
+	cli := newTestClient(t, serverURL, quoteConf)
+
+	if _, _, err := cli.GetQuote(request.GetQuote{Symbol: "XXX", Format: "CSV"}); !IsSymbolNotFoundError(err) {
+		t.Errorf("expected SymbolNotFoundError, got %v", err)
+	}
+}
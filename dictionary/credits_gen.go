@@ -0,0 +1,335 @@
+// Code generated by genendpoints from endpoints.json. DO NOT EDIT.
+
+package dictionary
+
+// API credit costs of the endpoints, named after their Client method without the Get prefix.
+const (
+	// Market Data.
+
+	// TimeSeries represents the API credit cost for time series data requests.
+	TimeSeries = 1
+	// TimeSeriesCross represents the API credit cost for cross-currency time series requests.
+	TimeSeriesCross = 5
+	// Quote represents the API credit cost for quote data requests.
+	Quote = 1
+	// Price represents the API credit cost for price data requests.
+	Price = 1
+	// EOD represents the API credit cost for end-of-day data requests.
+	EOD = 1
+	// MarketMovers represents the API credit cost for market movers requests.
+	MarketMovers = 100
+
+	// Reference Data - Asset Catalogs.
+
+	// Stocks represents the API credit cost for stocks catalog requests.
+	Stocks = 1
+	// ForexPairs represents the API credit cost for forex pairs catalog requests.
+	ForexPairs = 1
+	// Cryptocurrencies represents the API credit cost for cryptocurrencies catalog requests.
+	Cryptocurrencies = 1
+	// ETFs represents the API credit cost for ETFs catalog requests.
+	ETFs = 1
+	// Funds represents the API credit cost for funds catalog requests.
+	Funds = 1
+	// Commodities represents the API credit cost for commodities catalog requests.
+	Commodities = 1
+	// Bonds represents the API credit cost for bonds catalog requests.
+	Bonds = 1
+
+	// Reference Data - Discovery.
+
+	// SymbolSearch represents the API credit cost for symbol search requests.
+	SymbolSearch = 1
+	// CrossListings represents the API credit cost for cross-listings requests.
+	CrossListings = 40
+	// EarliestTimestamp represents the API credit cost for earliest timestamp requests.
+	EarliestTimestamp = 1
+
+	// Reference Data - Markets.
+
+	// Exchanges represents the API credit cost for exchanges list requests.
+	Exchanges = 1
+	// ExchangeSchedule represents the API credit cost for exchanges schedule requests.
+	ExchangeSchedule = 100
+	// CryptocurrencyExchanges represents the API credit cost for cryptocurrency exchanges requests.
+	CryptocurrencyExchanges = 1
+	// MarketState represents the API credit cost for market state requests.
+	MarketState = 1
+
+	// Reference Data - Supporting Metadata.
+
+	// Countries represents the API credit cost for countries list requests.
+	Countries = 1
+	// InstrumentType represents the API credit cost for instrument type requests.
+	InstrumentType = 1
+	// TechnicalIndicators represents the API credit cost for technical indicators list requests.
+	TechnicalIndicators = 1
+
+	// Fundamentals.
+
+	// Logo represents the API credit cost for company logo requests.
+	Logo = 1
+	// Profile represents the API credit cost for company profile requests.
+	Profile = 10
+	// Dividends represents the API credit cost for dividends data requests.
+	Dividends = 20
+	// DividendsCalendar represents the API credit cost for dividends calendar requests.
+	DividendsCalendar = 40
+	// Earnings represents the API credit cost for earnings data requests.
+	Earnings = 20
+	// EarningsCalendar represents the API credit cost for earnings calendar requests.
+	EarningsCalendar = 40
+	// IPOCalendar represents the API credit cost for IPO calendar requests.
+	IPOCalendar = 40
+	// Splits represents the API credit cost for stock splits data requests.
+	Splits = 20
+	// SplitsCalendar represents the API credit cost for stock splits calendar requests.
+	SplitsCalendar = 40
+	// Statistics represents the API credit cost for company statistics requests.
+	Statistics = 50
+	// PressReleases represents the API credit cost for press releases requests.
+	PressReleases = 50
+	// IncomeStatement represents the API credit cost for income statement requests.
+	IncomeStatement = 100
+	// IncomeStatementConsolidated represents the API credit cost for consolidated income statement requests.
+	IncomeStatementConsolidated = 100
+	// BalanceSheet represents the API credit cost for balance sheet requests.
+	BalanceSheet = 100
+	// BalanceSheetConsolidated represents the API credit cost for consolidated balance sheet requests.
+	BalanceSheetConsolidated = 100
+	// CashFlow represents the API credit cost for cash flow statement requests.
+	CashFlow = 100
+	// CashFlowConsolidated represents the API credit cost for consolidated cash flow statement requests.
+	CashFlowConsolidated = 100
+	// KeyExecutives represents the API credit cost for key executives requests.
+	KeyExecutives = 1000
+	// MarketCap represents the API credit cost for market capitalization requests.
+	MarketCap = 5
+	// LastChange represents the API credit cost for last changes requests.
+	LastChange = 50
+
+	// Currencies.
+
+	// ExchangeRate represents the API credit cost for exchange rate requests.
+	ExchangeRate = 1
+	// CurrencyConversion represents the API credit cost for currency conversion requests.
+	CurrencyConversion = 1
+
+	// ETFs.
+
+	// ETFsDirectory represents the API credit cost for ETFs directory requests.
+	ETFsDirectory = 1
+	// ETFFullData represents the API credit cost for ETF full data requests.
+	ETFFullData = 800
+	// ETFSummary represents the API credit cost for ETF summary requests.
+	ETFSummary = 50
+	// ETFPerformance represents the API credit cost for ETF performance requests.
+	ETFPerformance = 200
+	// ETFRisk represents the API credit cost for ETF risk data requests.
+	ETFRisk = 50
+	// ETFComposition represents the API credit cost for ETF composition requests.
+	ETFComposition = 100
+	// ETFFamilies represents the API credit cost for ETF families requests.
+	ETFFamilies = 10
+	// ETFTypes represents the API credit cost for ETF types requests.
+	ETFTypes = 10
+
+	// Mutual Funds.
+
+	// MutualFundsDirectory represents the API credit cost for mutual funds directory requests.
+	MutualFundsDirectory = 50
+	// MutualFundFullData represents the API credit cost for mutual fund full data requests.
+	MutualFundFullData = 1000
+	// MutualFundSummary represents the API credit cost for mutual fund summary requests.
+	MutualFundSummary = 50
+	// MutualFundPerformance represents the API credit cost for mutual fund performance requests.
+	MutualFundPerformance = 200
+	// MutualFundRisk represents the API credit cost for mutual fund risk data requests.
+	MutualFundRisk = 50
+	// MutualFundRatings represents the API credit cost for mutual fund ratings requests.
+	MutualFundRatings = 50
+	// MutualFundComposition represents the API credit cost for mutual fund composition requests.
+	MutualFundComposition = 200
+	// MutualFundPurchaseInfo represents the API credit cost for mutual fund purchase info requests.
+	MutualFundPurchaseInfo = 50
+	// MutualFundSustainability represents the API credit cost for mutual fund sustainability requests.
+	MutualFundSustainability = 50
+	// MutualFundFamilies represents the API credit cost for mutual fund families requests.
+	MutualFundFamilies = 10
+	// MutualFundTypes represents the API credit cost for mutual fund types requests.
+	MutualFundTypes = 10
+
+	// Technical Indicators.
+
+	// BBands represents the API credit cost for Bollinger Bands technical indicator requests.
+	BBands = 10
+	// SMA represents the API credit cost for Simple Moving Average technical indicator requests.
+	SMA = 10
+	// EMA represents the API credit cost for Exponential Moving Average technical indicator requests.
+	EMA = 10
+	// MA represents the API credit cost for Moving Average technical indicator requests.
+	MA = 10
+	// WMA represents the API credit cost for Weighted Moving Average technical indicator requests.
+	WMA = 10
+	// VWAP represents the API credit cost for Volume Weighted Average Price technical indicator requests.
+	VWAP = 10
+	// DEMA represents the API credit cost for Double Exponential Moving Average technical indicator requests.
+	DEMA = 10
+	// TEMA represents the API credit cost for Triple Exponential Moving Average technical indicator requests.
+	TEMA = 10
+	// TRMA represents the API credit cost for Triangular Moving Average technical indicator requests.
+	TRMA = 10
+	// KAMA represents the API credit cost for Kaufman Adaptive Moving Average technical indicator requests.
+	KAMA = 10
+	// SAR represents the API credit cost for Parabolic SAR technical indicator requests.
+	SAR = 10
+	// ADX represents the API credit cost for Average Directional Index technical indicator requests.
+	ADX = 10
+	// MACD represents the API credit cost for Moving Average Convergence Divergence technical indicator requests.
+	MACD = 10
+	// RSI represents the API credit cost for Relative Strength Index technical indicator requests.
+	RSI = 10
+	// Stoch represents the API credit cost for Stochastic Oscillator technical indicator requests.
+	Stoch = 10
+	// PercentB represents the API credit cost for %B technical indicator requests.
+	PercentB = 10
+	// CCI represents the API credit cost for Commodity Channel Index technical indicator requests.
+	CCI = 10
+	// WillR represents the API credit cost for Williams %R technical indicator requests.
+	WillR = 10
+	// ROC represents the API credit cost for Rate of Change technical indicator requests.
+	ROC = 10
+	// MOM represents the API credit cost for Momentum technical indicator requests.
+	MOM = 10
+	// OBV represents the API credit cost for On Balance Volume technical indicator requests.
+	OBV = 10
+	// AD represents the API credit cost for Accumulation/Distribution technical indicator requests.
+	AD = 10
+	// ATR represents the API credit cost for Average True Range technical indicator requests.
+	ATR = 10
+	// NATR represents the API credit cost for Normalized Average True Range technical indicator requests.
+	NATR = 10
+	// TR represents the API credit cost for True Range technical indicator requests.
+	TR = 10
+
+	// Analysis.
+
+	// EarningsEstimate represents the API credit cost for earnings estimate requests.
+	EarningsEstimate = 100
+	// RevenueEstimate represents the API credit cost for revenue estimate requests.
+	RevenueEstimate = 100
+	// EPSTrend represents the API credit cost for EPS trend requests.
+	EPSTrend = 100
+	// EPSRevisions represents the API credit cost for EPS revisions requests.
+	EPSRevisions = 100
+	// GrowthEstimates represents the API credit cost for growth estimates requests.
+	GrowthEstimates = 100
+	// Recommendations represents the API credit cost for analyst recommendations requests.
+	Recommendations = 100
+	// PriceTarget represents the API credit cost for price target requests.
+	PriceTarget = 100
+	// AnalystRatingsSnapshot represents the API credit cost for analyst ratings snapshot requests.
+	AnalystRatingsSnapshot = 200
+	// AnalystRatingsUSEquities represents the API credit cost for US equities analyst ratings requests.
+	AnalystRatingsUSEquities = 200
+
+	// Regulatory.
+
+	// EDGARFilings represents the API credit cost for EDGAR filings requests.
+	EDGARFilings = 50
+	// InsiderTransactions represents the API credit cost for insider transactions requests.
+	InsiderTransactions = 200
+	// InstitutionalHolders represents the API credit cost for institutional holders requests.
+	InstitutionalHolders = 1500
+	// FundHolders represents the API credit cost for fund holders requests.
+	FundHolders = 1500
+	// DirectHolders represents the API credit cost for direct holders requests.
+	DirectHolders = 1500
+	// TaxInformation represents the API credit cost for tax information requests.
+	TaxInformation = 50
+	// SanctionedEntities represents the API credit cost for sanctioned entities requests.
+	SanctionedEntities = 50
+
+	// Advanced.
+
+	// Usage represents the API credit cost for usage tracking requests.
+	Usage = 1
+	// Batches represents the API credit cost for batch requests.
+	// Every request inside a batch is charged at the cost of its own endpoint.
+	Batches = 0
+	// ComplexData represents the API credit cost for complex data requests.
+	// Every method is charged for every symbol and interval at the cost of its own endpoint.
+	ComplexData = 0
+)
+
+// Former names of API credit costs.
+const (
+	// ExchangesSchedule is the former name of ExchangeSchedule.
+	//
+	// Deprecated: use ExchangeSchedule.
+	ExchangesSchedule = ExchangeSchedule
+	// TechnicalIndicatorsList is the former name of TechnicalIndicators.
+	//
+	// Deprecated: use TechnicalIndicators.
+	TechnicalIndicatorsList = TechnicalIndicators
+	// MarketCapitalization is the former name of MarketCap.
+	//
+	// Deprecated: use MarketCap.
+	MarketCapitalization = MarketCap
+	// LastChanges is the former name of LastChange.
+	//
+	// Deprecated: use LastChange.
+	LastChanges = LastChange
+	// ETFsFamilies is the former name of ETFFamilies.
+	//
+	// Deprecated: use ETFFamilies.
+	ETFsFamilies = ETFFamilies
+	// ETFsTypes is the former name of ETFTypes.
+	//
+	// Deprecated: use ETFTypes.
+	ETFsTypes = ETFTypes
+	// MFsDirectory is the former name of MutualFundsDirectory.
+	//
+	// Deprecated: use MutualFundsDirectory.
+	MFsDirectory = MutualFundsDirectory
+	// MFFullData is the former name of MutualFundFullData.
+	//
+	// Deprecated: use MutualFundFullData.
+	MFFullData = MutualFundFullData
+	// MFSummary is the former name of MutualFundSummary.
+	//
+	// Deprecated: use MutualFundSummary.
+	MFSummary = MutualFundSummary
+	// MFPerformance is the former name of MutualFundPerformance.
+	//
+	// Deprecated: use MutualFundPerformance.
+	MFPerformance = MutualFundPerformance
+	// MFRisk is the former name of MutualFundRisk.
+	//
+	// Deprecated: use MutualFundRisk.
+	MFRisk = MutualFundRisk
+	// MFRatings is the former name of MutualFundRatings.
+	//
+	// Deprecated: use MutualFundRatings.
+	MFRatings = MutualFundRatings
+	// MFComposition is the former name of MutualFundComposition.
+	//
+	// Deprecated: use MutualFundComposition.
+	MFComposition = MutualFundComposition
+	// MFPurchaseInfo is the former name of MutualFundPurchaseInfo.
+	//
+	// Deprecated: use MutualFundPurchaseInfo.
+	MFPurchaseInfo = MutualFundPurchaseInfo
+	// MFSustainability is the former name of MutualFundSustainability.
+	//
+	// Deprecated: use MutualFundSustainability.
+	MFSustainability = MutualFundSustainability
+	// MFsFamilies is the former name of MutualFundFamilies.
+	//
+	// Deprecated: use MutualFundFamilies.
+	MFsFamilies = MutualFundFamilies
+	// MFsTypes is the former name of MutualFundTypes.
+	//
+	// Deprecated: use MutualFundTypes.
+	MFsTypes = MutualFundTypes
+)
@@ -8,4 +8,5 @@ const (
 	APIKeyInvalidMsg              = "invalid api key"
 	APIKeyRequiredMsg             = "api key is required"
 	InsufficientCreditsMsg        = "insufficient credits"
+	NoDataAvailableMsg            = "no data is available"
 )
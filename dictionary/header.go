@@ -8,3 +8,6 @@ const APICreditsLeft = "Api-credits-left"
 
 // APICreditsRequest is the HTTP header name for credits required by an API request.
 const APICreditsRequest = "Api-credits-request"
+
+// Authorization is the HTTP header name carrying the API key as "apikey <key>".
+const Authorization = "Authorization"
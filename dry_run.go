@@ -0,0 +1,117 @@
+package twelvedata
+
+import (
+	"context"
+	"sync"
+)
+
+// PreparedRequest is the request an endpoint call would send, with the API key redacted.
+type PreparedRequest struct {
+	Endpoint string // endpoint path, e.g. "/time_series"
+	Method   string
+	URL      string
+	Headers  map[string]string
+	Body     []byte
+	// EstimatedCost is the credit cost from the dictionary constants multiplied by the number of symbols.
+	// Twelve Data reports the actual cost in the Api-credits-request response header.
+	EstimatedCost int64
+}
+
+// Prepare builds req exactly like Call does and returns it without sending it or spending credits.
+func (endpoint Endpoint[Request, Response, Credits, Error]) Prepare(req Request) (PreparedRequest, error) {
+	built, err := endpoint.build(req)
+	if err != nil {
+		return PreparedRequest{}, err
+	}
+
+	return endpoint.prepared(built, endpoint.httpCli.costs.estimate(endpoint.cost, req, built.values)), nil
+}
+
+func (endpoint Endpoint[Request, Response, Credits, Error]) prepared(built builtRequest, cost int64) PreparedRequest {
+	var headers map[string]string
+	if built.headers != nil {
+		headers = make(map[string]string, len(built.headers))
+		for key, value := range built.headers {
+			headers[key] = redactSecrets(value)
+		}
+	}
+
+	var body []byte
+	if built.body != nil {
+		body = []byte(redactSecrets(string(built.body)))
+	}
+
+	return PreparedRequest{
+		Endpoint:      endpoint.name(),
+		Method:        built.method,
+		URL:           redactSecrets(built.uri.String()),
+		Headers:       headers,
+		Body:          body,
+		EstimatedCost: cost,
+	}
+}
+
+// DryRun collects the requests of every call made with a context returned by WithDryRun.
+type DryRun struct {
+	mu       sync.Mutex
+	requests []PreparedRequest
+}
+
+type dryRunKey struct{}
+
+// WithDryRun returns a context that turns every Client call made with it into a dry run:
+// the call is recorded in the returned DryRun instead of being sent, and returns a zero response,
+// no error and credits whose CreditsRequest holds the estimated cost.
+// Dry runs bypass the credit limiter and ledger.
+func WithDryRun(ctx context.Context) (context.Context, *DryRun) {
+	dryRun := &DryRun{}
+
+	return context.WithValue(ctx, dryRunKey{}, dryRun), dryRun
+}
+
+func dryRunFromContext(ctx context.Context) *DryRun {
+	dryRun, _ := ctx.Value(dryRunKey{}).(*DryRun)
+
+	return dryRun
+}
+
+func (d *DryRun) record(req PreparedRequest) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.requests = append(d.requests, req)
+}
+
+// Requests returns the recorded requests in call order.
+func (d *DryRun) Requests() []PreparedRequest {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return append([]PreparedRequest(nil), d.requests...)
+}
+
+// EstimatedCost returns the total estimated credit cost of the recorded requests.
+func (d *DryRun) EstimatedCost() int64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var total int64
+	for _, req := range d.requests {
+		total += req.EstimatedCost
+	}
+
+	return total
+}
+
+// CostByEndpoint returns the estimated credit cost of the recorded requests per endpoint path.
+func (d *DryRun) CostByEndpoint() map[string]int64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	costs := map[string]int64{}
+	for _, req := range d.requests {
+		costs[req.Endpoint] += req.EstimatedCost
+	}
+
+	return costs
+}
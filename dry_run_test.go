@@ -0,0 +1,154 @@
+package twelvedata
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/soulgarden/twelvedata/dictionary"
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+func TestEndpoint_Prepare(t *testing.T) {
+	httpCli := newTestHTTPCli("https://api.twelvedata.com")
+	cfg := &Conf{BaseURL: "https://api.twelvedata.com"}
+
+	endpoint := newEndpoint[request.GetTimeSeries, response.TimeSeries](httpCli, cfg, "/time_series", dictionary.TimeSeries)
+
+	prepared, err := endpoint.Prepare(request.GetTimeSeries{
+		APIKey:   request.APIKey{APIKey: "secret"},
+		Symbol:   "AAPL,MSFT",
+		Interval: "1day",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := PreparedRequest{
+		Endpoint:      "/time_series",
+		Method:        http.MethodGet,
+		URL:           "https://api.twelvedata.com/time_series?apikey=REDACTED&interval=1day&symbol=AAPL%2CMSFT",
+		EstimatedCost: 2,
+	}
+
+	if prepared.Endpoint != want.Endpoint || prepared.Method != want.Method || prepared.URL != want.URL ||
+		prepared.EstimatedCost != want.EstimatedCost || prepared.Headers != nil || prepared.Body != nil {
+		t.Fatalf("Prepare() = %+v, want %+v", prepared, want)
+	}
+}
+
+func TestEndpoint_Prepare_Batches(t *testing.T) {
+	httpCli := newTestHTTPCli("https://api.twelvedata.com")
+	cfg := &Conf{BaseURL: "https://api.twelvedata.com"}
+
+	newEndpoint[request.GetProfile, response.Profile](httpCli, cfg, "/profile", dictionary.Profile)
+	endpoint := newEndpoint[request.GetBatches, response.Batches](httpCli, cfg, "/batch", dictionary.Batches)
+
+	prepared, err := endpoint.Prepare(request.GetBatches{
+		APIKey: request.APIKey{APIKey: "secret"},
+		Requests: map[string]request.BatchRequest{
+			"req_1": {URL: "/profile?symbol=AAPL&apikey=secret"},
+			"req_2": {URL: "/profile?symbol=MSFT&apikey=secret"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if prepared.Method != http.MethodPost {
+		t.Errorf("unexpected method: %s", prepared.Method)
+	}
+
+	if prepared.EstimatedCost != 2*dictionary.Profile {
+		t.Errorf("unexpected estimated cost: %d", prepared.EstimatedCost)
+	}
+
+	if got := prepared.Headers["Authorization"]; got != "apikey REDACTED" {
+		t.Errorf("unexpected Authorization header: %q", got)
+	}
+
+	if strings.Contains(prepared.URL+string(prepared.Body), "secret") {
+		t.Errorf("API key leaked: %s %s", prepared.URL, prepared.Body)
+	}
+
+	if !strings.Contains(string(prepared.Body), "apikey=REDACTED") {
+		t.Errorf("expected redacted body, got %s", prepared.Body)
+	}
+}
+
+func TestEndpoint_Prepare_BuildError(t *testing.T) {
+	endpoint := NewEndpoint[getWithBodyRequest, testResponse, response.Credits, error](newTestHTTPCli("http://localhost"), "http://localhost")
+
+	if _, err := endpoint.Prepare(getWithBodyRequest{}); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestClient_DryRun(t *testing.T) {
+	var calls atomic.Int32
+
+	serverURL := newTestServer(t, func(_ http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+	})
+
+	cli := newTestClient(t, serverURL, Conf{
+		CoreData:     CoreData{QuotesURL: "/quote"},
+		Fundamentals: Fundamentals{ProfileURL: "/profile"},
+	})
+
+	ctx, dryRun := WithDryRun(context.Background())
+
+	_, creds, err := cli.GetQuoteCtx(ctx, request.GetQuote{Symbol: "AAPL"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := creds.(*response.CreditsImpl).GetCreditsRequest(); got != dictionary.Quote { //nolint:forcetypeassert
+		t.Errorf("unexpected credits request: %d", got)
+	}
+
+	if _, _, err := cli.GetProfileCtx(ctx, request.GetProfile{Symbol: "AAPL,MSFT"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := calls.Load(); got != 0 {
+		t.Fatalf("dry run sent %d requests", got)
+	}
+
+	requests := dryRun.Requests()
+	if len(requests) != 2 || requests[0].Endpoint != "/quote" || requests[1].Endpoint != "/profile" {
+		t.Fatalf("unexpected recorded requests: %+v", requests)
+	}
+
+	if got := dryRun.EstimatedCost(); got != dictionary.Quote+2*dictionary.Profile {
+		t.Errorf("unexpected estimated cost: %d", got)
+	}
+
+	if got := dryRun.CostByEndpoint()["/profile"]; got != 2*dictionary.Profile {
+		t.Errorf("unexpected /profile cost: %d", got)
+	}
+}
+
+func TestEndpoint_CallCtx_CreditsRequestHeader(t *testing.T) {
+	serverURL := newTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set(dictionary.APICreditsRequest, "8")
+
+		if _, err := w.Write([]byte(`{"status":"ok"}`)); err != nil {
+			t.Error(err)
+		}
+	})
+
+	endpoint := NewEndpoint[headerRequest, testResponse, response.Credits, error](newTestHTTPCli(serverURL), serverURL)
+
+	_, creds, err := endpoint.Call(headerRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := creds.(*response.CreditsImpl).GetCreditsRequest(); got != 8 { //nolint:forcetypeassert
+		t.Fatalf("unexpected credits request: %d", got)
+	}
+}
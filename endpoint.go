@@ -1,15 +1,21 @@
 package twelvedata
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gorilla/schema"
+	"github.com/soulgarden/twelvedata/dictionary"
 	"github.com/soulgarden/twelvedata/response"
 	"github.com/valyala/fasthttp"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var encoder = schema.NewEncoder()
@@ -44,10 +50,24 @@ type RequestPather interface {
 	PathParams() map[string]string
 }
 
+// RequestCoster allows a request to compute its own credit cost, for example a batch
+// that is charged for every request it bundles. costOf returns the cost of an API URL.
+type RequestCoster interface {
+	Cost(costOf func(uri string) int64) int64
+}
+
+// RequestValidator allows a request to reject invalid parameters before it is sent.
+type RequestValidator interface {
+	Validate() error
+}
+
 // Endpoint represents a generic HTTP endpoint with type-safe request/response handling.
 type Endpoint[Request any, Response any, Credits response.Credits, Error error] struct {
 	httpCli *HTTPCli
 	URL     string
+
+	path string // URL path relative to Conf.BaseURL
+	cost int64  // credit cost per symbol
 }
 
 // NewEndpoint creates a new endpoint instance with the specified HTTP client and URI.
@@ -58,6 +78,36 @@ func NewEndpoint[Request any, Response any, Credits response.Credits, Error erro
 	}
 }
 
+// newEndpoint creates an endpoint for a Conf path and registers its credit cost with httpCli.
+func newEndpoint[Request any, Response any](
+	httpCli *HTTPCli,
+	cfg *Conf,
+	path string,
+	cost int64,
+) *Endpoint[Request, Response, response.Credits, error] {
+	httpCli.costs.register(path, cost)
+
+	return &Endpoint[Request, Response, response.Credits, error]{
+		httpCli: httpCli,
+		URL:     cfg.BaseURL + path,
+		path:    path,
+		cost:    cost,
+	}
+}
+
+// name returns the endpoint path used to identify it in costs, logs and metrics.
+func (endpoint Endpoint[Request, Response, Credits, Error]) name() string {
+	if endpoint.path != "" {
+		return endpoint.path
+	}
+
+	if uri, err := url.Parse(endpoint.URL); err == nil {
+		return uri.Path
+	}
+
+	return endpoint.URL
+}
+
 func buildQueryParams(req any) (url.Values, error) {
 	values := url.Values{}
 	if queryer, ok := req.(RequestQueryer); ok {
@@ -153,103 +203,285 @@ func validateMethodBody(method string, body []byte) error {
 	return nil
 }
 
+// builtRequest is an endpoint request ready to be sent.
+type builtRequest struct {
+	uri     *url.URL
+	values  url.Values
+	method  string
+	headers map[string]string
+	body    []byte
+}
+
+// build resolves the URL, query, body, method and headers of req the same way for Call and Prepare.
+func (endpoint Endpoint[Request, Response, Credits, Error]) build(req Request) (builtRequest, error) {
+	if validator, ok := any(req).(RequestValidator); ok {
+		if err := validator.Validate(); err != nil {
+			return builtRequest{}, err
+		}
+	}
+
+	values, err := buildQueryParams(req)
+	if err != nil {
+		return builtRequest{}, fmt.Errorf("build query params: %w", err)
+	}
+
+	uri, err := url.Parse(applyPathParams(endpoint.URL, req))
+	if err != nil {
+		return builtRequest{}, fmt.Errorf("parse uri: %w", err)
+	}
+
+	body, contentType, err := buildRequestBody(req)
+	if err != nil {
+		return builtRequest{}, fmt.Errorf("build body: %w", err)
+	}
+
+	headers := buildHeaders(req, contentType)
+	if cfg := endpoint.httpCli.cfg; cfg != nil && cfg.APIKeyInHeader {
+		headers = moveAPIKeyToHeader(values, headers, cfg.APIKey)
+	}
+
+	uri.RawQuery = values.Encode()
+
+	method := resolveMethod(req, body)
+	if err = validateMethodBody(method, body); err != nil {
+		return builtRequest{}, err
+	}
+
+	return builtRequest{
+		uri:     uri,
+		values:  values,
+		method:  method,
+		headers: headers,
+		body:    body,
+	}, nil
+}
+
 // Call executes the endpoint request and returns the response, credits, and any errors.
 func (endpoint Endpoint[Request, Response, Credits, ErrorResponse]) Call(req Request) (resp Response, creds response.Credits, err Error) {
-	httpResp := fasthttp.AcquireResponse()
-
-	defer fasthttp.ReleaseResponse(httpResp)
+	return endpoint.CallCtx(context.Background(), req)
+}
 
+// CallCtx executes the endpoint request bound to ctx and returns the response, credits, and any errors.
+// A cancelled context yields an error matching context.Canceled, an expired deadline yields a TimeoutError.
+func (endpoint Endpoint[Request, Response, Credits, ErrorResponse]) CallCtx(
+	ctx context.Context,
+	req Request,
+) (resp Response, creds response.Credits, err Error) {
 	var (
-		creditsLeft, creditsUsed int64
-		innerErr                 error
+		result upstreamResult
+		local  bool // served without reaching the API: dry run, cache hit or coalesced call
 	)
 
-	values, innerErr := buildQueryParams(req)
+	start := time.Now()
+
+	ctx, span := startSpan(ctx, endpoint.httpCli.tracer, endpoint.name(), trace.SpanKindClient, attrEndpoint.String(endpoint.name()))
+
+	defer func() {
+		endCallSpan(span, result.statusCode, creds, err)
+
+		if !local {
+			endpoint.httpCli.metrics.observeCall(endpoint.name(), result.statusCode, time.Since(start), creds, result.creditsLeftKnown, err)
+		}
+	}()
+
+	built, innerErr := endpoint.build(req)
 	if innerErr != nil {
-		return resp, creds, NewError[Error](fmt.Errorf("build query params: %w", innerErr), nil)
+		return resp, creds, NewError[Error](innerErr, nil)
 	}
 
-	var uri *url.URL
+	uri, method := built.uri, built.method
+	cost := endpoint.httpCli.costs.estimate(endpoint.cost, req, built.values)
 
-	endpointURL := applyPathParams(endpoint.URL, req)
-	if uri, innerErr = url.Parse(endpointURL); innerErr != nil {
-		return resp, creds, NewError[Error](fmt.Errorf("parse uri: %w", innerErr), nil)
+	if span.IsRecording() {
+		span.SetAttributes(append(symbolAttribute(built.values), attrMethod.String(method))...)
 	}
 
-	uri.RawQuery = values.Encode()
+	if call := batchCallFromContext(ctx); call != nil {
+		local = true
 
-	body, contentType, innerErr := buildRequestBody(req)
-	if innerErr != nil {
-		return resp, creds, NewError[Error](fmt.Errorf("build body: %w", innerErr), nil)
+		if innerErr = endpoint.capture(call, built); innerErr != nil {
+			return resp, creds, NewError[Error](innerErr, nil)
+		}
+
+		return resp, creds, err
 	}
 
-	method := resolveMethod(req, body)
-	if innerErr = validateMethodBody(method, body); innerErr != nil {
-		return resp, creds, NewError[Error](innerErr, nil)
+	if recorder := dryRunFromContext(ctx); recorder != nil {
+		local = true
+
+		recorder.record(endpoint.prepared(built, cost))
+		span.SetAttributes(attrDryRun.Bool(true))
+
+		creds = &response.CreditsImpl{CreditsRequest: cost}
+
+		return resp, creds, err
 	}
 
-	headers := buildHeaders(req, contentType)
+	var cacheKey string
 
-	if creditsLeft, creditsUsed, innerErr = endpoint.httpCli.doRequest(method, uri.String(), headers, body, httpResp); innerErr != nil {
-		// Check if it's a network or timeout error
-		if isTimeoutError(innerErr) {
-			return resp, creds, NewError[Error](&TimeoutError{Message: innerErr.Error()}, nil)
-		}
+	cacheTTL := endpoint.httpCli.cache.ttl(endpoint.name(), method, built.values)
+	if cacheTTL > 0 {
+		cacheKey = endpoint.httpCli.cache.key(uri.Path, built.values, built.headers)
 
-		if isNetworkError(innerErr) {
-			return resp, creds, NewError[Error](&NetworkError{Message: innerErr.Error(), Cause: innerErr}, nil)
+		if cached, ok := endpoint.httpCli.cache.backend.Get(cacheKey); ok {
+			local = true
+
+			span.SetAttributes(attrCacheHit.Bool(true))
+
+			creds = &response.CreditsImpl{}
+			resp, err = endpoint.decode(http.StatusOK, cached, uri.String(), built.values)
+
+			return resp, creds, err
 		}
+	}
+
+	var shared bool
+
+	result, shared, innerErr = endpoint.coalesce(ctx, req, built, cost)
+	if shared {
+		local = true
 
+		span.SetAttributes(attrCoalesced.Bool(true))
+	}
+
+	if innerErr != nil {
 		return resp, creds, NewError[Error](innerErr, nil)
 	}
 
-	creds = &response.CreditsImpl{}
+	creds = &response.CreditsImpl{CreditsRequest: result.creditsRequest}
+
+	creds.SetCreditsLeft(result.creditsLeft)
+	creds.SetCreditsUsed(result.creditsUsed)
+
+	resp, err = endpoint.decode(result.statusCode, result.body, uri.String(), built.values)
+
+	if cacheTTL > 0 && err == nil && result.statusCode == http.StatusOK {
+		endpoint.httpCli.cache.backend.Set(cacheKey, result.body, cacheTTL)
+	}
+
+	return resp, creds, err
+}
+
+// upstreamResult is a response received from the API.
+type upstreamResult struct {
+	statusCode       int
+	body             []byte
+	creditsLeft      int64
+	creditsUsed      int64
+	creditsRequest   int64
+	creditsLeftKnown bool
+}
+
+// fetch sends a built request to the API, admitting the cost of every upstream attempt to the ledger and
+// the limiter.
+func (endpoint Endpoint[Request, Response, Credits, ErrorResponse]) fetch(
+	ctx context.Context,
+	req Request,
+	built builtRequest,
+	cost int64,
+) (result upstreamResult, err error) {
+	httpResp := fasthttp.AcquireResponse()
+
+	defer fasthttp.ReleaseResponse(httpResp)
+
+	if endpoint.httpCli.ledger != nil || endpoint.httpCli.limiter != nil {
+		ctx = withAdmission(ctx, admission{endpoint: endpoint.name(), tag: CreditTagFromContext(ctx), cost: cost})
+	}
+
+	if len(endpoint.httpCli.interceptors) > 0 {
+		ctx = withCallInfo(ctx, endpoint.name(), req)
+	}
 
-	creds.SetCreditsLeft(creditsLeft)
-	creds.SetCreditsUsed(creditsUsed)
+	creditsLeft, creditsUsed, err := endpoint.httpCli.doRequestPooled(ctx, built, httpResp)
+	if err != nil {
+		switch {
+		case errors.Is(err, context.Canceled):
+			return result, err
+		case errors.Is(err, context.DeadlineExceeded):
+			return result, &TimeoutError{Message: err.Error(), Cause: err}
+		// Check if it's a network or timeout error
+		case isTimeoutError(err):
+			return result, &TimeoutError{Message: err.Error()}
+		case isNetworkError(err):
+			return result, &NetworkError{Message: err.Error(), Cause: err}
+		default:
+			return result, err
+		}
+	}
 
+	return upstreamResult{
+		statusCode:       httpResp.StatusCode(),
+		body:             append([]byte(nil), httpResp.Body()...),
+		creditsLeft:      creditsLeft,
+		creditsUsed:      creditsUsed,
+		creditsRequest:   parseCreditsRequest(httpResp),
+		creditsLeftKnown: hasCreditsLeft(httpResp),
+	}, nil
+}
+
+// decode classifies an API response and unmarshals a successful one into Response.
+// values are the query parameters of the request, a CSV format decodes a non-JSON body with decodeCSV.
+func (endpoint Endpoint[Request, Response, Credits, ErrorResponse]) decode(
+	statusCode int,
+	body []byte,
+	uri string,
+	values url.Values,
+) (resp Response, err Error) {
 	// Handle HTTP status code errors first
-	statusCode := httpResp.StatusCode()
 	if statusCode >= 400 {
 		var apiError response.Error
 
 		var parsedAPIError *response.Error
 
 		// Try to parse API error from response body
-		if innerErr := json.Unmarshal(httpResp.Body(), &apiError); innerErr == nil && apiError.Status == "error" {
+		if innerErr := json.Unmarshal(body, &apiError); innerErr == nil && apiError.Status == "error" {
 			parsedAPIError = &apiError
 		}
 
 		// Check for domain-specific errors first
 		if parsedAPIError != nil {
-			if domainErr := ParseDomainError(parsedAPIError, statusCode, uri.String()); domainErr != nil {
-				return resp, creds, NewError[Error](domainErr, nil)
+			if domainErr := ParseDomainError(parsedAPIError, statusCode, uri); domainErr != nil {
+				return resp, NewError[Error](domainErr, nil)
 			}
 		}
 
 		// Fall back to HTTP error types
-		typedErr := NewHTTPError(statusCode, httpResp.Body(), uri.String(), parsedAPIError, nil)
+		typedErr := NewHTTPError(statusCode, body, uri, parsedAPIError, nil)
 
-		return resp, creds, NewError[Error](typedErr, nil)
+		return resp, NewError[Error](typedErr, nil)
 	}
 
 	var respErr response.Error
 
-	if innerErr := json.Unmarshal(httpResp.Body(), &respErr); innerErr == nil && respErr.Status == "error" {
+	if innerErr := json.Unmarshal(body, &respErr); innerErr == nil && respErr.Status == "error" {
 		// Check for domain-specific errors in 200 OK responses with error status
-		if domainErr := ParseDomainError(&respErr, statusCode, uri.String()); domainErr != nil {
-			return resp, creds, NewError[Error](domainErr, nil)
+		if domainErr := ParseDomainError(&respErr, statusCode, uri); domainErr != nil {
+			return resp, NewError[Error](domainErr, nil)
 		}
 
 		// Fall back to generic error
-		return resp, creds, NewError[Error](fmt.Errorf("error received: %s", respErr.Error()), respErr)
+		return resp, NewError[Error](fmt.Errorf("error received: %s", respErr.Error()), respErr)
+	}
+
+	if delimiter, ok := csvDelimiter(values); ok && !isJSONBody(body) {
+		if raw, ok := any(&resp).(*json.RawMessage); ok { // Client.Do returns CSV as it is
+			*raw = append(json.RawMessage(nil), body...)
+
+			return resp, nil
+		}
+
+		if innerErr := decodeCSV(body, delimiter, &resp); innerErr != nil {
+			return resp, NewError[Error](fmt.Errorf("decode csv: %w", innerErr), nil)
+		}
+
+		return resp, nil
 	}
 
-	if innerErr := json.Unmarshal(httpResp.Body(), &resp); innerErr != nil {
-		return resp, creds, NewError[Error](fmt.Errorf("unmarshall json: %w", innerErr), nil)
+	if innerErr := json.Unmarshal(body, &resp); innerErr != nil {
+		return resp, NewError[Error](fmt.Errorf("unmarshall json: %w", innerErr), nil)
 	}
 
-	return resp, creds, err
+	return resp, nil
 }
 
 // NewError creates a new generic error wrapper.
@@ -279,6 +511,21 @@ func (e ErrImplError[Err]) Unwrap() error {
 	return e.inner
 }
 
+// hasCreditsLeft reports whether resp carries the Api-credits-left header.
+func hasCreditsLeft(resp *fasthttp.Response) bool {
+	return len(resp.Header.Peek(dictionary.APICreditsLeft)) > 0
+}
+
+// parseCreditsRequest returns the Api-credits-request header value, or zero when it is missing or malformed.
+func parseCreditsRequest(resp *fasthttp.Response) int64 {
+	value, err := strconv.ParseInt(string(resp.Header.Peek(dictionary.APICreditsRequest)), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return value
+}
+
 // Helper functions to identify error types.
 func isTimeoutError(err error) bool {
 	// Check for common timeout error patterns
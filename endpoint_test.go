@@ -51,14 +51,38 @@ func (getWithBodyRequest) Body() (any, string, error) {
 }
 
 func newTestHTTPCli(baseURL string) *HTTPCli {
-	return &HTTPCli{
+	return withTestTransport(&HTTPCli{
 		transport: &fasthttp.Client{},
 		cfg: &Conf{
 			Timeout: 1,
 			BaseURL: baseURL,
 		},
 		logger: &zerolog.Logger{},
+	})
+}
+
+// quoteConf and profileConf route the endpoints most client tests call.
+var (
+	quoteConf   = Conf{CoreData: CoreData{QuotesURL: "/quote"}}
+	profileConf = Conf{Fundamentals: Fundamentals{ProfileURL: "/profile"}}
+)
+
+// newTestClient creates a client calling serverURL with the endpoint paths and settings of conf, such as
+// APIKeyInHeader, and the HTTP client options opts.
+func newTestClient(t *testing.T, serverURL string, conf Conf, opts ...HTTPCliOption) Client {
+	t.Helper()
+
+	conf.BaseURL = serverURL
+	if conf.Timeout == 0 {
+		conf.Timeout = 1
+	}
+
+	httpCli := withTestTransport(&HTTPCli{transport: &fasthttp.Client{}, cfg: &conf, logger: &zerolog.Logger{}})
+	for _, opt := range opts {
+		opt(httpCli)
 	}
+
+	return NewClient(httpCli, &conf)
 }
 
 func TestEndpoint_Call_UsesHeaders(t *testing.T) {
@@ -0,0 +1,1442 @@
+// Code generated by genendpoints from endpoints.json. DO NOT EDIT.
+
+package twelvedata
+
+import (
+	"context"
+
+	"github.com/soulgarden/twelvedata/dictionary"
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+// Endpoints defines a method for every endpoint of the manifest.
+type Endpoints interface {
+	// Market Data
+	GetTimeSeries(request.GetTimeSeries) (response.TimeSeries, response.Credits, error)
+	GetTimeSeriesCross(request.GetTimeSeriesCross) (response.TimeSeriesCross, response.Credits, error)
+	GetQuote(request.GetQuote) (response.Quote, response.Credits, error)
+	GetPrice(request.GetPrice) (response.Price, response.Credits, error)
+	GetEOD(request.GetEOD) (response.EOD, response.Credits, error)
+	GetMarketMovers(request.GetMarketMovers) (response.MarketMovers, response.Credits, error)
+
+	// Reference Data - Asset Catalogs
+	GetStocks(request.GetStock) (response.Stocks, response.Credits, error)
+	GetForexPairs(request.GetForexPairs) (response.ForexPairs, response.Credits, error)
+	GetCryptocurrencies(request.GetCryptocurrencies) (response.Cryptocurrencies, response.Credits, error)
+	GetETFs(request.GetETFs) (response.ETFs, response.Credits, error)
+	GetFunds(request.GetFunds) (response.Funds, response.Credits, error)
+	GetCommodities(request.GetCommodities) (response.Commodities, response.Credits, error)
+	GetBonds(request.GetBonds) (response.Bonds, response.Credits, error)
+
+	// Reference Data - Discovery
+	GetSymbolSearch(request.GetSymbolSearch) (response.SymbolSearch, response.Credits, error)
+	GetCrossListings(request.GetCrossListings) (response.CrossListings, response.Credits, error)
+	GetEarliestTimestamp(request.GetEarliestTimestamp) (response.EarliestTimestamp, response.Credits, error)
+
+	// Reference Data - Markets
+	GetExchanges(request.GetExchanges) (response.Exchanges, response.Credits, error)
+	GetExchangeSchedule(request.GetExchangeSchedule) (response.ExchangeSchedule, response.Credits, error)
+	GetCryptocurrencyExchanges(request.GetCryptocurrencyExchanges) (response.CryptocurrencyExchanges, response.Credits, error)
+	GetMarketState(request.GetMarketState) ([]response.MarketState, response.Credits, error)
+
+	// Reference Data - Supporting Metadata
+	GetCountries(request.GetCountries) (response.Countries, response.Credits, error)
+	GetInstrumentType(request.GetInstrumentType) (response.InstrumentType, response.Credits, error)
+	GetTechnicalIndicators(request.GetTechnicalIndicators) (response.TechnicalIndicators, response.Credits, error)
+
+	// Fundamentals
+	GetLogo(request.GetLogo) (response.Logo, response.Credits, error)
+	GetProfile(request.GetProfile) (response.Profile, response.Credits, error)
+	GetDividends(request.GetDividends) (response.Dividends, response.Credits, error)
+	GetDividendsCalendar(request.GetDividendsCalendar) (response.DividendsCalendar, response.Credits, error)
+	GetEarnings(request.GetEarnings) (response.Earnings, response.Credits, error)
+	GetEarningsCalendar(request.GetEarningsCalendar) (response.EarningsCalendar, response.Credits, error)
+	GetIPOCalendar(request.GetIPOCalendar) (response.IPOCalendar, response.Credits, error)
+	GetSplits(request.GetSplits) (response.Splits, response.Credits, error)
+	GetSplitsCalendar(request.GetSplitsCalendar) (response.SplitsCalendar, response.Credits, error)
+	GetStatistics(request.GetStatistics) (response.Statistics, response.Credits, error)
+	GetPressReleases(request.GetPressReleases) (response.PressReleases, response.Credits, error)
+	GetIncomeStatement(request.GetIncomeStatement) (response.IncomeStatements, response.Credits, error)
+	GetIncomeStatementConsolidated(request.GetIncomeStatement) (response.IncomeStatements, response.Credits, error)
+	GetBalanceSheet(request.GetBalanceSheet) (response.BalanceSheets, response.Credits, error)
+	GetBalanceSheetConsolidated(request.GetBalanceSheet) (response.BalanceSheets, response.Credits, error)
+	GetCashFlow(request.GetCashFlow) (response.CashFlows, response.Credits, error)
+	GetCashFlowConsolidated(request.GetCashFlow) (response.CashFlows, response.Credits, error)
+	GetKeyExecutives(request.GetKeyExecutives) (response.KeyExecutives, response.Credits, error)
+	GetMarketCap(request.GetMarketCap) (response.MarketCap, response.Credits, error)
+	GetLastChange(request.GetLastChange) (response.LastChange, response.Credits, error)
+
+	// Currencies
+	GetExchangeRate(request.GetExchangeRate) (response.ExchangeRate, response.Credits, error)
+	GetCurrencyConversion(request.GetCurrencyConversion) (response.CurrencyConversion, response.Credits, error)
+
+	// ETFs
+	GetETFsDirectory(request.GetETFsDirectory) (response.ETFsDirectory, response.Credits, error)
+	GetETFFullData(request.GetETFFullData) (response.ETFFullData, response.Credits, error)
+	GetETFSummary(request.GetETFSummary) (response.ETFWorldSummary, response.Credits, error)
+	GetETFPerformance(request.GetETFPerformance) (response.ETFPerformance, response.Credits, error)
+	GetETFRisk(request.GetETFRisk) (response.ETFRisk, response.Credits, error)
+	GetETFComposition(request.GetETFComposition) (response.ETFComposition, response.Credits, error)
+	GetETFFamilies(request.GetETFFamilies) (response.ETFFamilies, response.Credits, error)
+	GetETFTypes(request.GetETFTypes) (response.ETFTypes, response.Credits, error)
+
+	// Mutual Funds
+	GetMutualFundsDirectory(request.GetMutualFundsDirectory) (response.MutualFundsDirectory, response.Credits, error)
+	GetMutualFundFullData(request.GetMutualFundFullData) (response.MutualFundFullData, response.Credits, error)
+	GetMutualFundSummary(request.GetMutualFundSummary) (response.MutualFundSummary, response.Credits, error)
+	GetMutualFundPerformance(request.GetMutualFundPerformance) (response.MutualFundPerformance, response.Credits, error)
+	GetMutualFundRisk(request.GetMutualFundRisk) (response.MutualFundRisk, response.Credits, error)
+	GetMutualFundRatings(request.GetMutualFundRatings) (response.MutualFundRatings, response.Credits, error)
+	GetMutualFundComposition(request.GetMutualFundComposition) (response.MutualFundComposition, response.Credits, error)
+	GetMutualFundPurchaseInfo(request.GetMutualFundPurchaseInfo) (response.MutualFundPurchaseInfo, response.Credits, error)
+	GetMutualFundSustainability(request.GetMutualFundSustainability) (response.MutualFundSustainability, response.Credits, error)
+	GetMutualFundFamilies(request.GetMutualFundFamilies) (response.MutualFundFamilies, response.Credits, error)
+	GetMutualFundTypes(request.GetMutualFundTypes) (response.MutualFundTypes, response.Credits, error)
+
+	// Technical Indicators
+	GetBBands(request.GetBBands) (response.BBands, response.Credits, error)
+	GetSMA(request.GetSMA) (response.SMA, response.Credits, error)
+	GetEMA(request.GetEMA) (response.EMA, response.Credits, error)
+	GetMA(request.GetMA) (response.MA, response.Credits, error)
+	GetWMA(request.GetWMA) (response.WMA, response.Credits, error)
+	GetVWAP(request.GetVWAP) (response.VWAP, response.Credits, error)
+	GetDEMA(request.GetDEMA) (response.DEMA, response.Credits, error)
+	GetTEMA(request.GetTEMA) (response.TEMA, response.Credits, error)
+	GetTRMA(request.GetTRMA) (response.TRMA, response.Credits, error)
+	GetKAMA(request.GetKAMA) (response.KAMA, response.Credits, error)
+	GetSAR(request.GetSAR) (response.SAR, response.Credits, error)
+	GetADX(request.GetADX) (response.ADX, response.Credits, error)
+	GetMACD(request.GetMACD) (response.MACD, response.Credits, error)
+	GetRSI(request.GetRSI) (response.RSI, response.Credits, error)
+	GetStoch(request.GetStoch) (response.Stoch, response.Credits, error)
+	GetPercentB(request.GetPercentB) (response.PercentB, response.Credits, error)
+	GetCCI(request.GetCCI) (response.CCI, response.Credits, error)
+	GetWillR(request.GetWillR) (response.WillR, response.Credits, error)
+	GetROC(request.GetROC) (response.ROC, response.Credits, error)
+	GetMOM(request.GetMOM) (response.MOM, response.Credits, error)
+	GetOBV(request.GetOBV) (response.OBV, response.Credits, error)
+	GetAD(request.GetAD) (response.AD, response.Credits, error)
+	GetATR(request.GetATR) (response.ATR, response.Credits, error)
+	GetNATR(request.GetNATR) (response.NATR, response.Credits, error)
+	GetTR(request.GetTR) (response.TR, response.Credits, error)
+
+	// Analysis
+	GetEarningsEstimate(request.GetEarningsEstimate) (response.EarningsEstimate, response.Credits, error)
+	GetRevenueEstimate(request.GetRevenueEstimate) (response.RevenueEstimate, response.Credits, error)
+	GetEPSTrend(request.GetEPSTrend) (response.EPSTrend, response.Credits, error)
+	GetEPSRevisions(request.GetEPSRevisions) (response.EPSRevisions, response.Credits, error)
+	GetGrowthEstimates(request.GetGrowthEstimates) (response.GrowthEstimates, response.Credits, error)
+	GetRecommendations(request.GetRecommendations) (response.Recommendations, response.Credits, error)
+	GetPriceTarget(request.GetPriceTarget) (response.PriceTarget, response.Credits, error)
+	GetAnalystRatingsSnapshot(request.GetAnalystRatingsSnapshot) (response.AnalystRatingsSnapshot, response.Credits, error)
+	GetAnalystRatingsUSEquities(request.GetAnalystRatingsUSEquities) (response.AnalystRatingsUSEquities, response.Credits, error)
+
+	// Regulatory
+	GetEDGARFilings(request.GetEDGARFilings) (response.EDGARFilings, response.Credits, error)
+	GetInsiderTransactions(request.GetInsiderTransactions) (response.InsiderTransactions, response.Credits, error)
+	GetInstitutionalHolders(request.GetInstitutionalHolders) (response.InstitutionalHolders, response.Credits, error)
+	GetFundHolders(request.GetFundHolders) (response.FundHolders, response.Credits, error)
+	GetDirectHolders(request.GetDirectHolders) (response.DirectHolders, response.Credits, error)
+	GetTaxInformation(request.GetTaxInformation) (response.TaxInformation, response.Credits, error)
+	GetSanctionedEntities(request.GetSanctionedEntities) (response.SanctionedEntities, response.Credits, error)
+
+	// Advanced
+	GetUsage(request.GetUsage) (response.Usage, response.Credits, error)
+	GetBatches(request.GetBatches) (response.Batches, response.Credits, error)
+	GetComplexData(request.GetComplexData) (response.ComplexData, response.Credits, error)
+}
+
+// EndpointsCtx defines the context-aware variants of the Endpoints methods.
+type EndpointsCtx interface {
+	// Market Data
+	GetTimeSeriesCtx(context.Context, request.GetTimeSeries) (response.TimeSeries, response.Credits, error)
+	GetTimeSeriesCrossCtx(context.Context, request.GetTimeSeriesCross) (response.TimeSeriesCross, response.Credits, error)
+	GetQuoteCtx(context.Context, request.GetQuote) (response.Quote, response.Credits, error)
+	GetPriceCtx(context.Context, request.GetPrice) (response.Price, response.Credits, error)
+	GetEODCtx(context.Context, request.GetEOD) (response.EOD, response.Credits, error)
+	GetMarketMoversCtx(context.Context, request.GetMarketMovers) (response.MarketMovers, response.Credits, error)
+
+	// Reference Data - Asset Catalogs
+	GetStocksCtx(context.Context, request.GetStock) (response.Stocks, response.Credits, error)
+	GetForexPairsCtx(context.Context, request.GetForexPairs) (response.ForexPairs, response.Credits, error)
+	GetCryptocurrenciesCtx(context.Context, request.GetCryptocurrencies) (response.Cryptocurrencies, response.Credits, error)
+	GetETFsCtx(context.Context, request.GetETFs) (response.ETFs, response.Credits, error)
+	GetFundsCtx(context.Context, request.GetFunds) (response.Funds, response.Credits, error)
+	GetCommoditiesCtx(context.Context, request.GetCommodities) (response.Commodities, response.Credits, error)
+	GetBondsCtx(context.Context, request.GetBonds) (response.Bonds, response.Credits, error)
+
+	// Reference Data - Discovery
+	GetSymbolSearchCtx(context.Context, request.GetSymbolSearch) (response.SymbolSearch, response.Credits, error)
+	GetCrossListingsCtx(context.Context, request.GetCrossListings) (response.CrossListings, response.Credits, error)
+	GetEarliestTimestampCtx(context.Context, request.GetEarliestTimestamp) (response.EarliestTimestamp, response.Credits, error)
+
+	// Reference Data - Markets
+	GetExchangesCtx(context.Context, request.GetExchanges) (response.Exchanges, response.Credits, error)
+	GetExchangeScheduleCtx(context.Context, request.GetExchangeSchedule) (response.ExchangeSchedule, response.Credits, error)
+	GetCryptocurrencyExchangesCtx(context.Context, request.GetCryptocurrencyExchanges) (response.CryptocurrencyExchanges, response.Credits, error)
+	GetMarketStateCtx(context.Context, request.GetMarketState) ([]response.MarketState, response.Credits, error)
+
+	// Reference Data - Supporting Metadata
+	GetCountriesCtx(context.Context, request.GetCountries) (response.Countries, response.Credits, error)
+	GetInstrumentTypeCtx(context.Context, request.GetInstrumentType) (response.InstrumentType, response.Credits, error)
+	GetTechnicalIndicatorsCtx(context.Context, request.GetTechnicalIndicators) (response.TechnicalIndicators, response.Credits, error)
+
+	// Fundamentals
+	GetLogoCtx(context.Context, request.GetLogo) (response.Logo, response.Credits, error)
+	GetProfileCtx(context.Context, request.GetProfile) (response.Profile, response.Credits, error)
+	GetDividendsCtx(context.Context, request.GetDividends) (response.Dividends, response.Credits, error)
+	GetDividendsCalendarCtx(context.Context, request.GetDividendsCalendar) (response.DividendsCalendar, response.Credits, error)
+	GetEarningsCtx(context.Context, request.GetEarnings) (response.Earnings, response.Credits, error)
+	GetEarningsCalendarCtx(context.Context, request.GetEarningsCalendar) (response.EarningsCalendar, response.Credits, error)
+	GetIPOCalendarCtx(context.Context, request.GetIPOCalendar) (response.IPOCalendar, response.Credits, error)
+	GetSplitsCtx(context.Context, request.GetSplits) (response.Splits, response.Credits, error)
+	GetSplitsCalendarCtx(context.Context, request.GetSplitsCalendar) (response.SplitsCalendar, response.Credits, error)
+	GetStatisticsCtx(context.Context, request.GetStatistics) (response.Statistics, response.Credits, error)
+	GetPressReleasesCtx(context.Context, request.GetPressReleases) (response.PressReleases, response.Credits, error)
+	GetIncomeStatementCtx(context.Context, request.GetIncomeStatement) (response.IncomeStatements, response.Credits, error)
+	GetIncomeStatementConsolidatedCtx(context.Context, request.GetIncomeStatement) (response.IncomeStatements, response.Credits, error)
+	GetBalanceSheetCtx(context.Context, request.GetBalanceSheet) (response.BalanceSheets, response.Credits, error)
+	GetBalanceSheetConsolidatedCtx(context.Context, request.GetBalanceSheet) (response.BalanceSheets, response.Credits, error)
+	GetCashFlowCtx(context.Context, request.GetCashFlow) (response.CashFlows, response.Credits, error)
+	GetCashFlowConsolidatedCtx(context.Context, request.GetCashFlow) (response.CashFlows, response.Credits, error)
+	GetKeyExecutivesCtx(context.Context, request.GetKeyExecutives) (response.KeyExecutives, response.Credits, error)
+	GetMarketCapCtx(context.Context, request.GetMarketCap) (response.MarketCap, response.Credits, error)
+	GetLastChangeCtx(context.Context, request.GetLastChange) (response.LastChange, response.Credits, error)
+
+	// Currencies
+	GetExchangeRateCtx(context.Context, request.GetExchangeRate) (response.ExchangeRate, response.Credits, error)
+	GetCurrencyConversionCtx(context.Context, request.GetCurrencyConversion) (response.CurrencyConversion, response.Credits, error)
+
+	// ETFs
+	GetETFsDirectoryCtx(context.Context, request.GetETFsDirectory) (response.ETFsDirectory, response.Credits, error)
+	GetETFFullDataCtx(context.Context, request.GetETFFullData) (response.ETFFullData, response.Credits, error)
+	GetETFSummaryCtx(context.Context, request.GetETFSummary) (response.ETFWorldSummary, response.Credits, error)
+	GetETFPerformanceCtx(context.Context, request.GetETFPerformance) (response.ETFPerformance, response.Credits, error)
+	GetETFRiskCtx(context.Context, request.GetETFRisk) (response.ETFRisk, response.Credits, error)
+	GetETFCompositionCtx(context.Context, request.GetETFComposition) (response.ETFComposition, response.Credits, error)
+	GetETFFamiliesCtx(context.Context, request.GetETFFamilies) (response.ETFFamilies, response.Credits, error)
+	GetETFTypesCtx(context.Context, request.GetETFTypes) (response.ETFTypes, response.Credits, error)
+
+	// Mutual Funds
+	GetMutualFundsDirectoryCtx(context.Context, request.GetMutualFundsDirectory) (response.MutualFundsDirectory, response.Credits, error)
+	GetMutualFundFullDataCtx(context.Context, request.GetMutualFundFullData) (response.MutualFundFullData, response.Credits, error)
+	GetMutualFundSummaryCtx(context.Context, request.GetMutualFundSummary) (response.MutualFundSummary, response.Credits, error)
+	GetMutualFundPerformanceCtx(context.Context, request.GetMutualFundPerformance) (response.MutualFundPerformance, response.Credits, error)
+	GetMutualFundRiskCtx(context.Context, request.GetMutualFundRisk) (response.MutualFundRisk, response.Credits, error)
+	GetMutualFundRatingsCtx(context.Context, request.GetMutualFundRatings) (response.MutualFundRatings, response.Credits, error)
+	GetMutualFundCompositionCtx(context.Context, request.GetMutualFundComposition) (response.MutualFundComposition, response.Credits, error)
+	GetMutualFundPurchaseInfoCtx(context.Context, request.GetMutualFundPurchaseInfo) (response.MutualFundPurchaseInfo, response.Credits, error)
+	GetMutualFundSustainabilityCtx(context.Context, request.GetMutualFundSustainability) (response.MutualFundSustainability, response.Credits, error)
+	GetMutualFundFamiliesCtx(context.Context, request.GetMutualFundFamilies) (response.MutualFundFamilies, response.Credits, error)
+	GetMutualFundTypesCtx(context.Context, request.GetMutualFundTypes) (response.MutualFundTypes, response.Credits, error)
+
+	// Technical Indicators
+	GetBBandsCtx(context.Context, request.GetBBands) (response.BBands, response.Credits, error)
+	GetSMACtx(context.Context, request.GetSMA) (response.SMA, response.Credits, error)
+	GetEMACtx(context.Context, request.GetEMA) (response.EMA, response.Credits, error)
+	GetMACtx(context.Context, request.GetMA) (response.MA, response.Credits, error)
+	GetWMACtx(context.Context, request.GetWMA) (response.WMA, response.Credits, error)
+	GetVWAPCtx(context.Context, request.GetVWAP) (response.VWAP, response.Credits, error)
+	GetDEMACtx(context.Context, request.GetDEMA) (response.DEMA, response.Credits, error)
+	GetTEMACtx(context.Context, request.GetTEMA) (response.TEMA, response.Credits, error)
+	GetTRMACtx(context.Context, request.GetTRMA) (response.TRMA, response.Credits, error)
+	GetKAMACtx(context.Context, request.GetKAMA) (response.KAMA, response.Credits, error)
+	GetSARCtx(context.Context, request.GetSAR) (response.SAR, response.Credits, error)
+	GetADXCtx(context.Context, request.GetADX) (response.ADX, response.Credits, error)
+	GetMACDCtx(context.Context, request.GetMACD) (response.MACD, response.Credits, error)
+	GetRSICtx(context.Context, request.GetRSI) (response.RSI, response.Credits, error)
+	GetStochCtx(context.Context, request.GetStoch) (response.Stoch, response.Credits, error)
+	GetPercentBCtx(context.Context, request.GetPercentB) (response.PercentB, response.Credits, error)
+	GetCCICtx(context.Context, request.GetCCI) (response.CCI, response.Credits, error)
+	GetWillRCtx(context.Context, request.GetWillR) (response.WillR, response.Credits, error)
+	GetROCCtx(context.Context, request.GetROC) (response.ROC, response.Credits, error)
+	GetMOMCtx(context.Context, request.GetMOM) (response.MOM, response.Credits, error)
+	GetOBVCtx(context.Context, request.GetOBV) (response.OBV, response.Credits, error)
+	GetADCtx(context.Context, request.GetAD) (response.AD, response.Credits, error)
+	GetATRCtx(context.Context, request.GetATR) (response.ATR, response.Credits, error)
+	GetNATRCtx(context.Context, request.GetNATR) (response.NATR, response.Credits, error)
+	GetTRCtx(context.Context, request.GetTR) (response.TR, response.Credits, error)
+
+	// Analysis
+	GetEarningsEstimateCtx(context.Context, request.GetEarningsEstimate) (response.EarningsEstimate, response.Credits, error)
+	GetRevenueEstimateCtx(context.Context, request.GetRevenueEstimate) (response.RevenueEstimate, response.Credits, error)
+	GetEPSTrendCtx(context.Context, request.GetEPSTrend) (response.EPSTrend, response.Credits, error)
+	GetEPSRevisionsCtx(context.Context, request.GetEPSRevisions) (response.EPSRevisions, response.Credits, error)
+	GetGrowthEstimatesCtx(context.Context, request.GetGrowthEstimates) (response.GrowthEstimates, response.Credits, error)
+	GetRecommendationsCtx(context.Context, request.GetRecommendations) (response.Recommendations, response.Credits, error)
+	GetPriceTargetCtx(context.Context, request.GetPriceTarget) (response.PriceTarget, response.Credits, error)
+	GetAnalystRatingsSnapshotCtx(context.Context, request.GetAnalystRatingsSnapshot) (response.AnalystRatingsSnapshot, response.Credits, error)
+	GetAnalystRatingsUSEquitiesCtx(context.Context, request.GetAnalystRatingsUSEquities) (response.AnalystRatingsUSEquities, response.Credits, error)
+
+	// Regulatory
+	GetEDGARFilingsCtx(context.Context, request.GetEDGARFilings) (response.EDGARFilings, response.Credits, error)
+	GetInsiderTransactionsCtx(context.Context, request.GetInsiderTransactions) (response.InsiderTransactions, response.Credits, error)
+	GetInstitutionalHoldersCtx(context.Context, request.GetInstitutionalHolders) (response.InstitutionalHolders, response.Credits, error)
+	GetFundHoldersCtx(context.Context, request.GetFundHolders) (response.FundHolders, response.Credits, error)
+	GetDirectHoldersCtx(context.Context, request.GetDirectHolders) (response.DirectHolders, response.Credits, error)
+	GetTaxInformationCtx(context.Context, request.GetTaxInformation) (response.TaxInformation, response.Credits, error)
+	GetSanctionedEntitiesCtx(context.Context, request.GetSanctionedEntities) (response.SanctionedEntities, response.Credits, error)
+
+	// Advanced
+	GetUsageCtx(context.Context, request.GetUsage) (response.Usage, response.Credits, error)
+	GetBatchesCtx(context.Context, request.GetBatches) (response.Batches, response.Credits, error)
+	GetComplexDataCtx(context.Context, request.GetComplexData) (response.ComplexData, response.Credits, error)
+}
+
+type client struct {
+	// Market Data
+	getTimeSeries      *Endpoint[request.GetTimeSeries, response.TimeSeries, response.Credits, error]
+	getTimeSeriesCross *Endpoint[request.GetTimeSeriesCross, response.TimeSeriesCross, response.Credits, error]
+	getQuote           *Endpoint[request.GetQuote, response.Quote, response.Credits, error]
+	getPrice           *Endpoint[request.GetPrice, response.Price, response.Credits, error]
+	getEOD             *Endpoint[request.GetEOD, response.EOD, response.Credits, error]
+	getMarketMovers    *Endpoint[request.GetMarketMovers, response.MarketMovers, response.Credits, error]
+
+	// Reference Data - Asset Catalogs
+	getStocks           *Endpoint[request.GetStock, response.Stocks, response.Credits, error]
+	getForexPairs       *Endpoint[request.GetForexPairs, response.ForexPairs, response.Credits, error]
+	getCryptocurrencies *Endpoint[request.GetCryptocurrencies, response.Cryptocurrencies, response.Credits, error]
+	getETFs             *Endpoint[request.GetETFs, response.ETFs, response.Credits, error]
+	getFunds            *Endpoint[request.GetFunds, response.Funds, response.Credits, error]
+	getCommodities      *Endpoint[request.GetCommodities, response.Commodities, response.Credits, error]
+	getBonds            *Endpoint[request.GetBonds, response.Bonds, response.Credits, error]
+
+	// Reference Data - Discovery
+	getSymbolSearch      *Endpoint[request.GetSymbolSearch, response.SymbolSearch, response.Credits, error]
+	getCrossListings     *Endpoint[request.GetCrossListings, response.CrossListings, response.Credits, error]
+	getEarliestTimestamp *Endpoint[request.GetEarliestTimestamp, response.EarliestTimestamp, response.Credits, error]
+
+	// Reference Data - Markets
+	getExchanges               *Endpoint[request.GetExchanges, response.Exchanges, response.Credits, error]
+	getExchangeSchedule        *Endpoint[request.GetExchangeSchedule, response.ExchangeSchedule, response.Credits, error]
+	getCryptocurrencyExchanges *Endpoint[request.GetCryptocurrencyExchanges, response.CryptocurrencyExchanges, response.Credits, error]
+	getMarketState             *Endpoint[request.GetMarketState, []response.MarketState, response.Credits, error]
+
+	// Reference Data - Supporting Metadata
+	getCountries           *Endpoint[request.GetCountries, response.Countries, response.Credits, error]
+	getInstrumentType      *Endpoint[request.GetInstrumentType, response.InstrumentType, response.Credits, error]
+	getTechnicalIndicators *Endpoint[request.GetTechnicalIndicators, response.TechnicalIndicators, response.Credits, error]
+
+	// Fundamentals
+	getLogo                        *Endpoint[request.GetLogo, response.Logo, response.Credits, error]
+	getProfile                     *Endpoint[request.GetProfile, response.Profile, response.Credits, error]
+	getDividends                   *Endpoint[request.GetDividends, response.Dividends, response.Credits, error]
+	getDividendsCalendar           *Endpoint[request.GetDividendsCalendar, response.DividendsCalendar, response.Credits, error]
+	getEarnings                    *Endpoint[request.GetEarnings, response.Earnings, response.Credits, error]
+	getEarningsCalendar            *Endpoint[request.GetEarningsCalendar, response.EarningsCalendar, response.Credits, error]
+	getIPOCalendar                 *Endpoint[request.GetIPOCalendar, response.IPOCalendar, response.Credits, error]
+	getSplits                      *Endpoint[request.GetSplits, response.Splits, response.Credits, error]
+	getSplitsCalendar              *Endpoint[request.GetSplitsCalendar, response.SplitsCalendar, response.Credits, error]
+	getStatistics                  *Endpoint[request.GetStatistics, response.Statistics, response.Credits, error]
+	getPressReleases               *Endpoint[request.GetPressReleases, response.PressReleases, response.Credits, error]
+	getIncomeStatement             *Endpoint[request.GetIncomeStatement, response.IncomeStatements, response.Credits, error]
+	getIncomeStatementConsolidated *Endpoint[request.GetIncomeStatement, response.IncomeStatements, response.Credits, error]
+	getBalanceSheet                *Endpoint[request.GetBalanceSheet, response.BalanceSheets, response.Credits, error]
+	getBalanceSheetConsolidated    *Endpoint[request.GetBalanceSheet, response.BalanceSheets, response.Credits, error]
+	getCashFlow                    *Endpoint[request.GetCashFlow, response.CashFlows, response.Credits, error]
+	getCashFlowConsolidated        *Endpoint[request.GetCashFlow, response.CashFlows, response.Credits, error]
+	getKeyExecutives               *Endpoint[request.GetKeyExecutives, response.KeyExecutives, response.Credits, error]
+	getMarketCap                   *Endpoint[request.GetMarketCap, response.MarketCap, response.Credits, error]
+	getLastChange                  *Endpoint[request.GetLastChange, response.LastChange, response.Credits, error]
+
+	// Currencies
+	getExchangeRate       *Endpoint[request.GetExchangeRate, response.ExchangeRate, response.Credits, error]
+	getCurrencyConversion *Endpoint[request.GetCurrencyConversion, response.CurrencyConversion, response.Credits, error]
+
+	// ETFs
+	getETFsDirectory  *Endpoint[request.GetETFsDirectory, response.ETFsDirectory, response.Credits, error]
+	getETFFullData    *Endpoint[request.GetETFFullData, response.ETFFullData, response.Credits, error]
+	getETFSummary     *Endpoint[request.GetETFSummary, response.ETFWorldSummary, response.Credits, error]
+	getETFPerformance *Endpoint[request.GetETFPerformance, response.ETFPerformance, response.Credits, error]
+	getETFRisk        *Endpoint[request.GetETFRisk, response.ETFRisk, response.Credits, error]
+	getETFComposition *Endpoint[request.GetETFComposition, response.ETFComposition, response.Credits, error]
+	getETFFamilies    *Endpoint[request.GetETFFamilies, response.ETFFamilies, response.Credits, error]
+	getETFTypes       *Endpoint[request.GetETFTypes, response.ETFTypes, response.Credits, error]
+
+	// Mutual Funds
+	getMutualFundsDirectory     *Endpoint[request.GetMutualFundsDirectory, response.MutualFundsDirectory, response.Credits, error]
+	getMutualFundFullData       *Endpoint[request.GetMutualFundFullData, response.MutualFundFullData, response.Credits, error]
+	getMutualFundSummary        *Endpoint[request.GetMutualFundSummary, response.MutualFundSummary, response.Credits, error]
+	getMutualFundPerformance    *Endpoint[request.GetMutualFundPerformance, response.MutualFundPerformance, response.Credits, error]
+	getMutualFundRisk           *Endpoint[request.GetMutualFundRisk, response.MutualFundRisk, response.Credits, error]
+	getMutualFundRatings        *Endpoint[request.GetMutualFundRatings, response.MutualFundRatings, response.Credits, error]
+	getMutualFundComposition    *Endpoint[request.GetMutualFundComposition, response.MutualFundComposition, response.Credits, error]
+	getMutualFundPurchaseInfo   *Endpoint[request.GetMutualFundPurchaseInfo, response.MutualFundPurchaseInfo, response.Credits, error]
+	getMutualFundSustainability *Endpoint[request.GetMutualFundSustainability, response.MutualFundSustainability, response.Credits, error]
+	getMutualFundFamilies       *Endpoint[request.GetMutualFundFamilies, response.MutualFundFamilies, response.Credits, error]
+	getMutualFundTypes          *Endpoint[request.GetMutualFundTypes, response.MutualFundTypes, response.Credits, error]
+
+	// Technical Indicators
+	getBBands   *Endpoint[request.GetBBands, response.BBands, response.Credits, error]
+	getSMA      *Endpoint[request.GetSMA, response.SMA, response.Credits, error]
+	getEMA      *Endpoint[request.GetEMA, response.EMA, response.Credits, error]
+	getMA       *Endpoint[request.GetMA, response.MA, response.Credits, error]
+	getWMA      *Endpoint[request.GetWMA, response.WMA, response.Credits, error]
+	getVWAP     *Endpoint[request.GetVWAP, response.VWAP, response.Credits, error]
+	getDEMA     *Endpoint[request.GetDEMA, response.DEMA, response.Credits, error]
+	getTEMA     *Endpoint[request.GetTEMA, response.TEMA, response.Credits, error]
+	getTRMA     *Endpoint[request.GetTRMA, response.TRMA, response.Credits, error]
+	getKAMA     *Endpoint[request.GetKAMA, response.KAMA, response.Credits, error]
+	getSAR      *Endpoint[request.GetSAR, response.SAR, response.Credits, error]
+	getADX      *Endpoint[request.GetADX, response.ADX, response.Credits, error]
+	getMACD     *Endpoint[request.GetMACD, response.MACD, response.Credits, error]
+	getRSI      *Endpoint[request.GetRSI, response.RSI, response.Credits, error]
+	getStoch    *Endpoint[request.GetStoch, response.Stoch, response.Credits, error]
+	getPercentB *Endpoint[request.GetPercentB, response.PercentB, response.Credits, error]
+	getCCI      *Endpoint[request.GetCCI, response.CCI, response.Credits, error]
+	getWillR    *Endpoint[request.GetWillR, response.WillR, response.Credits, error]
+	getROC      *Endpoint[request.GetROC, response.ROC, response.Credits, error]
+	getMOM      *Endpoint[request.GetMOM, response.MOM, response.Credits, error]
+	getOBV      *Endpoint[request.GetOBV, response.OBV, response.Credits, error]
+	getAD       *Endpoint[request.GetAD, response.AD, response.Credits, error]
+	getATR      *Endpoint[request.GetATR, response.ATR, response.Credits, error]
+	getNATR     *Endpoint[request.GetNATR, response.NATR, response.Credits, error]
+	getTR       *Endpoint[request.GetTR, response.TR, response.Credits, error]
+
+	// Analysis
+	getEarningsEstimate         *Endpoint[request.GetEarningsEstimate, response.EarningsEstimate, response.Credits, error]
+	getRevenueEstimate          *Endpoint[request.GetRevenueEstimate, response.RevenueEstimate, response.Credits, error]
+	getEPSTrend                 *Endpoint[request.GetEPSTrend, response.EPSTrend, response.Credits, error]
+	getEPSRevisions             *Endpoint[request.GetEPSRevisions, response.EPSRevisions, response.Credits, error]
+	getGrowthEstimates          *Endpoint[request.GetGrowthEstimates, response.GrowthEstimates, response.Credits, error]
+	getRecommendations          *Endpoint[request.GetRecommendations, response.Recommendations, response.Credits, error]
+	getPriceTarget              *Endpoint[request.GetPriceTarget, response.PriceTarget, response.Credits, error]
+	getAnalystRatingsSnapshot   *Endpoint[request.GetAnalystRatingsSnapshot, response.AnalystRatingsSnapshot, response.Credits, error]
+	getAnalystRatingsUSEquities *Endpoint[request.GetAnalystRatingsUSEquities, response.AnalystRatingsUSEquities, response.Credits, error]
+
+	// Regulatory
+	getEDGARFilings         *Endpoint[request.GetEDGARFilings, response.EDGARFilings, response.Credits, error]
+	getInsiderTransactions  *Endpoint[request.GetInsiderTransactions, response.InsiderTransactions, response.Credits, error]
+	getInstitutionalHolders *Endpoint[request.GetInstitutionalHolders, response.InstitutionalHolders, response.Credits, error]
+	getFundHolders          *Endpoint[request.GetFundHolders, response.FundHolders, response.Credits, error]
+	getDirectHolders        *Endpoint[request.GetDirectHolders, response.DirectHolders, response.Credits, error]
+	getTaxInformation       *Endpoint[request.GetTaxInformation, response.TaxInformation, response.Credits, error]
+	getSanctionedEntities   *Endpoint[request.GetSanctionedEntities, response.SanctionedEntities, response.Credits, error]
+
+	// Advanced
+	getUsage       *Endpoint[request.GetUsage, response.Usage, response.Credits, error]
+	getBatches     *Endpoint[request.GetBatches, response.Batches, response.Credits, error]
+	getComplexData *Endpoint[request.GetComplexData, response.ComplexData, response.Credits, error]
+
+	// Any indicator
+	getIndicator *Endpoint[indicatorRequest, response.IndicatorSeries, response.Credits, error]
+	indicators   *indicatorCatalog
+
+	// Raw calls
+	httpCli *HTTPCli
+	baseURL string
+}
+
+// NewClient creates a new Twelve Data API client instance with the provided HTTP client and configuration.
+// The httpCli parameter should be configured with appropriate timeout and other HTTP settings,
+// while cfg contains the API endpoints, authentication, and other client configuration.
+func NewClient(httpCli *HTTPCli, cfg *Conf) Client {
+	return client{
+		// Market Data
+		getTimeSeries:      newEndpoint[request.GetTimeSeries, response.TimeSeries](httpCli, cfg, cfg.CoreData.TimeSeriesURL, dictionary.TimeSeries),
+		getTimeSeriesCross: newEndpoint[request.GetTimeSeriesCross, response.TimeSeriesCross](httpCli, cfg, cfg.CoreData.TimeSeriesCrossURL, dictionary.TimeSeriesCross),
+		getQuote:           newEndpoint[request.GetQuote, response.Quote](httpCli, cfg, cfg.CoreData.QuotesURL, dictionary.Quote),
+		getPrice:           newEndpoint[request.GetPrice, response.Price](httpCli, cfg, cfg.CoreData.PriceURL, dictionary.Price),
+		getEOD:             newEndpoint[request.GetEOD, response.EOD](httpCli, cfg, cfg.CoreData.EODURL, dictionary.EOD),
+		getMarketMovers:    newEndpoint[request.GetMarketMovers, response.MarketMovers](httpCli, cfg, cfg.CoreData.MarketMoversURL, dictionary.MarketMovers),
+
+		// Reference Data - Asset Catalogs
+		getStocks:           newEndpoint[request.GetStock, response.Stocks](httpCli, cfg, cfg.ReferenceData.StocksURL, dictionary.Stocks),
+		getForexPairs:       newEndpoint[request.GetForexPairs, response.ForexPairs](httpCli, cfg, cfg.ReferenceData.ForexPairsURL, dictionary.ForexPairs),
+		getCryptocurrencies: newEndpoint[request.GetCryptocurrencies, response.Cryptocurrencies](httpCli, cfg, cfg.ReferenceData.CryptocurrenciesURL, dictionary.Cryptocurrencies),
+		getETFs:             newEndpoint[request.GetETFs, response.ETFs](httpCli, cfg, cfg.ReferenceData.ETFsURL, dictionary.ETFs),
+		getFunds:            newEndpoint[request.GetFunds, response.Funds](httpCli, cfg, cfg.ReferenceData.FundsURL, dictionary.Funds),
+		getCommodities:      newEndpoint[request.GetCommodities, response.Commodities](httpCli, cfg, cfg.ReferenceData.CommoditiesURL, dictionary.Commodities),
+		getBonds:            newEndpoint[request.GetBonds, response.Bonds](httpCli, cfg, cfg.ReferenceData.BondsURL, dictionary.Bonds),
+
+		// Reference Data - Discovery
+		getSymbolSearch:      newEndpoint[request.GetSymbolSearch, response.SymbolSearch](httpCli, cfg, cfg.ReferenceData.SymbolSearchURL, dictionary.SymbolSearch),
+		getCrossListings:     newEndpoint[request.GetCrossListings, response.CrossListings](httpCli, cfg, cfg.ReferenceData.CrossListingsURL, dictionary.CrossListings),
+		getEarliestTimestamp: newEndpoint[request.GetEarliestTimestamp, response.EarliestTimestamp](httpCli, cfg, cfg.ReferenceData.EarliestTimestampURL, dictionary.EarliestTimestamp),
+
+		// Reference Data - Markets
+		getExchanges:               newEndpoint[request.GetExchanges, response.Exchanges](httpCli, cfg, cfg.ReferenceData.ExchangesURL, dictionary.Exchanges),
+		getExchangeSchedule:        newEndpoint[request.GetExchangeSchedule, response.ExchangeSchedule](httpCli, cfg, cfg.ReferenceData.ExchangeScheduleURL, dictionary.ExchangeSchedule),
+		getCryptocurrencyExchanges: newEndpoint[request.GetCryptocurrencyExchanges, response.CryptocurrencyExchanges](httpCli, cfg, cfg.ReferenceData.CryptocurrencyExchangesURL, dictionary.CryptocurrencyExchanges),
+		getMarketState:             newEndpoint[request.GetMarketState, []response.MarketState](httpCli, cfg, cfg.ReferenceData.MarketStateURL, dictionary.MarketState),
+
+		// Reference Data - Supporting Metadata
+		getCountries:           newEndpoint[request.GetCountries, response.Countries](httpCli, cfg, cfg.ReferenceData.CountriesURL, dictionary.Countries),
+		getInstrumentType:      newEndpoint[request.GetInstrumentType, response.InstrumentType](httpCli, cfg, cfg.ReferenceData.InstrumentTypeURL, dictionary.InstrumentType),
+		getTechnicalIndicators: newEndpoint[request.GetTechnicalIndicators, response.TechnicalIndicators](httpCli, cfg, cfg.ReferenceData.TechnicalIndicatorsURL, dictionary.TechnicalIndicators),
+
+		// Fundamentals
+		getLogo:                        newEndpoint[request.GetLogo, response.Logo](httpCli, cfg, cfg.Fundamentals.LogoURL, dictionary.Logo),
+		getProfile:                     newEndpoint[request.GetProfile, response.Profile](httpCli, cfg, cfg.Fundamentals.ProfileURL, dictionary.Profile),
+		getDividends:                   newEndpoint[request.GetDividends, response.Dividends](httpCli, cfg, cfg.Fundamentals.DividendsURL, dictionary.Dividends),
+		getDividendsCalendar:           newEndpoint[request.GetDividendsCalendar, response.DividendsCalendar](httpCli, cfg, cfg.Fundamentals.DividendsCalendarURL, dictionary.DividendsCalendar),
+		getEarnings:                    newEndpoint[request.GetEarnings, response.Earnings](httpCli, cfg, cfg.Fundamentals.EarningsURL, dictionary.Earnings),
+		getEarningsCalendar:            newEndpoint[request.GetEarningsCalendar, response.EarningsCalendar](httpCli, cfg, cfg.Fundamentals.EarningsCalendarURL, dictionary.EarningsCalendar),
+		getIPOCalendar:                 newEndpoint[request.GetIPOCalendar, response.IPOCalendar](httpCli, cfg, cfg.Fundamentals.IPOCalendarURL, dictionary.IPOCalendar),
+		getSplits:                      newEndpoint[request.GetSplits, response.Splits](httpCli, cfg, cfg.Fundamentals.SplitsURL, dictionary.Splits),
+		getSplitsCalendar:              newEndpoint[request.GetSplitsCalendar, response.SplitsCalendar](httpCli, cfg, cfg.Fundamentals.SplitsCalendarURL, dictionary.SplitsCalendar),
+		getStatistics:                  newEndpoint[request.GetStatistics, response.Statistics](httpCli, cfg, cfg.Fundamentals.StatisticsURL, dictionary.Statistics),
+		getPressReleases:               newEndpoint[request.GetPressReleases, response.PressReleases](httpCli, cfg, cfg.Fundamentals.PressReleasesURL, dictionary.PressReleases),
+		getIncomeStatement:             newEndpoint[request.GetIncomeStatement, response.IncomeStatements](httpCli, cfg, cfg.Fundamentals.IncomeStatementURL, dictionary.IncomeStatement),
+		getIncomeStatementConsolidated: newEndpoint[request.GetIncomeStatement, response.IncomeStatements](httpCli, cfg, cfg.Fundamentals.IncomeStatementConsolidatedURL, dictionary.IncomeStatementConsolidated),
+		getBalanceSheet:                newEndpoint[request.GetBalanceSheet, response.BalanceSheets](httpCli, cfg, cfg.Fundamentals.BalanceSheetURL, dictionary.BalanceSheet),
+		getBalanceSheetConsolidated:    newEndpoint[request.GetBalanceSheet, response.BalanceSheets](httpCli, cfg, cfg.Fundamentals.BalanceSheetConsolidatedURL, dictionary.BalanceSheetConsolidated),
+		getCashFlow:                    newEndpoint[request.GetCashFlow, response.CashFlows](httpCli, cfg, cfg.Fundamentals.CashFlowURL, dictionary.CashFlow),
+		getCashFlowConsolidated:        newEndpoint[request.GetCashFlow, response.CashFlows](httpCli, cfg, cfg.Fundamentals.CashFlowConsolidatedURL, dictionary.CashFlowConsolidated),
+		getKeyExecutives:               newEndpoint[request.GetKeyExecutives, response.KeyExecutives](httpCli, cfg, cfg.Fundamentals.KeyExecutivesURL, dictionary.KeyExecutives),
+		getMarketCap:                   newEndpoint[request.GetMarketCap, response.MarketCap](httpCli, cfg, cfg.Fundamentals.MarketCapURL, dictionary.MarketCap),
+		getLastChange:                  newEndpoint[request.GetLastChange, response.LastChange](httpCli, cfg, cfg.Fundamentals.LastChangeURL, dictionary.LastChange),
+
+		// Currencies
+		getExchangeRate:       newEndpoint[request.GetExchangeRate, response.ExchangeRate](httpCli, cfg, cfg.Currencies.ExchangeRateURL, dictionary.ExchangeRate),
+		getCurrencyConversion: newEndpoint[request.GetCurrencyConversion, response.CurrencyConversion](httpCli, cfg, cfg.Currencies.CurrencyConversionURL, dictionary.CurrencyConversion),
+
+		// ETFs
+		getETFsDirectory:  newEndpoint[request.GetETFsDirectory, response.ETFsDirectory](httpCli, cfg, cfg.ETFs.ETFsDirectoryURL, dictionary.ETFsDirectory),
+		getETFFullData:    newEndpoint[request.GetETFFullData, response.ETFFullData](httpCli, cfg, cfg.ETFs.ETFsFullDataURL, dictionary.ETFFullData),
+		getETFSummary:     newEndpoint[request.GetETFSummary, response.ETFWorldSummary](httpCli, cfg, cfg.ETFs.ETFsSummaryURL, dictionary.ETFSummary),
+		getETFPerformance: newEndpoint[request.GetETFPerformance, response.ETFPerformance](httpCli, cfg, cfg.ETFs.ETFsPerformanceURL, dictionary.ETFPerformance),
+		getETFRisk:        newEndpoint[request.GetETFRisk, response.ETFRisk](httpCli, cfg, cfg.ETFs.ETFsRiskURL, dictionary.ETFRisk),
+		getETFComposition: newEndpoint[request.GetETFComposition, response.ETFComposition](httpCli, cfg, cfg.ETFs.ETFsCompositionURL, dictionary.ETFComposition),
+		getETFFamilies:    newEndpoint[request.GetETFFamilies, response.ETFFamilies](httpCli, cfg, cfg.ETFs.ETFsFamiliesURL, dictionary.ETFFamilies),
+		getETFTypes:       newEndpoint[request.GetETFTypes, response.ETFTypes](httpCli, cfg, cfg.ETFs.ETFsTypesURL, dictionary.ETFTypes),
+
+		// Mutual Funds
+		getMutualFundsDirectory:     newEndpoint[request.GetMutualFundsDirectory, response.MutualFundsDirectory](httpCli, cfg, cfg.MutualFunds.MutualFundsDirectoryURL, dictionary.MutualFundsDirectory),
+		getMutualFundFullData:       newEndpoint[request.GetMutualFundFullData, response.MutualFundFullData](httpCli, cfg, cfg.MutualFunds.MutualFundsFullDataURL, dictionary.MutualFundFullData),
+		getMutualFundSummary:        newEndpoint[request.GetMutualFundSummary, response.MutualFundSummary](httpCli, cfg, cfg.MutualFunds.MutualFundsSummaryURL, dictionary.MutualFundSummary),
+		getMutualFundPerformance:    newEndpoint[request.GetMutualFundPerformance, response.MutualFundPerformance](httpCli, cfg, cfg.MutualFunds.MutualFundsPerformanceURL, dictionary.MutualFundPerformance),
+		getMutualFundRisk:           newEndpoint[request.GetMutualFundRisk, response.MutualFundRisk](httpCli, cfg, cfg.MutualFunds.MutualFundsRiskURL, dictionary.MutualFundRisk),
+		getMutualFundRatings:        newEndpoint[request.GetMutualFundRatings, response.MutualFundRatings](httpCli, cfg, cfg.MutualFunds.MutualFundsRatingsURL, dictionary.MutualFundRatings),
+		getMutualFundComposition:    newEndpoint[request.GetMutualFundComposition, response.MutualFundComposition](httpCli, cfg, cfg.MutualFunds.MutualFundsCompositionURL, dictionary.MutualFundComposition),
+		getMutualFundPurchaseInfo:   newEndpoint[request.GetMutualFundPurchaseInfo, response.MutualFundPurchaseInfo](httpCli, cfg, cfg.MutualFunds.MutualFundsPurchaseInfoURL, dictionary.MutualFundPurchaseInfo),
+		getMutualFundSustainability: newEndpoint[request.GetMutualFundSustainability, response.MutualFundSustainability](httpCli, cfg, cfg.MutualFunds.MutualFundsSustainabilityURL, dictionary.MutualFundSustainability),
+		getMutualFundFamilies:       newEndpoint[request.GetMutualFundFamilies, response.MutualFundFamilies](httpCli, cfg, cfg.MutualFunds.MutualFundsFamiliesURL, dictionary.MutualFundFamilies),
+		getMutualFundTypes:          newEndpoint[request.GetMutualFundTypes, response.MutualFundTypes](httpCli, cfg, cfg.MutualFunds.MutualFundsTypesURL, dictionary.MutualFundTypes),
+
+		// Technical Indicators
+		getBBands:   newEndpoint[request.GetBBands, response.BBands](httpCli, cfg, cfg.TechnicalIndicators.BbandsURL, dictionary.BBands),
+		getSMA:      newEndpoint[request.GetSMA, response.SMA](httpCli, cfg, cfg.TechnicalIndicators.SMAURL, dictionary.SMA),
+		getEMA:      newEndpoint[request.GetEMA, response.EMA](httpCli, cfg, cfg.TechnicalIndicators.EMAURL, dictionary.EMA),
+		getMA:       newEndpoint[request.GetMA, response.MA](httpCli, cfg, cfg.TechnicalIndicators.MAURL, dictionary.MA),
+		getWMA:      newEndpoint[request.GetWMA, response.WMA](httpCli, cfg, cfg.TechnicalIndicators.WMAURL, dictionary.WMA),
+		getVWAP:     newEndpoint[request.GetVWAP, response.VWAP](httpCli, cfg, cfg.TechnicalIndicators.VWAPURL, dictionary.VWAP),
+		getDEMA:     newEndpoint[request.GetDEMA, response.DEMA](httpCli, cfg, cfg.TechnicalIndicators.DEMAURL, dictionary.DEMA),
+		getTEMA:     newEndpoint[request.GetTEMA, response.TEMA](httpCli, cfg, cfg.TechnicalIndicators.TEMAURL, dictionary.TEMA),
+		getTRMA:     newEndpoint[request.GetTRMA, response.TRMA](httpCli, cfg, cfg.TechnicalIndicators.TRMAURL, dictionary.TRMA),
+		getKAMA:     newEndpoint[request.GetKAMA, response.KAMA](httpCli, cfg, cfg.TechnicalIndicators.KAMAURL, dictionary.KAMA),
+		getSAR:      newEndpoint[request.GetSAR, response.SAR](httpCli, cfg, cfg.TechnicalIndicators.SARURL, dictionary.SAR),
+		getADX:      newEndpoint[request.GetADX, response.ADX](httpCli, cfg, cfg.TechnicalIndicators.ADXURL, dictionary.ADX),
+		getMACD:     newEndpoint[request.GetMACD, response.MACD](httpCli, cfg, cfg.TechnicalIndicators.MACDURL, dictionary.MACD),
+		getRSI:      newEndpoint[request.GetRSI, response.RSI](httpCli, cfg, cfg.TechnicalIndicators.RSIURL, dictionary.RSI),
+		getStoch:    newEndpoint[request.GetStoch, response.Stoch](httpCli, cfg, cfg.TechnicalIndicators.StochURL, dictionary.Stoch),
+		getPercentB: newEndpoint[request.GetPercentB, response.PercentB](httpCli, cfg, cfg.TechnicalIndicators.PercentBURL, dictionary.PercentB),
+		getCCI:      newEndpoint[request.GetCCI, response.CCI](httpCli, cfg, cfg.TechnicalIndicators.CCIURL, dictionary.CCI),
+		getWillR:    newEndpoint[request.GetWillR, response.WillR](httpCli, cfg, cfg.TechnicalIndicators.WilliamsRURL, dictionary.WillR),
+		getROC:      newEndpoint[request.GetROC, response.ROC](httpCli, cfg, cfg.TechnicalIndicators.ROCURL, dictionary.ROC),
+		getMOM:      newEndpoint[request.GetMOM, response.MOM](httpCli, cfg, cfg.TechnicalIndicators.MomURL, dictionary.MOM),
+		getOBV:      newEndpoint[request.GetOBV, response.OBV](httpCli, cfg, cfg.TechnicalIndicators.OBVURL, dictionary.OBV),
+		getAD:       newEndpoint[request.GetAD, response.AD](httpCli, cfg, cfg.TechnicalIndicators.ADURL, dictionary.AD),
+		getATR:      newEndpoint[request.GetATR, response.ATR](httpCli, cfg, cfg.TechnicalIndicators.ATRURL, dictionary.ATR),
+		getNATR:     newEndpoint[request.GetNATR, response.NATR](httpCli, cfg, cfg.TechnicalIndicators.NATRURL, dictionary.NATR),
+		getTR:       newEndpoint[request.GetTR, response.TR](httpCli, cfg, cfg.TechnicalIndicators.TRURL, dictionary.TR),
+
+		// Analysis
+		getEarningsEstimate:         newEndpoint[request.GetEarningsEstimate, response.EarningsEstimate](httpCli, cfg, cfg.Analysis.EarningsEstimateURL, dictionary.EarningsEstimate),
+		getRevenueEstimate:          newEndpoint[request.GetRevenueEstimate, response.RevenueEstimate](httpCli, cfg, cfg.Analysis.RevenueEstimateURL, dictionary.RevenueEstimate),
+		getEPSTrend:                 newEndpoint[request.GetEPSTrend, response.EPSTrend](httpCli, cfg, cfg.Analysis.EPSTrendURL, dictionary.EPSTrend),
+		getEPSRevisions:             newEndpoint[request.GetEPSRevisions, response.EPSRevisions](httpCli, cfg, cfg.Analysis.EPSRevisionsURL, dictionary.EPSRevisions),
+		getGrowthEstimates:          newEndpoint[request.GetGrowthEstimates, response.GrowthEstimates](httpCli, cfg, cfg.Analysis.GrowthEstimatesURL, dictionary.GrowthEstimates),
+		getRecommendations:          newEndpoint[request.GetRecommendations, response.Recommendations](httpCli, cfg, cfg.Analysis.RecommendationsURL, dictionary.Recommendations),
+		getPriceTarget:              newEndpoint[request.GetPriceTarget, response.PriceTarget](httpCli, cfg, cfg.Analysis.PriceTargetURL, dictionary.PriceTarget),
+		getAnalystRatingsSnapshot:   newEndpoint[request.GetAnalystRatingsSnapshot, response.AnalystRatingsSnapshot](httpCli, cfg, cfg.Analysis.AnalystRatingsSnapshotURL, dictionary.AnalystRatingsSnapshot),
+		getAnalystRatingsUSEquities: newEndpoint[request.GetAnalystRatingsUSEquities, response.AnalystRatingsUSEquities](httpCli, cfg, cfg.Analysis.AnalystRatingsUSEquitiesURL, dictionary.AnalystRatingsUSEquities),
+
+		// Regulatory
+		getEDGARFilings:         newEndpoint[request.GetEDGARFilings, response.EDGARFilings](httpCli, cfg, cfg.Regulatory.EDGARFilingsURL, dictionary.EDGARFilings),
+		getInsiderTransactions:  newEndpoint[request.GetInsiderTransactions, response.InsiderTransactions](httpCli, cfg, cfg.Regulatory.InsiderTransactionsURL, dictionary.InsiderTransactions),
+		getInstitutionalHolders: newEndpoint[request.GetInstitutionalHolders, response.InstitutionalHolders](httpCli, cfg, cfg.Regulatory.InstitutionalHoldersURL, dictionary.InstitutionalHolders),
+		getFundHolders:          newEndpoint[request.GetFundHolders, response.FundHolders](httpCli, cfg, cfg.Regulatory.FundHoldersURL, dictionary.FundHolders),
+		getDirectHolders:        newEndpoint[request.GetDirectHolders, response.DirectHolders](httpCli, cfg, cfg.Regulatory.DirectHoldersURL, dictionary.DirectHolders),
+		getTaxInformation:       newEndpoint[request.GetTaxInformation, response.TaxInformation](httpCli, cfg, cfg.Regulatory.TaxInformationURL, dictionary.TaxInformation),
+		getSanctionedEntities:   newEndpoint[request.GetSanctionedEntities, response.SanctionedEntities](httpCli, cfg, cfg.Regulatory.SanctionedEntitiesURL, dictionary.SanctionedEntities),
+
+		// Advanced
+		getUsage:       newEndpoint[request.GetUsage, response.Usage](httpCli, cfg, cfg.Advanced.UsageURL, dictionary.Usage),
+		getBatches:     newEndpoint[request.GetBatches, response.Batches](httpCli, cfg, cfg.Advanced.BatchesURL, dictionary.Batches),
+		getComplexData: newEndpoint[request.GetComplexData, response.ComplexData](httpCli, cfg, cfg.Advanced.ComplexDataURL, dictionary.ComplexData),
+
+		// Any indicator
+		getIndicator: newIndicatorEndpoint(httpCli, cfg),
+		indicators:   &indicatorCatalog{},
+
+		// Raw calls
+		httpCli: httpCli,
+		baseURL: cfg.BaseURL,
+	}
+}
+
+func (cli client) GetTimeSeries(req request.GetTimeSeries) (response.TimeSeries, response.Credits, error) {
+	return cli.getTimeSeries.Call(req)
+}
+
+func (cli client) GetTimeSeriesCtx(ctx context.Context, req request.GetTimeSeries) (response.TimeSeries, response.Credits, error) {
+	return cli.getTimeSeries.CallCtx(ctx, req)
+}
+
+func (cli client) GetTimeSeriesCross(req request.GetTimeSeriesCross) (response.TimeSeriesCross, response.Credits, error) {
+	return cli.getTimeSeriesCross.Call(req)
+}
+
+func (cli client) GetTimeSeriesCrossCtx(ctx context.Context, req request.GetTimeSeriesCross) (response.TimeSeriesCross, response.Credits, error) {
+	return cli.getTimeSeriesCross.CallCtx(ctx, req)
+}
+
+func (cli client) GetQuote(req request.GetQuote) (response.Quote, response.Credits, error) {
+	return cli.getQuote.Call(req)
+}
+
+func (cli client) GetQuoteCtx(ctx context.Context, req request.GetQuote) (response.Quote, response.Credits, error) {
+	return cli.getQuote.CallCtx(ctx, req)
+}
+
+func (cli client) GetPrice(req request.GetPrice) (response.Price, response.Credits, error) {
+	return cli.getPrice.Call(req)
+}
+
+func (cli client) GetPriceCtx(ctx context.Context, req request.GetPrice) (response.Price, response.Credits, error) {
+	return cli.getPrice.CallCtx(ctx, req)
+}
+
+func (cli client) GetEOD(req request.GetEOD) (response.EOD, response.Credits, error) {
+	return cli.getEOD.Call(req)
+}
+
+func (cli client) GetEODCtx(ctx context.Context, req request.GetEOD) (response.EOD, response.Credits, error) {
+	return cli.getEOD.CallCtx(ctx, req)
+}
+
+func (cli client) GetMarketMovers(req request.GetMarketMovers) (response.MarketMovers, response.Credits, error) {
+	return cli.getMarketMovers.Call(req)
+}
+
+func (cli client) GetMarketMoversCtx(ctx context.Context, req request.GetMarketMovers) (response.MarketMovers, response.Credits, error) {
+	return cli.getMarketMovers.CallCtx(ctx, req)
+}
+
+func (cli client) GetStocks(req request.GetStock) (response.Stocks, response.Credits, error) {
+	return cli.getStocks.Call(req)
+}
+
+func (cli client) GetStocksCtx(ctx context.Context, req request.GetStock) (response.Stocks, response.Credits, error) {
+	return cli.getStocks.CallCtx(ctx, req)
+}
+
+func (cli client) GetForexPairs(req request.GetForexPairs) (response.ForexPairs, response.Credits, error) {
+	return cli.getForexPairs.Call(req)
+}
+
+func (cli client) GetForexPairsCtx(ctx context.Context, req request.GetForexPairs) (response.ForexPairs, response.Credits, error) {
+	return cli.getForexPairs.CallCtx(ctx, req)
+}
+
+func (cli client) GetCryptocurrencies(req request.GetCryptocurrencies) (response.Cryptocurrencies, response.Credits, error) {
+	return cli.getCryptocurrencies.Call(req)
+}
+
+func (cli client) GetCryptocurrenciesCtx(ctx context.Context, req request.GetCryptocurrencies) (response.Cryptocurrencies, response.Credits, error) {
+	return cli.getCryptocurrencies.CallCtx(ctx, req)
+}
+
+func (cli client) GetETFs(req request.GetETFs) (response.ETFs, response.Credits, error) {
+	return cli.getETFs.Call(req)
+}
+
+func (cli client) GetETFsCtx(ctx context.Context, req request.GetETFs) (response.ETFs, response.Credits, error) {
+	return cli.getETFs.CallCtx(ctx, req)
+}
+
+func (cli client) GetFunds(req request.GetFunds) (response.Funds, response.Credits, error) {
+	return cli.getFunds.Call(req)
+}
+
+func (cli client) GetFundsCtx(ctx context.Context, req request.GetFunds) (response.Funds, response.Credits, error) {
+	return cli.getFunds.CallCtx(ctx, req)
+}
+
+func (cli client) GetCommodities(req request.GetCommodities) (response.Commodities, response.Credits, error) {
+	return cli.getCommodities.Call(req)
+}
+
+func (cli client) GetCommoditiesCtx(ctx context.Context, req request.GetCommodities) (response.Commodities, response.Credits, error) {
+	return cli.getCommodities.CallCtx(ctx, req)
+}
+
+func (cli client) GetBonds(req request.GetBonds) (response.Bonds, response.Credits, error) {
+	return cli.getBonds.Call(req)
+}
+
+func (cli client) GetBondsCtx(ctx context.Context, req request.GetBonds) (response.Bonds, response.Credits, error) {
+	return cli.getBonds.CallCtx(ctx, req)
+}
+
+func (cli client) GetSymbolSearch(req request.GetSymbolSearch) (response.SymbolSearch, response.Credits, error) {
+	return cli.getSymbolSearch.Call(req)
+}
+
+func (cli client) GetSymbolSearchCtx(ctx context.Context, req request.GetSymbolSearch) (response.SymbolSearch, response.Credits, error) {
+	return cli.getSymbolSearch.CallCtx(ctx, req)
+}
+
+func (cli client) GetCrossListings(req request.GetCrossListings) (response.CrossListings, response.Credits, error) {
+	return cli.getCrossListings.Call(req)
+}
+
+func (cli client) GetCrossListingsCtx(ctx context.Context, req request.GetCrossListings) (response.CrossListings, response.Credits, error) {
+	return cli.getCrossListings.CallCtx(ctx, req)
+}
+
+func (cli client) GetEarliestTimestamp(req request.GetEarliestTimestamp) (response.EarliestTimestamp, response.Credits, error) {
+	return cli.getEarliestTimestamp.Call(req)
+}
+
+func (cli client) GetEarliestTimestampCtx(ctx context.Context, req request.GetEarliestTimestamp) (response.EarliestTimestamp, response.Credits, error) {
+	return cli.getEarliestTimestamp.CallCtx(ctx, req)
+}
+
+func (cli client) GetExchanges(req request.GetExchanges) (response.Exchanges, response.Credits, error) {
+	return cli.getExchanges.Call(req)
+}
+
+func (cli client) GetExchangesCtx(ctx context.Context, req request.GetExchanges) (response.Exchanges, response.Credits, error) {
+	return cli.getExchanges.CallCtx(ctx, req)
+}
+
+func (cli client) GetExchangeSchedule(req request.GetExchangeSchedule) (response.ExchangeSchedule, response.Credits, error) {
+	return cli.getExchangeSchedule.Call(req)
+}
+
+func (cli client) GetExchangeScheduleCtx(ctx context.Context, req request.GetExchangeSchedule) (response.ExchangeSchedule, response.Credits, error) {
+	return cli.getExchangeSchedule.CallCtx(ctx, req)
+}
+
+func (cli client) GetCryptocurrencyExchanges(req request.GetCryptocurrencyExchanges) (response.CryptocurrencyExchanges, response.Credits, error) {
+	return cli.getCryptocurrencyExchanges.Call(req)
+}
+
+func (cli client) GetCryptocurrencyExchangesCtx(ctx context.Context, req request.GetCryptocurrencyExchanges) (response.CryptocurrencyExchanges, response.Credits, error) {
+	return cli.getCryptocurrencyExchanges.CallCtx(ctx, req)
+}
+
+func (cli client) GetMarketState(req request.GetMarketState) ([]response.MarketState, response.Credits, error) {
+	return cli.getMarketState.Call(req)
+}
+
+func (cli client) GetMarketStateCtx(ctx context.Context, req request.GetMarketState) ([]response.MarketState, response.Credits, error) {
+	return cli.getMarketState.CallCtx(ctx, req)
+}
+
+func (cli client) GetCountries(req request.GetCountries) (response.Countries, response.Credits, error) {
+	return cli.getCountries.Call(req)
+}
+
+func (cli client) GetCountriesCtx(ctx context.Context, req request.GetCountries) (response.Countries, response.Credits, error) {
+	return cli.getCountries.CallCtx(ctx, req)
+}
+
+func (cli client) GetInstrumentType(req request.GetInstrumentType) (response.InstrumentType, response.Credits, error) {
+	return cli.getInstrumentType.Call(req)
+}
+
+func (cli client) GetInstrumentTypeCtx(ctx context.Context, req request.GetInstrumentType) (response.InstrumentType, response.Credits, error) {
+	return cli.getInstrumentType.CallCtx(ctx, req)
+}
+
+func (cli client) GetTechnicalIndicators(req request.GetTechnicalIndicators) (response.TechnicalIndicators, response.Credits, error) {
+	return cli.getTechnicalIndicators.Call(req)
+}
+
+func (cli client) GetTechnicalIndicatorsCtx(ctx context.Context, req request.GetTechnicalIndicators) (response.TechnicalIndicators, response.Credits, error) {
+	return cli.getTechnicalIndicators.CallCtx(ctx, req)
+}
+
+func (cli client) GetLogo(req request.GetLogo) (response.Logo, response.Credits, error) {
+	return cli.getLogo.Call(req)
+}
+
+func (cli client) GetLogoCtx(ctx context.Context, req request.GetLogo) (response.Logo, response.Credits, error) {
+	return cli.getLogo.CallCtx(ctx, req)
+}
+
+func (cli client) GetProfile(req request.GetProfile) (response.Profile, response.Credits, error) {
+	return cli.getProfile.Call(req)
+}
+
+func (cli client) GetProfileCtx(ctx context.Context, req request.GetProfile) (response.Profile, response.Credits, error) {
+	return cli.getProfile.CallCtx(ctx, req)
+}
+
+func (cli client) GetDividends(req request.GetDividends) (response.Dividends, response.Credits, error) {
+	return cli.getDividends.Call(req)
+}
+
+func (cli client) GetDividendsCtx(ctx context.Context, req request.GetDividends) (response.Dividends, response.Credits, error) {
+	return cli.getDividends.CallCtx(ctx, req)
+}
+
+func (cli client) GetDividendsCalendar(req request.GetDividendsCalendar) (response.DividendsCalendar, response.Credits, error) {
+	return cli.getDividendsCalendar.Call(req)
+}
+
+func (cli client) GetDividendsCalendarCtx(ctx context.Context, req request.GetDividendsCalendar) (response.DividendsCalendar, response.Credits, error) {
+	return cli.getDividendsCalendar.CallCtx(ctx, req)
+}
+
+func (cli client) GetEarnings(req request.GetEarnings) (response.Earnings, response.Credits, error) {
+	return cli.getEarnings.Call(req)
+}
+
+func (cli client) GetEarningsCtx(ctx context.Context, req request.GetEarnings) (response.Earnings, response.Credits, error) {
+	return cli.getEarnings.CallCtx(ctx, req)
+}
+
+func (cli client) GetEarningsCalendar(req request.GetEarningsCalendar) (response.EarningsCalendar, response.Credits, error) {
+	return cli.getEarningsCalendar.Call(req)
+}
+
+func (cli client) GetEarningsCalendarCtx(ctx context.Context, req request.GetEarningsCalendar) (response.EarningsCalendar, response.Credits, error) {
+	return cli.getEarningsCalendar.CallCtx(ctx, req)
+}
+
+func (cli client) GetIPOCalendar(req request.GetIPOCalendar) (response.IPOCalendar, response.Credits, error) {
+	return cli.getIPOCalendar.Call(req)
+}
+
+func (cli client) GetIPOCalendarCtx(ctx context.Context, req request.GetIPOCalendar) (response.IPOCalendar, response.Credits, error) {
+	return cli.getIPOCalendar.CallCtx(ctx, req)
+}
+
+func (cli client) GetSplits(req request.GetSplits) (response.Splits, response.Credits, error) {
+	return cli.getSplits.Call(req)
+}
+
+func (cli client) GetSplitsCtx(ctx context.Context, req request.GetSplits) (response.Splits, response.Credits, error) {
+	return cli.getSplits.CallCtx(ctx, req)
+}
+
+func (cli client) GetSplitsCalendar(req request.GetSplitsCalendar) (response.SplitsCalendar, response.Credits, error) {
+	return cli.getSplitsCalendar.Call(req)
+}
+
+func (cli client) GetSplitsCalendarCtx(ctx context.Context, req request.GetSplitsCalendar) (response.SplitsCalendar, response.Credits, error) {
+	return cli.getSplitsCalendar.CallCtx(ctx, req)
+}
+
+func (cli client) GetStatistics(req request.GetStatistics) (response.Statistics, response.Credits, error) {
+	return cli.getStatistics.Call(req)
+}
+
+func (cli client) GetStatisticsCtx(ctx context.Context, req request.GetStatistics) (response.Statistics, response.Credits, error) {
+	return cli.getStatistics.CallCtx(ctx, req)
+}
+
+func (cli client) GetPressReleases(req request.GetPressReleases) (response.PressReleases, response.Credits, error) {
+	return cli.getPressReleases.Call(req)
+}
+
+func (cli client) GetPressReleasesCtx(ctx context.Context, req request.GetPressReleases) (response.PressReleases, response.Credits, error) {
+	return cli.getPressReleases.CallCtx(ctx, req)
+}
+
+func (cli client) GetIncomeStatement(req request.GetIncomeStatement) (response.IncomeStatements, response.Credits, error) {
+	return cli.getIncomeStatement.Call(req)
+}
+
+func (cli client) GetIncomeStatementCtx(ctx context.Context, req request.GetIncomeStatement) (response.IncomeStatements, response.Credits, error) {
+	return cli.getIncomeStatement.CallCtx(ctx, req)
+}
+
+func (cli client) GetIncomeStatementConsolidated(req request.GetIncomeStatement) (response.IncomeStatements, response.Credits, error) {
+	return cli.getIncomeStatementConsolidated.Call(req)
+}
+
+func (cli client) GetIncomeStatementConsolidatedCtx(ctx context.Context, req request.GetIncomeStatement) (response.IncomeStatements, response.Credits, error) {
+	return cli.getIncomeStatementConsolidated.CallCtx(ctx, req)
+}
+
+func (cli client) GetBalanceSheet(req request.GetBalanceSheet) (response.BalanceSheets, response.Credits, error) {
+	return cli.getBalanceSheet.Call(req)
+}
+
+func (cli client) GetBalanceSheetCtx(ctx context.Context, req request.GetBalanceSheet) (response.BalanceSheets, response.Credits, error) {
+	return cli.getBalanceSheet.CallCtx(ctx, req)
+}
+
+func (cli client) GetBalanceSheetConsolidated(req request.GetBalanceSheet) (response.BalanceSheets, response.Credits, error) {
+	return cli.getBalanceSheetConsolidated.Call(req)
+}
+
+func (cli client) GetBalanceSheetConsolidatedCtx(ctx context.Context, req request.GetBalanceSheet) (response.BalanceSheets, response.Credits, error) {
+	return cli.getBalanceSheetConsolidated.CallCtx(ctx, req)
+}
+
+func (cli client) GetCashFlow(req request.GetCashFlow) (response.CashFlows, response.Credits, error) {
+	return cli.getCashFlow.Call(req)
+}
+
+func (cli client) GetCashFlowCtx(ctx context.Context, req request.GetCashFlow) (response.CashFlows, response.Credits, error) {
+	return cli.getCashFlow.CallCtx(ctx, req)
+}
+
+func (cli client) GetCashFlowConsolidated(req request.GetCashFlow) (response.CashFlows, response.Credits, error) {
+	return cli.getCashFlowConsolidated.Call(req)
+}
+
+func (cli client) GetCashFlowConsolidatedCtx(ctx context.Context, req request.GetCashFlow) (response.CashFlows, response.Credits, error) {
+	return cli.getCashFlowConsolidated.CallCtx(ctx, req)
+}
+
+func (cli client) GetKeyExecutives(req request.GetKeyExecutives) (response.KeyExecutives, response.Credits, error) {
+	return cli.getKeyExecutives.Call(req)
+}
+
+func (cli client) GetKeyExecutivesCtx(ctx context.Context, req request.GetKeyExecutives) (response.KeyExecutives, response.Credits, error) {
+	return cli.getKeyExecutives.CallCtx(ctx, req)
+}
+
+func (cli client) GetMarketCap(req request.GetMarketCap) (response.MarketCap, response.Credits, error) {
+	return cli.getMarketCap.Call(req)
+}
+
+func (cli client) GetMarketCapCtx(ctx context.Context, req request.GetMarketCap) (response.MarketCap, response.Credits, error) {
+	return cli.getMarketCap.CallCtx(ctx, req)
+}
+
+func (cli client) GetLastChange(req request.GetLastChange) (response.LastChange, response.Credits, error) {
+	return cli.getLastChange.Call(req)
+}
+
+func (cli client) GetLastChangeCtx(ctx context.Context, req request.GetLastChange) (response.LastChange, response.Credits, error) {
+	return cli.getLastChange.CallCtx(ctx, req)
+}
+
+func (cli client) GetExchangeRate(req request.GetExchangeRate) (response.ExchangeRate, response.Credits, error) {
+	return cli.getExchangeRate.Call(req)
+}
+
+func (cli client) GetExchangeRateCtx(ctx context.Context, req request.GetExchangeRate) (response.ExchangeRate, response.Credits, error) {
+	return cli.getExchangeRate.CallCtx(ctx, req)
+}
+
+func (cli client) GetCurrencyConversion(req request.GetCurrencyConversion) (response.CurrencyConversion, response.Credits, error) {
+	return cli.getCurrencyConversion.Call(req)
+}
+
+func (cli client) GetCurrencyConversionCtx(ctx context.Context, req request.GetCurrencyConversion) (response.CurrencyConversion, response.Credits, error) {
+	return cli.getCurrencyConversion.CallCtx(ctx, req)
+}
+
+func (cli client) GetETFsDirectory(req request.GetETFsDirectory) (response.ETFsDirectory, response.Credits, error) {
+	return cli.getETFsDirectory.Call(req)
+}
+
+func (cli client) GetETFsDirectoryCtx(ctx context.Context, req request.GetETFsDirectory) (response.ETFsDirectory, response.Credits, error) {
+	return cli.getETFsDirectory.CallCtx(ctx, req)
+}
+
+func (cli client) GetETFFullData(req request.GetETFFullData) (response.ETFFullData, response.Credits, error) {
+	return cli.getETFFullData.Call(req)
+}
+
+func (cli client) GetETFFullDataCtx(ctx context.Context, req request.GetETFFullData) (response.ETFFullData, response.Credits, error) {
+	return cli.getETFFullData.CallCtx(ctx, req)
+}
+
+func (cli client) GetETFSummary(req request.GetETFSummary) (response.ETFWorldSummary, response.Credits, error) {
+	return cli.getETFSummary.Call(req)
+}
+
+func (cli client) GetETFSummaryCtx(ctx context.Context, req request.GetETFSummary) (response.ETFWorldSummary, response.Credits, error) {
+	return cli.getETFSummary.CallCtx(ctx, req)
+}
+
+func (cli client) GetETFPerformance(req request.GetETFPerformance) (response.ETFPerformance, response.Credits, error) {
+	return cli.getETFPerformance.Call(req)
+}
+
+func (cli client) GetETFPerformanceCtx(ctx context.Context, req request.GetETFPerformance) (response.ETFPerformance, response.Credits, error) {
+	return cli.getETFPerformance.CallCtx(ctx, req)
+}
+
+func (cli client) GetETFRisk(req request.GetETFRisk) (response.ETFRisk, response.Credits, error) {
+	return cli.getETFRisk.Call(req)
+}
+
+func (cli client) GetETFRiskCtx(ctx context.Context, req request.GetETFRisk) (response.ETFRisk, response.Credits, error) {
+	return cli.getETFRisk.CallCtx(ctx, req)
+}
+
+func (cli client) GetETFComposition(req request.GetETFComposition) (response.ETFComposition, response.Credits, error) {
+	return cli.getETFComposition.Call(req)
+}
+
+func (cli client) GetETFCompositionCtx(ctx context.Context, req request.GetETFComposition) (response.ETFComposition, response.Credits, error) {
+	return cli.getETFComposition.CallCtx(ctx, req)
+}
+
+func (cli client) GetETFFamilies(req request.GetETFFamilies) (response.ETFFamilies, response.Credits, error) {
+	return cli.getETFFamilies.Call(req)
+}
+
+func (cli client) GetETFFamiliesCtx(ctx context.Context, req request.GetETFFamilies) (response.ETFFamilies, response.Credits, error) {
+	return cli.getETFFamilies.CallCtx(ctx, req)
+}
+
+func (cli client) GetETFTypes(req request.GetETFTypes) (response.ETFTypes, response.Credits, error) {
+	return cli.getETFTypes.Call(req)
+}
+
+func (cli client) GetETFTypesCtx(ctx context.Context, req request.GetETFTypes) (response.ETFTypes, response.Credits, error) {
+	return cli.getETFTypes.CallCtx(ctx, req)
+}
+
+func (cli client) GetMutualFundsDirectory(req request.GetMutualFundsDirectory) (response.MutualFundsDirectory, response.Credits, error) {
+	return cli.getMutualFundsDirectory.Call(req)
+}
+
+func (cli client) GetMutualFundsDirectoryCtx(ctx context.Context, req request.GetMutualFundsDirectory) (response.MutualFundsDirectory, response.Credits, error) {
+	return cli.getMutualFundsDirectory.CallCtx(ctx, req)
+}
+
+func (cli client) GetMutualFundFullData(req request.GetMutualFundFullData) (response.MutualFundFullData, response.Credits, error) {
+	return cli.getMutualFundFullData.Call(req)
+}
+
+func (cli client) GetMutualFundFullDataCtx(ctx context.Context, req request.GetMutualFundFullData) (response.MutualFundFullData, response.Credits, error) {
+	return cli.getMutualFundFullData.CallCtx(ctx, req)
+}
+
+func (cli client) GetMutualFundSummary(req request.GetMutualFundSummary) (response.MutualFundSummary, response.Credits, error) {
+	return cli.getMutualFundSummary.Call(req)
+}
+
+func (cli client) GetMutualFundSummaryCtx(ctx context.Context, req request.GetMutualFundSummary) (response.MutualFundSummary, response.Credits, error) {
+	return cli.getMutualFundSummary.CallCtx(ctx, req)
+}
+
+func (cli client) GetMutualFundPerformance(req request.GetMutualFundPerformance) (response.MutualFundPerformance, response.Credits, error) {
+	return cli.getMutualFundPerformance.Call(req)
+}
+
+func (cli client) GetMutualFundPerformanceCtx(ctx context.Context, req request.GetMutualFundPerformance) (response.MutualFundPerformance, response.Credits, error) {
+	return cli.getMutualFundPerformance.CallCtx(ctx, req)
+}
+
+func (cli client) GetMutualFundRisk(req request.GetMutualFundRisk) (response.MutualFundRisk, response.Credits, error) {
+	return cli.getMutualFundRisk.Call(req)
+}
+
+func (cli client) GetMutualFundRiskCtx(ctx context.Context, req request.GetMutualFundRisk) (response.MutualFundRisk, response.Credits, error) {
+	return cli.getMutualFundRisk.CallCtx(ctx, req)
+}
+
+func (cli client) GetMutualFundRatings(req request.GetMutualFundRatings) (response.MutualFundRatings, response.Credits, error) {
+	return cli.getMutualFundRatings.Call(req)
+}
+
+func (cli client) GetMutualFundRatingsCtx(ctx context.Context, req request.GetMutualFundRatings) (response.MutualFundRatings, response.Credits, error) {
+	return cli.getMutualFundRatings.CallCtx(ctx, req)
+}
+
+func (cli client) GetMutualFundComposition(req request.GetMutualFundComposition) (response.MutualFundComposition, response.Credits, error) {
+	return cli.getMutualFundComposition.Call(req)
+}
+
+func (cli client) GetMutualFundCompositionCtx(ctx context.Context, req request.GetMutualFundComposition) (response.MutualFundComposition, response.Credits, error) {
+	return cli.getMutualFundComposition.CallCtx(ctx, req)
+}
+
+func (cli client) GetMutualFundPurchaseInfo(req request.GetMutualFundPurchaseInfo) (response.MutualFundPurchaseInfo, response.Credits, error) {
+	return cli.getMutualFundPurchaseInfo.Call(req)
+}
+
+func (cli client) GetMutualFundPurchaseInfoCtx(ctx context.Context, req request.GetMutualFundPurchaseInfo) (response.MutualFundPurchaseInfo, response.Credits, error) {
+	return cli.getMutualFundPurchaseInfo.CallCtx(ctx, req)
+}
+
+func (cli client) GetMutualFundSustainability(req request.GetMutualFundSustainability) (response.MutualFundSustainability, response.Credits, error) {
+	return cli.getMutualFundSustainability.Call(req)
+}
+
+func (cli client) GetMutualFundSustainabilityCtx(ctx context.Context, req request.GetMutualFundSustainability) (response.MutualFundSustainability, response.Credits, error) {
+	return cli.getMutualFundSustainability.CallCtx(ctx, req)
+}
+
+func (cli client) GetMutualFundFamilies(req request.GetMutualFundFamilies) (response.MutualFundFamilies, response.Credits, error) {
+	return cli.getMutualFundFamilies.Call(req)
+}
+
+func (cli client) GetMutualFundFamiliesCtx(ctx context.Context, req request.GetMutualFundFamilies) (response.MutualFundFamilies, response.Credits, error) {
+	return cli.getMutualFundFamilies.CallCtx(ctx, req)
+}
+
+func (cli client) GetMutualFundTypes(req request.GetMutualFundTypes) (response.MutualFundTypes, response.Credits, error) {
+	return cli.getMutualFundTypes.Call(req)
+}
+
+func (cli client) GetMutualFundTypesCtx(ctx context.Context, req request.GetMutualFundTypes) (response.MutualFundTypes, response.Credits, error) {
+	return cli.getMutualFundTypes.CallCtx(ctx, req)
+}
+
+func (cli client) GetBBands(req request.GetBBands) (response.BBands, response.Credits, error) {
+	return cli.getBBands.Call(req)
+}
+
+func (cli client) GetBBandsCtx(ctx context.Context, req request.GetBBands) (response.BBands, response.Credits, error) {
+	return cli.getBBands.CallCtx(ctx, req)
+}
+
+func (cli client) GetSMA(req request.GetSMA) (response.SMA, response.Credits, error) {
+	return cli.getSMA.Call(req)
+}
+
+func (cli client) GetSMACtx(ctx context.Context, req request.GetSMA) (response.SMA, response.Credits, error) {
+	return cli.getSMA.CallCtx(ctx, req)
+}
+
+func (cli client) GetEMA(req request.GetEMA) (response.EMA, response.Credits, error) {
+	return cli.getEMA.Call(req)
+}
+
+func (cli client) GetEMACtx(ctx context.Context, req request.GetEMA) (response.EMA, response.Credits, error) {
+	return cli.getEMA.CallCtx(ctx, req)
+}
+
+func (cli client) GetMA(req request.GetMA) (response.MA, response.Credits, error) {
+	return cli.getMA.Call(req)
+}
+
+func (cli client) GetMACtx(ctx context.Context, req request.GetMA) (response.MA, response.Credits, error) {
+	return cli.getMA.CallCtx(ctx, req)
+}
+
+func (cli client) GetWMA(req request.GetWMA) (response.WMA, response.Credits, error) {
+	return cli.getWMA.Call(req)
+}
+
+func (cli client) GetWMACtx(ctx context.Context, req request.GetWMA) (response.WMA, response.Credits, error) {
+	return cli.getWMA.CallCtx(ctx, req)
+}
+
+func (cli client) GetVWAP(req request.GetVWAP) (response.VWAP, response.Credits, error) {
+	return cli.getVWAP.Call(req)
+}
+
+func (cli client) GetVWAPCtx(ctx context.Context, req request.GetVWAP) (response.VWAP, response.Credits, error) {
+	return cli.getVWAP.CallCtx(ctx, req)
+}
+
+func (cli client) GetDEMA(req request.GetDEMA) (response.DEMA, response.Credits, error) {
+	return cli.getDEMA.Call(req)
+}
+
+func (cli client) GetDEMACtx(ctx context.Context, req request.GetDEMA) (response.DEMA, response.Credits, error) {
+	return cli.getDEMA.CallCtx(ctx, req)
+}
+
+func (cli client) GetTEMA(req request.GetTEMA) (response.TEMA, response.Credits, error) {
+	return cli.getTEMA.Call(req)
+}
+
+func (cli client) GetTEMACtx(ctx context.Context, req request.GetTEMA) (response.TEMA, response.Credits, error) {
+	return cli.getTEMA.CallCtx(ctx, req)
+}
+
+func (cli client) GetTRMA(req request.GetTRMA) (response.TRMA, response.Credits, error) {
+	return cli.getTRMA.Call(req)
+}
+
+func (cli client) GetTRMACtx(ctx context.Context, req request.GetTRMA) (response.TRMA, response.Credits, error) {
+	return cli.getTRMA.CallCtx(ctx, req)
+}
+
+func (cli client) GetKAMA(req request.GetKAMA) (response.KAMA, response.Credits, error) {
+	return cli.getKAMA.Call(req)
+}
+
+func (cli client) GetKAMACtx(ctx context.Context, req request.GetKAMA) (response.KAMA, response.Credits, error) {
+	return cli.getKAMA.CallCtx(ctx, req)
+}
+
+func (cli client) GetSAR(req request.GetSAR) (response.SAR, response.Credits, error) {
+	return cli.getSAR.Call(req)
+}
+
+func (cli client) GetSARCtx(ctx context.Context, req request.GetSAR) (response.SAR, response.Credits, error) {
+	return cli.getSAR.CallCtx(ctx, req)
+}
+
+func (cli client) GetADX(req request.GetADX) (response.ADX, response.Credits, error) {
+	return cli.getADX.Call(req)
+}
+
+func (cli client) GetADXCtx(ctx context.Context, req request.GetADX) (response.ADX, response.Credits, error) {
+	return cli.getADX.CallCtx(ctx, req)
+}
+
+func (cli client) GetMACD(req request.GetMACD) (response.MACD, response.Credits, error) {
+	return cli.getMACD.Call(req)
+}
+
+func (cli client) GetMACDCtx(ctx context.Context, req request.GetMACD) (response.MACD, response.Credits, error) {
+	return cli.getMACD.CallCtx(ctx, req)
+}
+
+func (cli client) GetRSI(req request.GetRSI) (response.RSI, response.Credits, error) {
+	return cli.getRSI.Call(req)
+}
+
+func (cli client) GetRSICtx(ctx context.Context, req request.GetRSI) (response.RSI, response.Credits, error) {
+	return cli.getRSI.CallCtx(ctx, req)
+}
+
+func (cli client) GetStoch(req request.GetStoch) (response.Stoch, response.Credits, error) {
+	return cli.getStoch.Call(req)
+}
+
+func (cli client) GetStochCtx(ctx context.Context, req request.GetStoch) (response.Stoch, response.Credits, error) {
+	return cli.getStoch.CallCtx(ctx, req)
+}
+
+func (cli client) GetPercentB(req request.GetPercentB) (response.PercentB, response.Credits, error) {
+	return cli.getPercentB.Call(req)
+}
+
+func (cli client) GetPercentBCtx(ctx context.Context, req request.GetPercentB) (response.PercentB, response.Credits, error) {
+	return cli.getPercentB.CallCtx(ctx, req)
+}
+
+func (cli client) GetCCI(req request.GetCCI) (response.CCI, response.Credits, error) {
+	return cli.getCCI.Call(req)
+}
+
+func (cli client) GetCCICtx(ctx context.Context, req request.GetCCI) (response.CCI, response.Credits, error) {
+	return cli.getCCI.CallCtx(ctx, req)
+}
+
+func (cli client) GetWillR(req request.GetWillR) (response.WillR, response.Credits, error) {
+	return cli.getWillR.Call(req)
+}
+
+func (cli client) GetWillRCtx(ctx context.Context, req request.GetWillR) (response.WillR, response.Credits, error) {
+	return cli.getWillR.CallCtx(ctx, req)
+}
+
+func (cli client) GetROC(req request.GetROC) (response.ROC, response.Credits, error) {
+	return cli.getROC.Call(req)
+}
+
+func (cli client) GetROCCtx(ctx context.Context, req request.GetROC) (response.ROC, response.Credits, error) {
+	return cli.getROC.CallCtx(ctx, req)
+}
+
+func (cli client) GetMOM(req request.GetMOM) (response.MOM, response.Credits, error) {
+	return cli.getMOM.Call(req)
+}
+
+func (cli client) GetMOMCtx(ctx context.Context, req request.GetMOM) (response.MOM, response.Credits, error) {
+	return cli.getMOM.CallCtx(ctx, req)
+}
+
+func (cli client) GetOBV(req request.GetOBV) (response.OBV, response.Credits, error) {
+	return cli.getOBV.Call(req)
+}
+
+func (cli client) GetOBVCtx(ctx context.Context, req request.GetOBV) (response.OBV, response.Credits, error) {
+	return cli.getOBV.CallCtx(ctx, req)
+}
+
+func (cli client) GetAD(req request.GetAD) (response.AD, response.Credits, error) {
+	return cli.getAD.Call(req)
+}
+
+func (cli client) GetADCtx(ctx context.Context, req request.GetAD) (response.AD, response.Credits, error) {
+	return cli.getAD.CallCtx(ctx, req)
+}
+
+func (cli client) GetATR(req request.GetATR) (response.ATR, response.Credits, error) {
+	return cli.getATR.Call(req)
+}
+
+func (cli client) GetATRCtx(ctx context.Context, req request.GetATR) (response.ATR, response.Credits, error) {
+	return cli.getATR.CallCtx(ctx, req)
+}
+
+func (cli client) GetNATR(req request.GetNATR) (response.NATR, response.Credits, error) {
+	return cli.getNATR.Call(req)
+}
+
+func (cli client) GetNATRCtx(ctx context.Context, req request.GetNATR) (response.NATR, response.Credits, error) {
+	return cli.getNATR.CallCtx(ctx, req)
+}
+
+func (cli client) GetTR(req request.GetTR) (response.TR, response.Credits, error) {
+	return cli.getTR.Call(req)
+}
+
+func (cli client) GetTRCtx(ctx context.Context, req request.GetTR) (response.TR, response.Credits, error) {
+	return cli.getTR.CallCtx(ctx, req)
+}
+
+func (cli client) GetEarningsEstimate(req request.GetEarningsEstimate) (response.EarningsEstimate, response.Credits, error) {
+	return cli.getEarningsEstimate.Call(req)
+}
+
+func (cli client) GetEarningsEstimateCtx(ctx context.Context, req request.GetEarningsEstimate) (response.EarningsEstimate, response.Credits, error) {
+	return cli.getEarningsEstimate.CallCtx(ctx, req)
+}
+
+func (cli client) GetRevenueEstimate(req request.GetRevenueEstimate) (response.RevenueEstimate, response.Credits, error) {
+	return cli.getRevenueEstimate.Call(req)
+}
+
+func (cli client) GetRevenueEstimateCtx(ctx context.Context, req request.GetRevenueEstimate) (response.RevenueEstimate, response.Credits, error) {
+	return cli.getRevenueEstimate.CallCtx(ctx, req)
+}
+
+func (cli client) GetEPSTrend(req request.GetEPSTrend) (response.EPSTrend, response.Credits, error) {
+	return cli.getEPSTrend.Call(req)
+}
+
+func (cli client) GetEPSTrendCtx(ctx context.Context, req request.GetEPSTrend) (response.EPSTrend, response.Credits, error) {
+	return cli.getEPSTrend.CallCtx(ctx, req)
+}
+
+func (cli client) GetEPSRevisions(req request.GetEPSRevisions) (response.EPSRevisions, response.Credits, error) {
+	return cli.getEPSRevisions.Call(req)
+}
+
+func (cli client) GetEPSRevisionsCtx(ctx context.Context, req request.GetEPSRevisions) (response.EPSRevisions, response.Credits, error) {
+	return cli.getEPSRevisions.CallCtx(ctx, req)
+}
+
+func (cli client) GetGrowthEstimates(req request.GetGrowthEstimates) (response.GrowthEstimates, response.Credits, error) {
+	return cli.getGrowthEstimates.Call(req)
+}
+
+func (cli client) GetGrowthEstimatesCtx(ctx context.Context, req request.GetGrowthEstimates) (response.GrowthEstimates, response.Credits, error) {
+	return cli.getGrowthEstimates.CallCtx(ctx, req)
+}
+
+func (cli client) GetRecommendations(req request.GetRecommendations) (response.Recommendations, response.Credits, error) {
+	return cli.getRecommendations.Call(req)
+}
+
+func (cli client) GetRecommendationsCtx(ctx context.Context, req request.GetRecommendations) (response.Recommendations, response.Credits, error) {
+	return cli.getRecommendations.CallCtx(ctx, req)
+}
+
+func (cli client) GetPriceTarget(req request.GetPriceTarget) (response.PriceTarget, response.Credits, error) {
+	return cli.getPriceTarget.Call(req)
+}
+
+func (cli client) GetPriceTargetCtx(ctx context.Context, req request.GetPriceTarget) (response.PriceTarget, response.Credits, error) {
+	return cli.getPriceTarget.CallCtx(ctx, req)
+}
+
+func (cli client) GetAnalystRatingsSnapshot(req request.GetAnalystRatingsSnapshot) (response.AnalystRatingsSnapshot, response.Credits, error) {
+	return cli.getAnalystRatingsSnapshot.Call(req)
+}
+
+func (cli client) GetAnalystRatingsSnapshotCtx(ctx context.Context, req request.GetAnalystRatingsSnapshot) (response.AnalystRatingsSnapshot, response.Credits, error) {
+	return cli.getAnalystRatingsSnapshot.CallCtx(ctx, req)
+}
+
+func (cli client) GetAnalystRatingsUSEquities(req request.GetAnalystRatingsUSEquities) (response.AnalystRatingsUSEquities, response.Credits, error) {
+	return cli.getAnalystRatingsUSEquities.Call(req)
+}
+
+func (cli client) GetAnalystRatingsUSEquitiesCtx(ctx context.Context, req request.GetAnalystRatingsUSEquities) (response.AnalystRatingsUSEquities, response.Credits, error) {
+	return cli.getAnalystRatingsUSEquities.CallCtx(ctx, req)
+}
+
+func (cli client) GetEDGARFilings(req request.GetEDGARFilings) (response.EDGARFilings, response.Credits, error) {
+	return cli.getEDGARFilings.Call(req)
+}
+
+func (cli client) GetEDGARFilingsCtx(ctx context.Context, req request.GetEDGARFilings) (response.EDGARFilings, response.Credits, error) {
+	return cli.getEDGARFilings.CallCtx(ctx, req)
+}
+
+func (cli client) GetInsiderTransactions(req request.GetInsiderTransactions) (response.InsiderTransactions, response.Credits, error) {
+	return cli.getInsiderTransactions.Call(req)
+}
+
+func (cli client) GetInsiderTransactionsCtx(ctx context.Context, req request.GetInsiderTransactions) (response.InsiderTransactions, response.Credits, error) {
+	return cli.getInsiderTransactions.CallCtx(ctx, req)
+}
+
+func (cli client) GetInstitutionalHolders(req request.GetInstitutionalHolders) (response.InstitutionalHolders, response.Credits, error) {
+	return cli.getInstitutionalHolders.Call(req)
+}
+
+func (cli client) GetInstitutionalHoldersCtx(ctx context.Context, req request.GetInstitutionalHolders) (response.InstitutionalHolders, response.Credits, error) {
+	return cli.getInstitutionalHolders.CallCtx(ctx, req)
+}
+
+func (cli client) GetFundHolders(req request.GetFundHolders) (response.FundHolders, response.Credits, error) {
+	return cli.getFundHolders.Call(req)
+}
+
+func (cli client) GetFundHoldersCtx(ctx context.Context, req request.GetFundHolders) (response.FundHolders, response.Credits, error) {
+	return cli.getFundHolders.CallCtx(ctx, req)
+}
+
+func (cli client) GetDirectHolders(req request.GetDirectHolders) (response.DirectHolders, response.Credits, error) {
+	return cli.getDirectHolders.Call(req)
+}
+
+func (cli client) GetDirectHoldersCtx(ctx context.Context, req request.GetDirectHolders) (response.DirectHolders, response.Credits, error) {
+	return cli.getDirectHolders.CallCtx(ctx, req)
+}
+
+func (cli client) GetTaxInformation(req request.GetTaxInformation) (response.TaxInformation, response.Credits, error) {
+	return cli.getTaxInformation.Call(req)
+}
+
+func (cli client) GetTaxInformationCtx(ctx context.Context, req request.GetTaxInformation) (response.TaxInformation, response.Credits, error) {
+	return cli.getTaxInformation.CallCtx(ctx, req)
+}
+
+func (cli client) GetSanctionedEntities(req request.GetSanctionedEntities) (response.SanctionedEntities, response.Credits, error) {
+	return cli.getSanctionedEntities.Call(req)
+}
+
+func (cli client) GetSanctionedEntitiesCtx(ctx context.Context, req request.GetSanctionedEntities) (response.SanctionedEntities, response.Credits, error) {
+	return cli.getSanctionedEntities.CallCtx(ctx, req)
+}
+
+func (cli client) GetUsage(req request.GetUsage) (response.Usage, response.Credits, error) {
+	return cli.getUsage.Call(req)
+}
+
+func (cli client) GetUsageCtx(ctx context.Context, req request.GetUsage) (response.Usage, response.Credits, error) {
+	return cli.getUsage.CallCtx(ctx, req)
+}
+
+func (cli client) GetBatches(req request.GetBatches) (response.Batches, response.Credits, error) {
+	return cli.getBatches.Call(req)
+}
+
+func (cli client) GetBatchesCtx(ctx context.Context, req request.GetBatches) (response.Batches, response.Credits, error) {
+	return cli.getBatches.CallCtx(ctx, req)
+}
+
+func (cli client) GetComplexData(req request.GetComplexData) (response.ComplexData, response.Credits, error) {
+	return cli.getComplexData.Call(req)
+}
+
+func (cli client) GetComplexDataCtx(ctx context.Context, req request.GetComplexData) (response.ComplexData, response.Credits, error) {
+	return cli.getComplexData.CallCtx(ctx, req)
+}
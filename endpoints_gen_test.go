@@ -0,0 +1,1830 @@
+// Code generated by genendpoints from endpoints.json. DO NOT EDIT.
+
+package twelvedata
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/soulgarden/twelvedata/dictionary"
+	"github.com/soulgarden/twelvedata/request"
+)
+
+// endpointWiring calls one endpoint with and without a context.
+type endpointWiring struct {
+	method  string
+	url     string
+	cost    int64
+	call    func(Client) error
+	callCtx func(context.Context, Client) error
+}
+
+// wiringConf sets the URL of every endpoint to the name of its Conf field.
+func wiringConf(baseURL string) *Conf {
+	return &Conf{
+		BaseURL: baseURL,
+		CoreData: CoreData{
+			TimeSeriesURL:      "/CoreData/TimeSeriesURL",
+			TimeSeriesCrossURL: "/CoreData/TimeSeriesCrossURL",
+			QuotesURL:          "/CoreData/QuotesURL",
+			PriceURL:           "/CoreData/PriceURL",
+			EODURL:             "/CoreData/EODURL",
+			MarketMoversURL:    "/CoreData/MarketMoversURL",
+		},
+		ReferenceData: ReferenceData{
+			StocksURL:                  "/ReferenceData/StocksURL",
+			ForexPairsURL:              "/ReferenceData/ForexPairsURL",
+			CryptocurrenciesURL:        "/ReferenceData/CryptocurrenciesURL",
+			ETFsURL:                    "/ReferenceData/ETFsURL",
+			FundsURL:                   "/ReferenceData/FundsURL",
+			CommoditiesURL:             "/ReferenceData/CommoditiesURL",
+			BondsURL:                   "/ReferenceData/BondsURL",
+			SymbolSearchURL:            "/ReferenceData/SymbolSearchURL",
+			CrossListingsURL:           "/ReferenceData/CrossListingsURL",
+			EarliestTimestampURL:       "/ReferenceData/EarliestTimestampURL",
+			ExchangesURL:               "/ReferenceData/ExchangesURL",
+			ExchangeScheduleURL:        "/ReferenceData/ExchangeScheduleURL",
+			CryptocurrencyExchangesURL: "/ReferenceData/CryptocurrencyExchangesURL",
+			MarketStateURL:             "/ReferenceData/MarketStateURL",
+			CountriesURL:               "/ReferenceData/CountriesURL",
+			InstrumentTypeURL:          "/ReferenceData/InstrumentTypeURL",
+			TechnicalIndicatorsURL:     "/ReferenceData/TechnicalIndicatorsURL",
+		},
+		Fundamentals: Fundamentals{
+			LogoURL:                        "/Fundamentals/LogoURL",
+			ProfileURL:                     "/Fundamentals/ProfileURL",
+			DividendsURL:                   "/Fundamentals/DividendsURL",
+			DividendsCalendarURL:           "/Fundamentals/DividendsCalendarURL",
+			EarningsURL:                    "/Fundamentals/EarningsURL",
+			EarningsCalendarURL:            "/Fundamentals/EarningsCalendarURL",
+			IPOCalendarURL:                 "/Fundamentals/IPOCalendarURL",
+			SplitsURL:                      "/Fundamentals/SplitsURL",
+			SplitsCalendarURL:              "/Fundamentals/SplitsCalendarURL",
+			StatisticsURL:                  "/Fundamentals/StatisticsURL",
+			PressReleasesURL:               "/Fundamentals/PressReleasesURL",
+			IncomeStatementURL:             "/Fundamentals/IncomeStatementURL",
+			IncomeStatementConsolidatedURL: "/Fundamentals/IncomeStatementConsolidatedURL",
+			BalanceSheetURL:                "/Fundamentals/BalanceSheetURL",
+			BalanceSheetConsolidatedURL:    "/Fundamentals/BalanceSheetConsolidatedURL",
+			CashFlowURL:                    "/Fundamentals/CashFlowURL",
+			CashFlowConsolidatedURL:        "/Fundamentals/CashFlowConsolidatedURL",
+			KeyExecutivesURL:               "/Fundamentals/KeyExecutivesURL",
+			MarketCapURL:                   "/Fundamentals/MarketCapURL",
+			LastChangeURL:                  "/Fundamentals/LastChangeURL",
+		},
+		Currencies: Currencies{
+			ExchangeRateURL:       "/Currencies/ExchangeRateURL",
+			CurrencyConversionURL: "/Currencies/CurrencyConversionURL",
+		},
+		TechnicalIndicators: TechnicalIndicators{
+			BbandsURL:    "/TechnicalIndicators/BbandsURL",
+			SMAURL:       "/TechnicalIndicators/SMAURL",
+			EMAURL:       "/TechnicalIndicators/EMAURL",
+			MAURL:        "/TechnicalIndicators/MAURL",
+			WMAURL:       "/TechnicalIndicators/WMAURL",
+			VWAPURL:      "/TechnicalIndicators/VWAPURL",
+			DEMAURL:      "/TechnicalIndicators/DEMAURL",
+			TEMAURL:      "/TechnicalIndicators/TEMAURL",
+			TRMAURL:      "/TechnicalIndicators/TRMAURL",
+			KAMAURL:      "/TechnicalIndicators/KAMAURL",
+			SARURL:       "/TechnicalIndicators/SARURL",
+			ADXURL:       "/TechnicalIndicators/ADXURL",
+			MACDURL:      "/TechnicalIndicators/MACDURL",
+			RSIURL:       "/TechnicalIndicators/RSIURL",
+			StochURL:     "/TechnicalIndicators/StochURL",
+			PercentBURL:  "/TechnicalIndicators/PercentBURL",
+			CCIURL:       "/TechnicalIndicators/CCIURL",
+			WilliamsRURL: "/TechnicalIndicators/WilliamsRURL",
+			ROCURL:       "/TechnicalIndicators/ROCURL",
+			MomURL:       "/TechnicalIndicators/MomURL",
+			OBVURL:       "/TechnicalIndicators/OBVURL",
+			ADURL:        "/TechnicalIndicators/ADURL",
+			ATRURL:       "/TechnicalIndicators/ATRURL",
+			NATRURL:      "/TechnicalIndicators/NATRURL",
+			TRURL:        "/TechnicalIndicators/TRURL",
+		},
+		ETFs: ETFs{
+			ETFsDirectoryURL:   "/ETFs/ETFsDirectoryURL",
+			ETFsFullDataURL:    "/ETFs/ETFsFullDataURL",
+			ETFsSummaryURL:     "/ETFs/ETFsSummaryURL",
+			ETFsPerformanceURL: "/ETFs/ETFsPerformanceURL",
+			ETFsRiskURL:        "/ETFs/ETFsRiskURL",
+			ETFsCompositionURL: "/ETFs/ETFsCompositionURL",
+			ETFsFamiliesURL:    "/ETFs/ETFsFamiliesURL",
+			ETFsTypesURL:       "/ETFs/ETFsTypesURL",
+		},
+		MutualFunds: MutualFunds{
+			MutualFundsDirectoryURL:      "/MutualFunds/MutualFundsDirectoryURL",
+			MutualFundsFullDataURL:       "/MutualFunds/MutualFundsFullDataURL",
+			MutualFundsSummaryURL:        "/MutualFunds/MutualFundsSummaryURL",
+			MutualFundsPerformanceURL:    "/MutualFunds/MutualFundsPerformanceURL",
+			MutualFundsRiskURL:           "/MutualFunds/MutualFundsRiskURL",
+			MutualFundsRatingsURL:        "/MutualFunds/MutualFundsRatingsURL",
+			MutualFundsCompositionURL:    "/MutualFunds/MutualFundsCompositionURL",
+			MutualFundsPurchaseInfoURL:   "/MutualFunds/MutualFundsPurchaseInfoURL",
+			MutualFundsSustainabilityURL: "/MutualFunds/MutualFundsSustainabilityURL",
+			MutualFundsFamiliesURL:       "/MutualFunds/MutualFundsFamiliesURL",
+			MutualFundsTypesURL:          "/MutualFunds/MutualFundsTypesURL",
+		},
+		Analysis: Analysis{
+			EarningsEstimateURL:         "/Analysis/EarningsEstimateURL",
+			RevenueEstimateURL:          "/Analysis/RevenueEstimateURL",
+			EPSTrendURL:                 "/Analysis/EPSTrendURL",
+			EPSRevisionsURL:             "/Analysis/EPSRevisionsURL",
+			GrowthEstimatesURL:          "/Analysis/GrowthEstimatesURL",
+			RecommendationsURL:          "/Analysis/RecommendationsURL",
+			PriceTargetURL:              "/Analysis/PriceTargetURL",
+			AnalystRatingsSnapshotURL:   "/Analysis/AnalystRatingsSnapshotURL",
+			AnalystRatingsUSEquitiesURL: "/Analysis/AnalystRatingsUSEquitiesURL",
+		},
+		Regulatory: Regulatory{
+			EDGARFilingsURL:         "/Regulatory/EDGARFilingsURL",
+			InsiderTransactionsURL:  "/Regulatory/InsiderTransactionsURL",
+			InstitutionalHoldersURL: "/Regulatory/InstitutionalHoldersURL",
+			FundHoldersURL:          "/Regulatory/FundHoldersURL",
+			DirectHoldersURL:        "/Regulatory/DirectHoldersURL",
+			TaxInformationURL:       "/Regulatory/TaxInformationURL",
+			SanctionedEntitiesURL:   "/Regulatory/SanctionedEntitiesURL",
+		},
+		Advanced: Advanced{
+			UsageURL:       "/Advanced/UsageURL",
+			BatchesURL:     "/Advanced/BatchesURL",
+			ComplexDataURL: "/Advanced/ComplexDataURL",
+		},
+	}
+}
+
+func wiringEndpoints() []endpointWiring {
+	return []endpointWiring{
+		{
+			method: "GetTimeSeries",
+			url:    "/CoreData/TimeSeriesURL",
+			cost:   dictionary.TimeSeries,
+			call: func(cli Client) error {
+				_, _, err := cli.GetTimeSeries(request.GetTimeSeries{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetTimeSeriesCtx(ctx, request.GetTimeSeries{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetTimeSeriesCross",
+			url:    "/CoreData/TimeSeriesCrossURL",
+			cost:   dictionary.TimeSeriesCross,
+			call: func(cli Client) error {
+				_, _, err := cli.GetTimeSeriesCross(request.GetTimeSeriesCross{Base: "EUR", Quote: "USD", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetTimeSeriesCrossCtx(ctx, request.GetTimeSeriesCross{Base: "EUR", Quote: "USD", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetQuote",
+			url:    "/CoreData/QuotesURL",
+			cost:   dictionary.Quote,
+			call: func(cli Client) error {
+				_, _, err := cli.GetQuote(request.GetQuote{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetQuoteCtx(ctx, request.GetQuote{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetPrice",
+			url:    "/CoreData/PriceURL",
+			cost:   dictionary.Price,
+			call: func(cli Client) error {
+				_, _, err := cli.GetPrice(request.GetPrice{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetPriceCtx(ctx, request.GetPrice{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetEOD",
+			url:    "/CoreData/EODURL",
+			cost:   dictionary.EOD,
+			call: func(cli Client) error {
+				_, _, err := cli.GetEOD(request.GetEOD{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetEODCtx(ctx, request.GetEOD{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetMarketMovers",
+			url:    "/CoreData/MarketMoversURL",
+			cost:   dictionary.MarketMovers,
+			call: func(cli Client) error {
+				_, _, err := cli.GetMarketMovers(request.GetMarketMovers{Market: request.MarketStocks})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetMarketMoversCtx(ctx, request.GetMarketMovers{Market: request.MarketStocks})
+
+				return err
+			},
+		},
+		{
+			method: "GetStocks",
+			url:    "/ReferenceData/StocksURL",
+			cost:   dictionary.Stocks,
+			call: func(cli Client) error {
+				_, _, err := cli.GetStocks(request.GetStock{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetStocksCtx(ctx, request.GetStock{})
+
+				return err
+			},
+		},
+		{
+			method: "GetForexPairs",
+			url:    "/ReferenceData/ForexPairsURL",
+			cost:   dictionary.ForexPairs,
+			call: func(cli Client) error {
+				_, _, err := cli.GetForexPairs(request.GetForexPairs{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetForexPairsCtx(ctx, request.GetForexPairs{})
+
+				return err
+			},
+		},
+		{
+			method: "GetCryptocurrencies",
+			url:    "/ReferenceData/CryptocurrenciesURL",
+			cost:   dictionary.Cryptocurrencies,
+			call: func(cli Client) error {
+				_, _, err := cli.GetCryptocurrencies(request.GetCryptocurrencies{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetCryptocurrenciesCtx(ctx, request.GetCryptocurrencies{})
+
+				return err
+			},
+		},
+		{
+			method: "GetETFs",
+			url:    "/ReferenceData/ETFsURL",
+			cost:   dictionary.ETFs,
+			call: func(cli Client) error {
+				_, _, err := cli.GetETFs(request.GetETFs{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetETFsCtx(ctx, request.GetETFs{})
+
+				return err
+			},
+		},
+		{
+			method: "GetFunds",
+			url:    "/ReferenceData/FundsURL",
+			cost:   dictionary.Funds,
+			call: func(cli Client) error {
+				_, _, err := cli.GetFunds(request.GetFunds{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetFundsCtx(ctx, request.GetFunds{})
+
+				return err
+			},
+		},
+		{
+			method: "GetCommodities",
+			url:    "/ReferenceData/CommoditiesURL",
+			cost:   dictionary.Commodities,
+			call: func(cli Client) error {
+				_, _, err := cli.GetCommodities(request.GetCommodities{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetCommoditiesCtx(ctx, request.GetCommodities{})
+
+				return err
+			},
+		},
+		{
+			method: "GetBonds",
+			url:    "/ReferenceData/BondsURL",
+			cost:   dictionary.Bonds,
+			call: func(cli Client) error {
+				_, _, err := cli.GetBonds(request.GetBonds{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetBondsCtx(ctx, request.GetBonds{})
+
+				return err
+			},
+		},
+		{
+			method: "GetSymbolSearch",
+			url:    "/ReferenceData/SymbolSearchURL",
+			cost:   dictionary.SymbolSearch,
+			call: func(cli Client) error {
+				_, _, err := cli.GetSymbolSearch(request.GetSymbolSearch{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetSymbolSearchCtx(ctx, request.GetSymbolSearch{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetCrossListings",
+			url:    "/ReferenceData/CrossListingsURL",
+			cost:   dictionary.CrossListings,
+			call: func(cli Client) error {
+				_, _, err := cli.GetCrossListings(request.GetCrossListings{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetCrossListingsCtx(ctx, request.GetCrossListings{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetEarliestTimestamp",
+			url:    "/ReferenceData/EarliestTimestampURL",
+			cost:   dictionary.EarliestTimestamp,
+			call: func(cli Client) error {
+				_, _, err := cli.GetEarliestTimestamp(request.GetEarliestTimestamp{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetEarliestTimestampCtx(ctx, request.GetEarliestTimestamp{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetExchanges",
+			url:    "/ReferenceData/ExchangesURL",
+			cost:   dictionary.Exchanges,
+			call: func(cli Client) error {
+				_, _, err := cli.GetExchanges(request.GetExchanges{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetExchangesCtx(ctx, request.GetExchanges{})
+
+				return err
+			},
+		},
+		{
+			method: "GetExchangeSchedule",
+			url:    "/ReferenceData/ExchangeScheduleURL",
+			cost:   dictionary.ExchangeSchedule,
+			call: func(cli Client) error {
+				_, _, err := cli.GetExchangeSchedule(request.GetExchangeSchedule{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetExchangeScheduleCtx(ctx, request.GetExchangeSchedule{})
+
+				return err
+			},
+		},
+		{
+			method: "GetCryptocurrencyExchanges",
+			url:    "/ReferenceData/CryptocurrencyExchangesURL",
+			cost:   dictionary.CryptocurrencyExchanges,
+			call: func(cli Client) error {
+				_, _, err := cli.GetCryptocurrencyExchanges(request.GetCryptocurrencyExchanges{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetCryptocurrencyExchangesCtx(ctx, request.GetCryptocurrencyExchanges{})
+
+				return err
+			},
+		},
+		{
+			method: "GetMarketState",
+			url:    "/ReferenceData/MarketStateURL",
+			cost:   dictionary.MarketState,
+			call: func(cli Client) error {
+				_, _, err := cli.GetMarketState(request.GetMarketState{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetMarketStateCtx(ctx, request.GetMarketState{})
+
+				return err
+			},
+		},
+		{
+			method: "GetCountries",
+			url:    "/ReferenceData/CountriesURL",
+			cost:   dictionary.Countries,
+			call: func(cli Client) error {
+				_, _, err := cli.GetCountries(request.GetCountries{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetCountriesCtx(ctx, request.GetCountries{})
+
+				return err
+			},
+		},
+		{
+			method: "GetInstrumentType",
+			url:    "/ReferenceData/InstrumentTypeURL",
+			cost:   dictionary.InstrumentType,
+			call: func(cli Client) error {
+				_, _, err := cli.GetInstrumentType(request.GetInstrumentType{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetInstrumentTypeCtx(ctx, request.GetInstrumentType{})
+
+				return err
+			},
+		},
+		{
+			method: "GetTechnicalIndicators",
+			url:    "/ReferenceData/TechnicalIndicatorsURL",
+			cost:   dictionary.TechnicalIndicators,
+			call: func(cli Client) error {
+				_, _, err := cli.GetTechnicalIndicators(request.GetTechnicalIndicators{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetTechnicalIndicatorsCtx(ctx, request.GetTechnicalIndicators{})
+
+				return err
+			},
+		},
+		{
+			method: "GetLogo",
+			url:    "/Fundamentals/LogoURL",
+			cost:   dictionary.Logo,
+			call: func(cli Client) error {
+				_, _, err := cli.GetLogo(request.GetLogo{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetLogoCtx(ctx, request.GetLogo{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetProfile",
+			url:    "/Fundamentals/ProfileURL",
+			cost:   dictionary.Profile,
+			call: func(cli Client) error {
+				_, _, err := cli.GetProfile(request.GetProfile{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetProfileCtx(ctx, request.GetProfile{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetDividends",
+			url:    "/Fundamentals/DividendsURL",
+			cost:   dictionary.Dividends,
+			call: func(cli Client) error {
+				_, _, err := cli.GetDividends(request.GetDividends{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetDividendsCtx(ctx, request.GetDividends{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetDividendsCalendar",
+			url:    "/Fundamentals/DividendsCalendarURL",
+			cost:   dictionary.DividendsCalendar,
+			call: func(cli Client) error {
+				_, _, err := cli.GetDividendsCalendar(request.GetDividendsCalendar{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetDividendsCalendarCtx(ctx, request.GetDividendsCalendar{})
+
+				return err
+			},
+		},
+		{
+			method: "GetEarnings",
+			url:    "/Fundamentals/EarningsURL",
+			cost:   dictionary.Earnings,
+			call: func(cli Client) error {
+				_, _, err := cli.GetEarnings(request.GetEarnings{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetEarningsCtx(ctx, request.GetEarnings{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetEarningsCalendar",
+			url:    "/Fundamentals/EarningsCalendarURL",
+			cost:   dictionary.EarningsCalendar,
+			call: func(cli Client) error {
+				_, _, err := cli.GetEarningsCalendar(request.GetEarningsCalendar{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetEarningsCalendarCtx(ctx, request.GetEarningsCalendar{})
+
+				return err
+			},
+		},
+		{
+			method: "GetIPOCalendar",
+			url:    "/Fundamentals/IPOCalendarURL",
+			cost:   dictionary.IPOCalendar,
+			call: func(cli Client) error {
+				_, _, err := cli.GetIPOCalendar(request.GetIPOCalendar{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetIPOCalendarCtx(ctx, request.GetIPOCalendar{})
+
+				return err
+			},
+		},
+		{
+			method: "GetSplits",
+			url:    "/Fundamentals/SplitsURL",
+			cost:   dictionary.Splits,
+			call: func(cli Client) error {
+				_, _, err := cli.GetSplits(request.GetSplits{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetSplitsCtx(ctx, request.GetSplits{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetSplitsCalendar",
+			url:    "/Fundamentals/SplitsCalendarURL",
+			cost:   dictionary.SplitsCalendar,
+			call: func(cli Client) error {
+				_, _, err := cli.GetSplitsCalendar(request.GetSplitsCalendar{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetSplitsCalendarCtx(ctx, request.GetSplitsCalendar{})
+
+				return err
+			},
+		},
+		{
+			method: "GetStatistics",
+			url:    "/Fundamentals/StatisticsURL",
+			cost:   dictionary.Statistics,
+			call: func(cli Client) error {
+				_, _, err := cli.GetStatistics(request.GetStatistics{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetStatisticsCtx(ctx, request.GetStatistics{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetPressReleases",
+			url:    "/Fundamentals/PressReleasesURL",
+			cost:   dictionary.PressReleases,
+			call: func(cli Client) error {
+				_, _, err := cli.GetPressReleases(request.GetPressReleases{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetPressReleasesCtx(ctx, request.GetPressReleases{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetIncomeStatement",
+			url:    "/Fundamentals/IncomeStatementURL",
+			cost:   dictionary.IncomeStatement,
+			call: func(cli Client) error {
+				_, _, err := cli.GetIncomeStatement(request.GetIncomeStatement{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetIncomeStatementCtx(ctx, request.GetIncomeStatement{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetIncomeStatementConsolidated",
+			url:    "/Fundamentals/IncomeStatementConsolidatedURL",
+			cost:   dictionary.IncomeStatementConsolidated,
+			call: func(cli Client) error {
+				_, _, err := cli.GetIncomeStatementConsolidated(request.GetIncomeStatement{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetIncomeStatementConsolidatedCtx(ctx, request.GetIncomeStatement{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetBalanceSheet",
+			url:    "/Fundamentals/BalanceSheetURL",
+			cost:   dictionary.BalanceSheet,
+			call: func(cli Client) error {
+				_, _, err := cli.GetBalanceSheet(request.GetBalanceSheet{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetBalanceSheetCtx(ctx, request.GetBalanceSheet{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetBalanceSheetConsolidated",
+			url:    "/Fundamentals/BalanceSheetConsolidatedURL",
+			cost:   dictionary.BalanceSheetConsolidated,
+			call: func(cli Client) error {
+				_, _, err := cli.GetBalanceSheetConsolidated(request.GetBalanceSheet{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetBalanceSheetConsolidatedCtx(ctx, request.GetBalanceSheet{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetCashFlow",
+			url:    "/Fundamentals/CashFlowURL",
+			cost:   dictionary.CashFlow,
+			call: func(cli Client) error {
+				_, _, err := cli.GetCashFlow(request.GetCashFlow{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetCashFlowCtx(ctx, request.GetCashFlow{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetCashFlowConsolidated",
+			url:    "/Fundamentals/CashFlowConsolidatedURL",
+			cost:   dictionary.CashFlowConsolidated,
+			call: func(cli Client) error {
+				_, _, err := cli.GetCashFlowConsolidated(request.GetCashFlow{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetCashFlowConsolidatedCtx(ctx, request.GetCashFlow{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetKeyExecutives",
+			url:    "/Fundamentals/KeyExecutivesURL",
+			cost:   dictionary.KeyExecutives,
+			call: func(cli Client) error {
+				_, _, err := cli.GetKeyExecutives(request.GetKeyExecutives{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetKeyExecutivesCtx(ctx, request.GetKeyExecutives{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetMarketCap",
+			url:    "/Fundamentals/MarketCapURL",
+			cost:   dictionary.MarketCap,
+			call: func(cli Client) error {
+				_, _, err := cli.GetMarketCap(request.GetMarketCap{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetMarketCapCtx(ctx, request.GetMarketCap{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetLastChange",
+			url:    "/Fundamentals/LastChangeURL",
+			cost:   dictionary.LastChange,
+			call: func(cli Client) error {
+				_, _, err := cli.GetLastChange(request.GetLastChange{Endpoint: request.LastChangeStatistics})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetLastChangeCtx(ctx, request.GetLastChange{Endpoint: request.LastChangeStatistics})
+
+				return err
+			},
+		},
+		{
+			method: "GetExchangeRate",
+			url:    "/Currencies/ExchangeRateURL",
+			cost:   dictionary.ExchangeRate,
+			call: func(cli Client) error {
+				_, _, err := cli.GetExchangeRate(request.GetExchangeRate{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetExchangeRateCtx(ctx, request.GetExchangeRate{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetCurrencyConversion",
+			url:    "/Currencies/CurrencyConversionURL",
+			cost:   dictionary.CurrencyConversion,
+			call: func(cli Client) error {
+				_, _, err := cli.GetCurrencyConversion(request.GetCurrencyConversion{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetCurrencyConversionCtx(ctx, request.GetCurrencyConversion{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetETFsDirectory",
+			url:    "/ETFs/ETFsDirectoryURL",
+			cost:   dictionary.ETFsDirectory,
+			call: func(cli Client) error {
+				_, _, err := cli.GetETFsDirectory(request.GetETFsDirectory{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetETFsDirectoryCtx(ctx, request.GetETFsDirectory{})
+
+				return err
+			},
+		},
+		{
+			method: "GetETFFullData",
+			url:    "/ETFs/ETFsFullDataURL",
+			cost:   dictionary.ETFFullData,
+			call: func(cli Client) error {
+				_, _, err := cli.GetETFFullData(request.GetETFFullData{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetETFFullDataCtx(ctx, request.GetETFFullData{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetETFSummary",
+			url:    "/ETFs/ETFsSummaryURL",
+			cost:   dictionary.ETFSummary,
+			call: func(cli Client) error {
+				_, _, err := cli.GetETFSummary(request.GetETFSummary{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetETFSummaryCtx(ctx, request.GetETFSummary{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetETFPerformance",
+			url:    "/ETFs/ETFsPerformanceURL",
+			cost:   dictionary.ETFPerformance,
+			call: func(cli Client) error {
+				_, _, err := cli.GetETFPerformance(request.GetETFPerformance{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetETFPerformanceCtx(ctx, request.GetETFPerformance{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetETFRisk",
+			url:    "/ETFs/ETFsRiskURL",
+			cost:   dictionary.ETFRisk,
+			call: func(cli Client) error {
+				_, _, err := cli.GetETFRisk(request.GetETFRisk{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetETFRiskCtx(ctx, request.GetETFRisk{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetETFComposition",
+			url:    "/ETFs/ETFsCompositionURL",
+			cost:   dictionary.ETFComposition,
+			call: func(cli Client) error {
+				_, _, err := cli.GetETFComposition(request.GetETFComposition{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetETFCompositionCtx(ctx, request.GetETFComposition{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetETFFamilies",
+			url:    "/ETFs/ETFsFamiliesURL",
+			cost:   dictionary.ETFFamilies,
+			call: func(cli Client) error {
+				_, _, err := cli.GetETFFamilies(request.GetETFFamilies{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetETFFamiliesCtx(ctx, request.GetETFFamilies{})
+
+				return err
+			},
+		},
+		{
+			method: "GetETFTypes",
+			url:    "/ETFs/ETFsTypesURL",
+			cost:   dictionary.ETFTypes,
+			call: func(cli Client) error {
+				_, _, err := cli.GetETFTypes(request.GetETFTypes{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetETFTypesCtx(ctx, request.GetETFTypes{})
+
+				return err
+			},
+		},
+		{
+			method: "GetMutualFundsDirectory",
+			url:    "/MutualFunds/MutualFundsDirectoryURL",
+			cost:   dictionary.MutualFundsDirectory,
+			call: func(cli Client) error {
+				_, _, err := cli.GetMutualFundsDirectory(request.GetMutualFundsDirectory{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetMutualFundsDirectoryCtx(ctx, request.GetMutualFundsDirectory{})
+
+				return err
+			},
+		},
+		{
+			method: "GetMutualFundFullData",
+			url:    "/MutualFunds/MutualFundsFullDataURL",
+			cost:   dictionary.MutualFundFullData,
+			call: func(cli Client) error {
+				_, _, err := cli.GetMutualFundFullData(request.GetMutualFundFullData{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetMutualFundFullDataCtx(ctx, request.GetMutualFundFullData{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetMutualFundSummary",
+			url:    "/MutualFunds/MutualFundsSummaryURL",
+			cost:   dictionary.MutualFundSummary,
+			call: func(cli Client) error {
+				_, _, err := cli.GetMutualFundSummary(request.GetMutualFundSummary{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetMutualFundSummaryCtx(ctx, request.GetMutualFundSummary{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetMutualFundPerformance",
+			url:    "/MutualFunds/MutualFundsPerformanceURL",
+			cost:   dictionary.MutualFundPerformance,
+			call: func(cli Client) error {
+				_, _, err := cli.GetMutualFundPerformance(request.GetMutualFundPerformance{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetMutualFundPerformanceCtx(ctx, request.GetMutualFundPerformance{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetMutualFundRisk",
+			url:    "/MutualFunds/MutualFundsRiskURL",
+			cost:   dictionary.MutualFundRisk,
+			call: func(cli Client) error {
+				_, _, err := cli.GetMutualFundRisk(request.GetMutualFundRisk{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetMutualFundRiskCtx(ctx, request.GetMutualFundRisk{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetMutualFundRatings",
+			url:    "/MutualFunds/MutualFundsRatingsURL",
+			cost:   dictionary.MutualFundRatings,
+			call: func(cli Client) error {
+				_, _, err := cli.GetMutualFundRatings(request.GetMutualFundRatings{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetMutualFundRatingsCtx(ctx, request.GetMutualFundRatings{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetMutualFundComposition",
+			url:    "/MutualFunds/MutualFundsCompositionURL",
+			cost:   dictionary.MutualFundComposition,
+			call: func(cli Client) error {
+				_, _, err := cli.GetMutualFundComposition(request.GetMutualFundComposition{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetMutualFundCompositionCtx(ctx, request.GetMutualFundComposition{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetMutualFundPurchaseInfo",
+			url:    "/MutualFunds/MutualFundsPurchaseInfoURL",
+			cost:   dictionary.MutualFundPurchaseInfo,
+			call: func(cli Client) error {
+				_, _, err := cli.GetMutualFundPurchaseInfo(request.GetMutualFundPurchaseInfo{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetMutualFundPurchaseInfoCtx(ctx, request.GetMutualFundPurchaseInfo{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetMutualFundSustainability",
+			url:    "/MutualFunds/MutualFundsSustainabilityURL",
+			cost:   dictionary.MutualFundSustainability,
+			call: func(cli Client) error {
+				_, _, err := cli.GetMutualFundSustainability(request.GetMutualFundSustainability{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetMutualFundSustainabilityCtx(ctx, request.GetMutualFundSustainability{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetMutualFundFamilies",
+			url:    "/MutualFunds/MutualFundsFamiliesURL",
+			cost:   dictionary.MutualFundFamilies,
+			call: func(cli Client) error {
+				_, _, err := cli.GetMutualFundFamilies(request.GetMutualFundFamilies{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetMutualFundFamiliesCtx(ctx, request.GetMutualFundFamilies{})
+
+				return err
+			},
+		},
+		{
+			method: "GetMutualFundTypes",
+			url:    "/MutualFunds/MutualFundsTypesURL",
+			cost:   dictionary.MutualFundTypes,
+			call: func(cli Client) error {
+				_, _, err := cli.GetMutualFundTypes(request.GetMutualFundTypes{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetMutualFundTypesCtx(ctx, request.GetMutualFundTypes{})
+
+				return err
+			},
+		},
+		{
+			method: "GetBBands",
+			url:    "/TechnicalIndicators/BbandsURL",
+			cost:   dictionary.BBands,
+			call: func(cli Client) error {
+				_, _, err := cli.GetBBands(request.GetBBands{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetBBandsCtx(ctx, request.GetBBands{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetSMA",
+			url:    "/TechnicalIndicators/SMAURL",
+			cost:   dictionary.SMA,
+			call: func(cli Client) error {
+				_, _, err := cli.GetSMA(request.GetSMA{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetSMACtx(ctx, request.GetSMA{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetEMA",
+			url:    "/TechnicalIndicators/EMAURL",
+			cost:   dictionary.EMA,
+			call: func(cli Client) error {
+				_, _, err := cli.GetEMA(request.GetEMA{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetEMACtx(ctx, request.GetEMA{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetMA",
+			url:    "/TechnicalIndicators/MAURL",
+			cost:   dictionary.MA,
+			call: func(cli Client) error {
+				_, _, err := cli.GetMA(request.GetMA{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetMACtx(ctx, request.GetMA{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetWMA",
+			url:    "/TechnicalIndicators/WMAURL",
+			cost:   dictionary.WMA,
+			call: func(cli Client) error {
+				_, _, err := cli.GetWMA(request.GetWMA{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetWMACtx(ctx, request.GetWMA{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetVWAP",
+			url:    "/TechnicalIndicators/VWAPURL",
+			cost:   dictionary.VWAP,
+			call: func(cli Client) error {
+				_, _, err := cli.GetVWAP(request.GetVWAP{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetVWAPCtx(ctx, request.GetVWAP{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetDEMA",
+			url:    "/TechnicalIndicators/DEMAURL",
+			cost:   dictionary.DEMA,
+			call: func(cli Client) error {
+				_, _, err := cli.GetDEMA(request.GetDEMA{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetDEMACtx(ctx, request.GetDEMA{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetTEMA",
+			url:    "/TechnicalIndicators/TEMAURL",
+			cost:   dictionary.TEMA,
+			call: func(cli Client) error {
+				_, _, err := cli.GetTEMA(request.GetTEMA{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetTEMACtx(ctx, request.GetTEMA{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetTRMA",
+			url:    "/TechnicalIndicators/TRMAURL",
+			cost:   dictionary.TRMA,
+			call: func(cli Client) error {
+				_, _, err := cli.GetTRMA(request.GetTRMA{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetTRMACtx(ctx, request.GetTRMA{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetKAMA",
+			url:    "/TechnicalIndicators/KAMAURL",
+			cost:   dictionary.KAMA,
+			call: func(cli Client) error {
+				_, _, err := cli.GetKAMA(request.GetKAMA{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetKAMACtx(ctx, request.GetKAMA{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetSAR",
+			url:    "/TechnicalIndicators/SARURL",
+			cost:   dictionary.SAR,
+			call: func(cli Client) error {
+				_, _, err := cli.GetSAR(request.GetSAR{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetSARCtx(ctx, request.GetSAR{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetADX",
+			url:    "/TechnicalIndicators/ADXURL",
+			cost:   dictionary.ADX,
+			call: func(cli Client) error {
+				_, _, err := cli.GetADX(request.GetADX{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetADXCtx(ctx, request.GetADX{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetMACD",
+			url:    "/TechnicalIndicators/MACDURL",
+			cost:   dictionary.MACD,
+			call: func(cli Client) error {
+				_, _, err := cli.GetMACD(request.GetMACD{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetMACDCtx(ctx, request.GetMACD{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetRSI",
+			url:    "/TechnicalIndicators/RSIURL",
+			cost:   dictionary.RSI,
+			call: func(cli Client) error {
+				_, _, err := cli.GetRSI(request.GetRSI{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetRSICtx(ctx, request.GetRSI{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetStoch",
+			url:    "/TechnicalIndicators/StochURL",
+			cost:   dictionary.Stoch,
+			call: func(cli Client) error {
+				_, _, err := cli.GetStoch(request.GetStoch{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetStochCtx(ctx, request.GetStoch{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetPercentB",
+			url:    "/TechnicalIndicators/PercentBURL",
+			cost:   dictionary.PercentB,
+			call: func(cli Client) error {
+				_, _, err := cli.GetPercentB(request.GetPercentB{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetPercentBCtx(ctx, request.GetPercentB{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetCCI",
+			url:    "/TechnicalIndicators/CCIURL",
+			cost:   dictionary.CCI,
+			call: func(cli Client) error {
+				_, _, err := cli.GetCCI(request.GetCCI{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetCCICtx(ctx, request.GetCCI{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetWillR",
+			url:    "/TechnicalIndicators/WilliamsRURL",
+			cost:   dictionary.WillR,
+			call: func(cli Client) error {
+				_, _, err := cli.GetWillR(request.GetWillR{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetWillRCtx(ctx, request.GetWillR{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetROC",
+			url:    "/TechnicalIndicators/ROCURL",
+			cost:   dictionary.ROC,
+			call: func(cli Client) error {
+				_, _, err := cli.GetROC(request.GetROC{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetROCCtx(ctx, request.GetROC{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetMOM",
+			url:    "/TechnicalIndicators/MomURL",
+			cost:   dictionary.MOM,
+			call: func(cli Client) error {
+				_, _, err := cli.GetMOM(request.GetMOM{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetMOMCtx(ctx, request.GetMOM{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetOBV",
+			url:    "/TechnicalIndicators/OBVURL",
+			cost:   dictionary.OBV,
+			call: func(cli Client) error {
+				_, _, err := cli.GetOBV(request.GetOBV{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetOBVCtx(ctx, request.GetOBV{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetAD",
+			url:    "/TechnicalIndicators/ADURL",
+			cost:   dictionary.AD,
+			call: func(cli Client) error {
+				_, _, err := cli.GetAD(request.GetAD{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetADCtx(ctx, request.GetAD{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetATR",
+			url:    "/TechnicalIndicators/ATRURL",
+			cost:   dictionary.ATR,
+			call: func(cli Client) error {
+				_, _, err := cli.GetATR(request.GetATR{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetATRCtx(ctx, request.GetATR{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetNATR",
+			url:    "/TechnicalIndicators/NATRURL",
+			cost:   dictionary.NATR,
+			call: func(cli Client) error {
+				_, _, err := cli.GetNATR(request.GetNATR{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetNATRCtx(ctx, request.GetNATR{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetTR",
+			url:    "/TechnicalIndicators/TRURL",
+			cost:   dictionary.TR,
+			call: func(cli Client) error {
+				_, _, err := cli.GetTR(request.GetTR{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetTRCtx(ctx, request.GetTR{Symbol: "AAPL", Interval: request.Interval1Day})
+
+				return err
+			},
+		},
+		{
+			method: "GetEarningsEstimate",
+			url:    "/Analysis/EarningsEstimateURL",
+			cost:   dictionary.EarningsEstimate,
+			call: func(cli Client) error {
+				_, _, err := cli.GetEarningsEstimate(request.GetEarningsEstimate{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetEarningsEstimateCtx(ctx, request.GetEarningsEstimate{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetRevenueEstimate",
+			url:    "/Analysis/RevenueEstimateURL",
+			cost:   dictionary.RevenueEstimate,
+			call: func(cli Client) error {
+				_, _, err := cli.GetRevenueEstimate(request.GetRevenueEstimate{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetRevenueEstimateCtx(ctx, request.GetRevenueEstimate{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetEPSTrend",
+			url:    "/Analysis/EPSTrendURL",
+			cost:   dictionary.EPSTrend,
+			call: func(cli Client) error {
+				_, _, err := cli.GetEPSTrend(request.GetEPSTrend{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetEPSTrendCtx(ctx, request.GetEPSTrend{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetEPSRevisions",
+			url:    "/Analysis/EPSRevisionsURL",
+			cost:   dictionary.EPSRevisions,
+			call: func(cli Client) error {
+				_, _, err := cli.GetEPSRevisions(request.GetEPSRevisions{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetEPSRevisionsCtx(ctx, request.GetEPSRevisions{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetGrowthEstimates",
+			url:    "/Analysis/GrowthEstimatesURL",
+			cost:   dictionary.GrowthEstimates,
+			call: func(cli Client) error {
+				_, _, err := cli.GetGrowthEstimates(request.GetGrowthEstimates{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetGrowthEstimatesCtx(ctx, request.GetGrowthEstimates{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetRecommendations",
+			url:    "/Analysis/RecommendationsURL",
+			cost:   dictionary.Recommendations,
+			call: func(cli Client) error {
+				_, _, err := cli.GetRecommendations(request.GetRecommendations{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetRecommendationsCtx(ctx, request.GetRecommendations{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetPriceTarget",
+			url:    "/Analysis/PriceTargetURL",
+			cost:   dictionary.PriceTarget,
+			call: func(cli Client) error {
+				_, _, err := cli.GetPriceTarget(request.GetPriceTarget{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetPriceTargetCtx(ctx, request.GetPriceTarget{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetAnalystRatingsSnapshot",
+			url:    "/Analysis/AnalystRatingsSnapshotURL",
+			cost:   dictionary.AnalystRatingsSnapshot,
+			call: func(cli Client) error {
+				_, _, err := cli.GetAnalystRatingsSnapshot(request.GetAnalystRatingsSnapshot{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetAnalystRatingsSnapshotCtx(ctx, request.GetAnalystRatingsSnapshot{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetAnalystRatingsUSEquities",
+			url:    "/Analysis/AnalystRatingsUSEquitiesURL",
+			cost:   dictionary.AnalystRatingsUSEquities,
+			call: func(cli Client) error {
+				_, _, err := cli.GetAnalystRatingsUSEquities(request.GetAnalystRatingsUSEquities{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetAnalystRatingsUSEquitiesCtx(ctx, request.GetAnalystRatingsUSEquities{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetEDGARFilings",
+			url:    "/Regulatory/EDGARFilingsURL",
+			cost:   dictionary.EDGARFilings,
+			call: func(cli Client) error {
+				_, _, err := cli.GetEDGARFilings(request.GetEDGARFilings{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetEDGARFilingsCtx(ctx, request.GetEDGARFilings{})
+
+				return err
+			},
+		},
+		{
+			method: "GetInsiderTransactions",
+			url:    "/Regulatory/InsiderTransactionsURL",
+			cost:   dictionary.InsiderTransactions,
+			call: func(cli Client) error {
+				_, _, err := cli.GetInsiderTransactions(request.GetInsiderTransactions{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetInsiderTransactionsCtx(ctx, request.GetInsiderTransactions{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetInstitutionalHolders",
+			url:    "/Regulatory/InstitutionalHoldersURL",
+			cost:   dictionary.InstitutionalHolders,
+			call: func(cli Client) error {
+				_, _, err := cli.GetInstitutionalHolders(request.GetInstitutionalHolders{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetInstitutionalHoldersCtx(ctx, request.GetInstitutionalHolders{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetFundHolders",
+			url:    "/Regulatory/FundHoldersURL",
+			cost:   dictionary.FundHolders,
+			call: func(cli Client) error {
+				_, _, err := cli.GetFundHolders(request.GetFundHolders{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetFundHoldersCtx(ctx, request.GetFundHolders{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetDirectHolders",
+			url:    "/Regulatory/DirectHoldersURL",
+			cost:   dictionary.DirectHolders,
+			call: func(cli Client) error {
+				_, _, err := cli.GetDirectHolders(request.GetDirectHolders{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetDirectHoldersCtx(ctx, request.GetDirectHolders{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetTaxInformation",
+			url:    "/Regulatory/TaxInformationURL",
+			cost:   dictionary.TaxInformation,
+			call: func(cli Client) error {
+				_, _, err := cli.GetTaxInformation(request.GetTaxInformation{Symbol: "AAPL"})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetTaxInformationCtx(ctx, request.GetTaxInformation{Symbol: "AAPL"})
+
+				return err
+			},
+		},
+		{
+			method: "GetSanctionedEntities",
+			url:    "/Regulatory/SanctionedEntitiesURL",
+			cost:   dictionary.SanctionedEntities,
+			call: func(cli Client) error {
+				_, _, err := cli.GetSanctionedEntities(request.GetSanctionedEntities{Source: request.SanctionsSourceOFAC})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetSanctionedEntitiesCtx(ctx, request.GetSanctionedEntities{Source: request.SanctionsSourceOFAC})
+
+				return err
+			},
+		},
+		{
+			method: "GetUsage",
+			url:    "/Advanced/UsageURL",
+			cost:   dictionary.Usage,
+			call: func(cli Client) error {
+				_, _, err := cli.GetUsage(request.GetUsage{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetUsageCtx(ctx, request.GetUsage{})
+
+				return err
+			},
+		},
+		{
+			method: "GetBatches",
+			url:    "/Advanced/BatchesURL",
+			cost:   dictionary.Batches,
+			call: func(cli Client) error {
+				_, _, err := cli.GetBatches(request.GetBatches{})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetBatchesCtx(ctx, request.GetBatches{})
+
+				return err
+			},
+		},
+		{
+			method: "GetComplexData",
+			url:    "/Advanced/ComplexDataURL",
+			cost:   dictionary.ComplexData,
+			call: func(cli Client) error {
+				_, _, err := cli.GetComplexData(request.GetComplexData{Symbols: []string{"AAPL"}, Intervals: []request.Interval{request.Interval1Day}, Methods: []request.ComplexDataMethod{request.ComplexTimeSeries()}})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.GetComplexDataCtx(ctx, request.GetComplexData{Symbols: []string{"AAPL"}, Intervals: []request.Interval{request.Interval1Day}, Methods: []request.ComplexDataMethod{request.ComplexTimeSeries()}})
+
+				return err
+			},
+		},
+	}
+}
+
+// TestEndpointsWiring checks that every method calls the URL of its Conf field at the cost of its credit
+// constant.
+func TestEndpointsWiring(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		path string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		path = r.URL.Path
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusNotFound)
+
+		if _, err := w.Write([]byte(`{"code":404,"message":"not found","status":"error"}`)); err != nil {
+			t.Error(err)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	cli := NewClient(newTestHTTPCli(server.URL), wiringConf(server.URL))
+
+	for _, tt := range wiringEndpoints() {
+		t.Run(tt.method, func(t *testing.T) {
+			ctx, dryRun := WithDryRun(context.Background())
+			if err := tt.callCtx(ctx, cli); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			requests := dryRun.Requests()
+			if len(requests) != 1 || requests[0].Endpoint != tt.url || requests[0].EstimatedCost != tt.cost {
+				t.Fatalf("%sCtx: expected %s at cost %d, got %+v", tt.method, tt.url, tt.cost, requests)
+			}
+
+			_ = tt.call(cli)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if path != tt.url {
+				t.Errorf("%s: expected %s, got %s", tt.method, tt.url, path)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package twelvedata
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/soulgarden/twelvedata/request"
+)
+
+func TestParseEnums(t *testing.T) {
+	interval, err := request.ParseInterval("1DAY")
+	if err != nil || interval != request.Interval1Day {
+		t.Errorf("ParseInterval(1DAY) = %q, %v", interval, err)
+	}
+
+	format, err := request.ParseFormat("csv")
+	if err != nil || format != request.FormatCSV {
+		t.Errorf("ParseFormat(csv) = %q, %v", format, err)
+	}
+
+	endpoint, err := request.ParseLastChangeEndpoint("statistics")
+	if err != nil || endpoint != request.LastChangeStatistics {
+		t.Errorf("ParseLastChangeEndpoint(statistics) = %q, %v", endpoint, err)
+	}
+
+	if _, err := request.ParseMarket("bonds"); err == nil ||
+		err.Error() != `unknown market "bonds", expected one of stocks, etf, mutual_funds, forex, crypto` {
+		t.Errorf("ParseMarket(bonds) error = %v", err)
+	}
+
+	if _, err := request.ParseOrder(""); err == nil {
+		t.Error("expected an error for an empty order")
+	}
+}
+
+func TestEnumsIsValid(t *testing.T) {
+	tests := []struct {
+		value interface{ IsValid() bool }
+		want  bool
+	}{
+		{value: request.Interval5Min, want: true},
+		{value: request.Interval("5MIN"), want: true},
+		{value: request.Interval("3min"), want: false},
+		{value: request.Order("DESC"), want: true},
+		{value: request.Adjust("splits"), want: true},
+		{value: request.Adjust("split"), want: false},
+		{value: request.SeriesType("hl2"), want: false},
+		{value: request.MAType("ema"), want: true},
+		{value: request.Period(""), want: false},
+		{value: request.Range("ytd"), want: true},
+		{value: request.Direction("losers"), want: true},
+		{value: request.SanctionsSource("ofac"), want: true},
+		{value: request.SanctionsSource("un"), want: false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.value.IsValid(); got != tt.want {
+			t.Errorf("%v.IsValid() = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestEnumsEncoding(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusOK, 99, 1, `{"values":[],"status":"ok"}`,
+		"/time_series?adjust=dividends&format=JSON&interval=1week&order=desc&symbol=AAPL")
+
+	cli := newTestClient(t, serverURL, Conf{CoreData: CoreData{TimeSeriesURL: "/time_series"}})
+
+	_, _, err := cli.GetTimeSeries(request.GetTimeSeries{
+		Symbol:   "AAPL",
+		Interval: request.Interval1Week,
+		Order:    request.OrderDesc,
+		Format:   request.FormatJSON,
+		Adjust:   request.AdjustDividends,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
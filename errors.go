@@ -1,12 +1,15 @@
 package twelvedata
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/soulgarden/twelvedata/dictionary"
+	"github.com/soulgarden/twelvedata/request"
 	"github.com/soulgarden/twelvedata/response"
 )
 
@@ -166,12 +169,18 @@ func (e InternalServerError) Error() string {
 // TimeoutError represents request timeout errors.
 type TimeoutError struct {
 	Message string
+	Cause   error // underlying error, e.g. context.DeadlineExceeded
 }
 
 func (e TimeoutError) Error() string {
 	return "Request Timeout: " + e.Message
 }
 
+// Unwrap returns the underlying cause of the timeout.
+func (e TimeoutError) Unwrap() error {
+	return e.Cause
+}
+
 // NetworkError represents network connectivity errors.
 type NetworkError struct {
 	Message string
@@ -234,11 +243,12 @@ func (e WSSubscriptionError) Unwrap() error {
 }
 
 // NewHTTPError creates appropriate typed error based on HTTP status code.
+// The API key is redacted from url.
 func NewHTTPError(statusCode int, body []byte, url string, apiError *response.Error, cause error) error {
 	baseError := HTTPError{
 		StatusCode: statusCode,
 		Body:       body,
-		URL:        url,
+		URL:        redactSecrets(url),
 		Message:    http.StatusText(statusCode),
 		Cause:      cause,
 	}
@@ -308,6 +318,18 @@ func IsRateLimitError(err error) bool {
 	return errors.As(err, &rateLimitErr)
 }
 
+// IsServerError checks if an error is an InternalServerError or any other HTTP 5xx error.
+func IsServerError(err error) bool {
+	var serverErr *InternalServerError
+	if errors.As(err, &serverErr) {
+		return true
+	}
+
+	var httpErr *HTTPError
+
+	return errors.As(err, &httpErr) && httpErr.StatusCode >= http.StatusInternalServerError
+}
+
 // IsTimeoutError checks if an error is a TimeoutError type.
 func IsTimeoutError(err error) bool {
 	var timeoutErr *TimeoutError
@@ -411,6 +433,58 @@ func (e InsufficientCreditsError) Unwrap() error {
 	return e.Cause
 }
 
+// CreditLimitExceededError is returned locally by CreditLimiter when a request does not fit
+// into the configured credits-per-minute limit.
+type CreditLimitExceededError struct {
+	Cost       int64
+	Limit      int64
+	RetryAfter time.Duration // zero when the cost can never fit into the limit
+	Message    string
+}
+
+func (e CreditLimitExceededError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("Credit Limit Exceeded: %s (cost %d, limit %d per minute, retry after %s)", e.Message, e.Cost, e.Limit, e.RetryAfter)
+	}
+
+	return fmt.Sprintf("Credit Limit Exceeded: %s (cost %d, limit %d per minute)", e.Message, e.Cost, e.Limit)
+}
+
+// CreditCapExceededError is returned locally by CreditLedger when a call would exceed a daily credit cap.
+// It unwraps to an InsufficientCreditsError, so IsInsufficientCreditsError reports it as well.
+type CreditCapExceededError struct {
+	Tag  string // empty for the overall daily cap
+	Cap  int64
+	Used int64
+	Cost int64
+}
+
+func (e CreditCapExceededError) Error() string {
+	if e.Tag != "" {
+		return fmt.Sprintf("Credit Cap Exceeded: tag %q used %d of %d daily credits, request costs %d", e.Tag, e.Used, e.Cap, e.Cost)
+	}
+
+	return fmt.Sprintf("Credit Cap Exceeded: used %d of %d daily credits, request costs %d", e.Used, e.Cap, e.Cost)
+}
+
+func (e CreditCapExceededError) Unwrap() error {
+	return &InsufficientCreditsError{
+		Required:  e.Cost,
+		Available: max(e.Cap-e.Used, 0),
+		Message:   "daily credit cap reached",
+	}
+}
+
+// APIKeyPoolExhaustedError is returned locally by APIKeyPool when every key is on cooldown.
+type APIKeyPoolExhaustedError struct {
+	Keys       int
+	RetryAfter time.Duration // time until the first key leaves its cooldown
+}
+
+func (e APIKeyPoolExhaustedError) Error() string {
+	return fmt.Sprintf("API Key Pool Exhausted: all %d keys are on cooldown, retry after %s", e.Keys, e.RetryAfter)
+}
+
 // APIKeyError represents API key related errors.
 type APIKeyError struct {
 	Type    string // "invalid", "required", "expired"
@@ -465,6 +539,35 @@ func IsAPIKeyError(err error) bool {
 	return errors.As(err, &keyErr)
 }
 
+// IsCreditLimitExceededError checks if an error is a CreditLimitExceededError type.
+func IsCreditLimitExceededError(err error) bool {
+	var limitErr *CreditLimitExceededError
+
+	return errors.As(err, &limitErr)
+}
+
+// IsAPIKeyPoolExhaustedError checks if an error is an APIKeyPoolExhaustedError type.
+func IsAPIKeyPoolExhaustedError(err error) bool {
+	var poolErr *APIKeyPoolExhaustedError
+
+	return errors.As(err, &poolErr)
+}
+
+// IsCreditCapExceededError checks if an error is a CreditCapExceededError type.
+func IsCreditCapExceededError(err error) bool {
+	var capErr *CreditCapExceededError
+
+	return errors.As(err, &capErr)
+}
+
+// IsValidationError checks if an error is a request.ValidationError, returned before a request with invalid
+// parameters is sent.
+func IsValidationError(err error) bool {
+	var validationErr *request.ValidationError
+
+	return errors.As(err, &validationErr)
+}
+
 // IsDomainError checks if an error is any of the Twelve Data domain-specific errors.
 func IsDomainError(err error) bool {
 	return IsSymbolNotFoundError(err) ||
@@ -625,3 +728,55 @@ func WrapWithDomainError(err error, apiError *response.Error, statusCode int, ur
 
 	return err
 }
+
+// ErrorClass returns a short, low-cardinality name of the error type of err, such as "TooManyRequestsError",
+// suitable for span attributes and metric labels. It returns an empty string for a nil error
+// and "Error" for errors of unknown type.
+func ErrorClass(err error) string {
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, context.Canceled):
+		return "Canceled"
+	case IsValidationError(err):
+		return "ValidationError"
+	case IsCreditCapExceededError(err):
+		return "CreditCapExceededError"
+	case IsCreditLimitExceededError(err):
+		return "CreditLimitExceededError"
+	case IsAPIKeyPoolExhaustedError(err):
+		return "APIKeyPoolExhaustedError"
+	case IsSymbolNotFoundError(err):
+		return "SymbolNotFoundError"
+	case IsPlanLimitationError(err):
+		return "PlanLimitationError"
+	case IsInsufficientCreditsError(err):
+		return "InsufficientCreditsError"
+	case IsAPIKeyError(err):
+		return "APIKeyError"
+	case IsBadRequestError(err):
+		return "BadRequestError"
+	case IsUnauthorizedError(err):
+		return "UnauthorizedError"
+	case IsNotFoundError(err):
+		return "NotFoundError"
+	case IsRateLimitError(err):
+		return "TooManyRequestsError"
+	case errors.As(err, new(*InternalServerError)):
+		return "InternalServerError"
+	case IsHTTPError(err):
+		return "HTTPError"
+	case IsTimeoutError(err):
+		return "TimeoutError"
+	case IsNetworkError(err):
+		return "NetworkError"
+	case IsWSConnectionError(err):
+		return "WSConnectionError"
+	case IsWSMessageError(err):
+		return "WSMessageError"
+	case IsWSSubscriptionError(err):
+		return "WSSubscriptionError"
+	default:
+		return "Error"
+	}
+}
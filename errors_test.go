@@ -351,7 +351,7 @@ func TestErrorContextAndURL(t *testing.T) {
 			name:        "http error with url context",
 			err:         NewHTTPError(http.StatusBadRequest, []byte("test"), testURL, nil, nil),
 			expectsURL:  true,
-			expectedURL: testURL,
+			expectedURL: "https://api.twelvedata.com/stocks?apikey=REDACTED",
 		},
 		{
 			name: "websocket connection error with url",
@@ -0,0 +1,155 @@
+package twelvedata
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/soulgarden/twelvedata/response"
+)
+
+// defaultFanOutConcurrency is the number of parallel calls FanOut makes when no concurrency is set.
+const defaultFanOutConcurrency = 8
+
+// FanOutMode defines how FanOut reacts to a failed call.
+type FanOutMode int
+
+const (
+	// FanOutCollectAll runs every call and reports failures per item.
+	FanOutCollectAll FanOutMode = iota
+	// FanOutFailFast stops starting new calls and cancels running ones after the first failure.
+	FanOutFailFast
+)
+
+// FanOutOptions configures FanOut.
+type FanOutOptions struct {
+	Concurrency int // maximum number of parallel calls, 8 when zero or negative
+	Mode        FanOutMode
+}
+
+// FanOutResult is the outcome of one call made by FanOut.
+type FanOutResult[Req any, Resp any] struct {
+	Request  Req
+	Response Resp
+	Credits  response.Credits // nil when the call got no response
+	Err      error
+}
+
+// FanOutResults holds the results of FanOut in the order of the requests.
+type FanOutResults[Req any, Resp any] []FanOutResult[Req, Resp]
+
+// CreditsUsed returns the credits used by all calls.
+func (r FanOutResults[Req, Resp]) CreditsUsed() int64 {
+	var used int64
+
+	for _, result := range r {
+		if result.Credits != nil {
+			used += result.Credits.GetCreditsUsed()
+		}
+	}
+
+	return used
+}
+
+// Failed returns the results with an error.
+func (r FanOutResults[Req, Resp]) Failed() FanOutResults[Req, Resp] {
+	var failed FanOutResults[Req, Resp]
+
+	for _, result := range r {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+
+	return failed
+}
+
+// FanOut calls fn for every request with bounded concurrency, e.g. FanOut(ctx, cli.GetProfileCtx, reqs, opts).
+// Calls pass through the limiter configured on HTTPCli; a call rejected by a fail-fast CreditLimiter is retried
+// once the limiter reports enough credits. Results are returned in the order of reqs.
+// In FanOutFailFast mode the first error is returned and calls that did not run report a context error.
+// Otherwise the returned error is only set when ctx is done.
+func FanOut[Req any, Resp any](
+	ctx context.Context,
+	fn func(context.Context, Req) (Resp, response.Credits, error),
+	reqs []Req,
+	opts FanOutOptions,
+) (FanOutResults[Req, Resp], error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultFanOutConcurrency
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(FanOutResults[Req, Resp], len(reqs))
+	sem := make(chan struct{}, concurrency)
+
+	var (
+		wg       sync.WaitGroup
+		failOnce sync.Once
+		firstErr error
+	)
+
+	for i, req := range reqs {
+		results[i].Request = req
+
+		if err := runCtx.Err(); err != nil {
+			results[i].Err = err
+
+			continue
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-runCtx.Done():
+			results[i].Err = runCtx.Err()
+
+			continue
+		}
+
+		wg.Go(func() {
+			defer func() { <-sem }()
+
+			resp, creds, err := callRespectingLimiter(runCtx, fn, req)
+			results[i].Response, results[i].Credits, results[i].Err = resp, creds, err
+
+			if err != nil && opts.Mode == FanOutFailFast {
+				failOnce.Do(func() {
+					firstErr = err
+
+					cancel()
+				})
+			}
+		})
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return results, firstErr
+	}
+
+	return results, ctx.Err()
+}
+
+// callRespectingLimiter calls fn and repeats the call after a CreditLimitExceededError with a RetryAfter.
+func callRespectingLimiter[Req any, Resp any](
+	ctx context.Context,
+	fn func(context.Context, Req) (Resp, response.Credits, error),
+	req Req,
+) (Resp, response.Credits, error) {
+	for {
+		resp, creds, err := fn(ctx, req)
+
+		var limitErr *CreditLimitExceededError
+		if !errors.As(err, &limitErr) || limitErr.RetryAfter <= 0 {
+			return resp, creds, err
+		}
+
+		if sleepErr := sleepCtx(ctx, limitErr.RetryAfter); sleepErr != nil {
+			return resp, creds, err
+		}
+	}
+}
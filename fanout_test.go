@@ -0,0 +1,162 @@
+package twelvedata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+	"golang.org/x/time/rate"
+)
+
+// newSymbolServer answers quotes after delay, tracking the peak number of parallel requests.
+// The symbol FAIL gets a symbol not found error.
+func newSymbolServer(t *testing.T, delay time.Duration, inFlight, peak *atomic.Int32) string {
+	t.Helper()
+
+	return newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			old := peak.Load()
+			if current <= old || peak.CompareAndSwap(old, current) {
+				break
+			}
+		}
+
+		time.Sleep(delay)
+
+		w.Header().Set("Api-credits-left", "100")
+		w.Header().Set("Api-credits-used", "1")
+
+		symbol := r.URL.Query().Get("symbol")
+
+		body := `{"symbol":"` + symbol + `"}`
+		if symbol == "FAIL" {
+			body = `{"code":404,"message":"**symbol** not found: FAIL. Please specify it correctly","status":"error"}`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func quoteRequests(symbols ...string) []request.GetQuote {
+	reqs := make([]request.GetQuote, 0, len(symbols))
+	for _, symbol := range symbols {
+		reqs = append(reqs, request.GetQuote{Symbol: symbol})
+	}
+
+	return reqs
+}
+
+func TestFanOut_CollectAll(t *testing.T) {
+	var inFlight, peak atomic.Int32
+
+	serverURL := newSymbolServer(t, 20*time.Millisecond, &inFlight, &peak)
+	cli := newTestClient(t, serverURL, quoteConf)
+
+	symbols := []string{"A", "B", "FAIL", "D", "E", "F", "G", "H", "I", "J"}
+
+	results, err := FanOut(context.Background(), cli.GetQuoteCtx, quoteRequests(symbols...), FanOutOptions{Concurrency: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if peak.Load() > 3 {
+		t.Errorf("expected at most 3 parallel calls, got %d", peak.Load())
+	}
+
+	for i, result := range results {
+		if result.Request.Symbol != symbols[i] {
+			t.Fatalf("result %d is for %q, want %q", i, result.Request.Symbol, symbols[i])
+		}
+
+		if symbols[i] == "FAIL" {
+			if !IsSymbolNotFoundError(result.Err) {
+				t.Errorf("expected SymbolNotFoundError for FAIL, got %v", result.Err)
+			}
+
+			continue
+		}
+
+		if result.Err != nil || result.Response.Symbol != symbols[i] {
+			t.Errorf("unexpected result for %s: %+v", symbols[i], result)
+		}
+	}
+
+	if got := results.CreditsUsed(); got != int64(len(symbols)) {
+		t.Errorf("CreditsUsed() = %d, want %d", got, len(symbols))
+	}
+
+	if failed := results.Failed(); len(failed) != 1 || failed[0].Request.Symbol != "FAIL" {
+		t.Errorf("unexpected failed results: %+v", failed)
+	}
+}
+
+func TestFanOut_FailFast(t *testing.T) {
+	var inFlight, peak atomic.Int32
+
+	serverURL := newSymbolServer(t, 20*time.Millisecond, &inFlight, &peak)
+	cli := newTestClient(t, serverURL, quoteConf)
+
+	symbols := []string{"FAIL", "B", "C", "D", "E", "F", "G", "H"}
+
+	results, err := FanOut(context.Background(), cli.GetQuoteCtx, quoteRequests(symbols...),
+		FanOutOptions{Concurrency: 1, Mode: FanOutFailFast})
+	if !IsSymbolNotFoundError(err) {
+		t.Fatalf("expected SymbolNotFoundError, got %v", err)
+	}
+
+	if len(results) != len(symbols) {
+		t.Fatalf("expected %d results, got %d", len(symbols), len(results))
+	}
+
+	for _, result := range results[1:] {
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("expected %s to be skipped, got %+v", result.Request.Symbol, result)
+		}
+	}
+}
+
+func TestFanOut_RespectsFailFastLimiter(t *testing.T) {
+	var inFlight, peak atomic.Int32
+
+	serverURL := newSymbolServer(t, 0, &inFlight, &peak)
+
+	limiter := NewCreditLimiter(60, LimiterFailFast)
+	limiter.limiter = rate.NewLimiter(rate.Limit(100), 2) // 100 credits per second, 2 at once
+
+	cli := newTestClient(t, serverURL, quoteConf, WithCreditLimiter(limiter))
+
+	results, err := FanOut(context.Background(), cli.GetQuoteCtx, quoteRequests("A", "B", "C", "D", "E", "F"), FanOutOptions{Concurrency: 6})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if failed := results.Failed(); len(failed) != 0 {
+		t.Fatalf("expected limited calls to be retried, got failures: %+v", failed)
+	}
+}
+
+func TestFanOut_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	fn := func(context.Context, int) (int, response.Credits, error) {
+		t.Error("unexpected call")
+
+		return 0, nil, nil
+	}
+
+	results, err := FanOut(ctx, fn, []int{1, 2, 3}, FanOutOptions{})
+	if !errors.Is(err, context.Canceled) || len(results) != 3 {
+		t.Fatalf("expected context.Canceled with 3 results, got %v, %d", err, len(results))
+	}
+}
@@ -1,6 +1,7 @@
 package twelvedata
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -10,6 +11,8 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/soulgarden/twelvedata/dictionary"
 	"github.com/valyala/fasthttp"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/singleflight"
 )
 
 // HTTPCli represents an HTTP client wrapper for API requests.
@@ -17,11 +20,55 @@ type HTTPCli struct {
 	transport *fasthttp.Client
 	cfg       *Conf
 	logger    *zerolog.Logger
+	retry     *RetryPolicy
+	limiter   *CreditLimiter
+	ledger    *CreditLedger
+	costs     costRegistry
+
+	interceptors []Interceptor
+	tracer       trace.Tracer
+	metrics      *Metrics
+	cache        *responseCache
+	flights      *singleflight.Group
+	doer         Doer
+	keys         *APIKeyPool
+}
+
+// HTTPCliOption configures optional HTTPCli behaviour.
+type HTTPCliOption func(*HTTPCli)
+
+// WithRetryPolicy makes HTTPCli retry failed requests according to policy.
+// Without it a request is retried once, and only after a dial timeout.
+func WithRetryPolicy(policy RetryPolicy) HTTPCliOption {
+	return func(c *HTTPCli) {
+		c.retry = &policy
+	}
+}
+
+// WithCreditLimiter paces every endpoint call by its credit cost using limiter.
+func WithCreditLimiter(limiter *CreditLimiter) HTTPCliOption {
+	return func(c *HTTPCli) {
+		c.limiter = limiter
+	}
+}
+
+// WithCreditLedger records the credits of every endpoint call in ledger and enforces its daily caps.
+func WithCreditLedger(ledger *CreditLedger) HTTPCliOption {
+	return func(c *HTTPCli) {
+		c.ledger = ledger
+	}
 }
 
 // NewHTTPCli creates a new HTTP client with the specified transport, configuration, and logger.
-func NewHTTPCli(transport *fasthttp.Client, cfg *Conf, logger *zerolog.Logger) *HTTPCli {
-	return &HTTPCli{transport: transport, cfg: cfg, logger: logger}
+// Use WithTransport to send requests through another Doer, transport may be nil then.
+func NewHTTPCli(transport *fasthttp.Client, cfg *Conf, logger *zerolog.Logger, opts ...HTTPCliOption) *HTTPCli {
+	c := &HTTPCli{transport: transport, cfg: cfg, logger: logger}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
 }
 
 func (c *HTTPCli) makeRequest(uri string, resp *fasthttp.Response) (int64, int64, error) {
@@ -29,6 +76,17 @@ func (c *HTTPCli) makeRequest(uri string, resp *fasthttp.Response) (int64, int64
 }
 
 func (c *HTTPCli) doRequest(method string, uri string, headers map[string]string, body []byte, resp *fasthttp.Response) (int64, int64, error) {
+	return c.doRequestCtx(context.Background(), method, uri, headers, body, resp)
+}
+
+func (c *HTTPCli) doRequestCtx(
+	ctx context.Context,
+	method string,
+	uri string,
+	headers map[string]string,
+	body []byte,
+	resp *fasthttp.Response,
+) (int64, int64, error) {
 	req := fasthttp.AcquireRequest()
 
 	defer fasthttp.ReleaseRequest(req)
@@ -50,30 +108,66 @@ func (c *HTTPCli) doRequest(method string, uri string, headers map[string]string
 		req.SetBody(body)
 	}
 
+	policy := legacyRetryPolicy
+	if c.retry != nil {
+		policy = *c.retry
+	}
+
 	start := time.Now()
 
-	if err := c.transport.DoTimeout(req, resp, time.Duration(c.cfg.Timeout)*time.Second); err != nil {
-		c.logRequest(req, resp, time.Since(start), err)
+	for attempt := 1; ; attempt++ {
+		attemptStart := time.Now()
 
-		if !errors.Is(err, fasthttp.ErrDialTimeout) {
-			return 0, 0, fmt.Errorf("http request: %w", err)
+		settle, err := c.admit(ctx)
+		if err != nil {
+			return 0, 0, err
 		}
 
-		c.logger.Debug().Msg("retrying request after dial timeout")
+		err = c.do(ctx, req, resp)
+		settle(resp, err)
 
-		// Reset response to ensure clean state for retry
-		resp.Reset()
+		if err != nil {
+			c.logRequest(req, resp, time.Since(attemptStart), err)
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				err = ctxErr
+			}
+
+			if attempt >= policy.attempts() || !policy.shouldRetry(method, classifyTransportError(err)) {
+				if attempt > 1 {
+					return 0, 0, fmt.Errorf("http cli request: %w", err)
+				}
+
+				return 0, 0, fmt.Errorf("http request: %w", err)
+			}
 
-		// Record new start time for retry logging
-		retryStart := time.Now()
+			if err := c.waitRetry(ctx, policy, attempt, 0, err); err != nil {
+				return 0, 0, fmt.Errorf("http cli request: %w", err)
+			}
 
-		if err := c.transport.DoTimeout(req, resp, time.Duration(c.cfg.Timeout)*time.Second); err != nil {
-			c.logRequest(req, resp, time.Since(retryStart), err)
+			// Reset response to ensure clean state for retry
+			resp.Reset()
+
+			continue
+		}
+
+		if attempt >= policy.attempts() {
+			break
+		}
+
+		respErr := classifyResponse(resp.StatusCode(), resp.Body(), uri)
+		if !policy.shouldRetry(method, respErr) {
+			break
+		}
+
+		c.logRequest(req, resp, time.Since(attemptStart), respErr)
+
+		retryAfter := parseRetryAfter(string(resp.Header.Peek(fasthttp.HeaderRetryAfter)), time.Now())
+		if err := c.waitRetry(ctx, policy, attempt, retryAfter, respErr); err != nil {
 			return 0, 0, fmt.Errorf("http cli request: %w", err)
 		}
 
-		// Log successful retry
-		c.logRequest(req, resp, time.Since(retryStart), nil)
+		resp.Reset()
 	}
 
 	statusCode := resp.StatusCode()
@@ -92,6 +186,115 @@ func (c *HTTPCli) doRequest(method string, uri string, headers map[string]string
 	return creditsLeft, creditsUsed, nil
 }
 
+// admission is the cost charged to the ledger and the limiter for every upstream attempt of an endpoint call.
+type admission struct {
+	endpoint string
+	tag      string
+	cost     int64
+}
+
+type admissionKey struct{}
+
+func withAdmission(ctx context.Context, a admission) context.Context {
+	return context.WithValue(ctx, admissionKey{}, a)
+}
+
+// admit holds the cost of one upstream attempt in the ledger and reserves it on the limiter. settle records
+// the response of the attempt, or only releases the held cost when the attempt failed without one.
+// Requests sent outside an endpoint call are not charged.
+func (c *HTTPCli) admit(ctx context.Context) (settle func(resp *fasthttp.Response, err error), err error) {
+	settle = func(*fasthttp.Response, error) {}
+
+	a, ok := ctx.Value(admissionKey{}).(admission)
+	if !ok {
+		return settle, nil
+	}
+
+	if ledger := c.ledger; ledger != nil {
+		if err := ledger.admit(a.tag, a.cost); err != nil {
+			return nil, err
+		}
+
+		settle = func(resp *fasthttp.Response, err error) {
+			if err != nil {
+				ledger.settle(a.endpoint, a.tag, a.cost, false, 0, -1)
+
+				return
+			}
+
+			creditsLeft, creditsUsed, _ := c.getCredits(resp)
+			if !hasCreditsLeft(resp) {
+				creditsLeft = -1
+			}
+
+			ledger.settle(a.endpoint, a.tag, a.cost, true, creditsUsed, creditsLeft)
+		}
+	}
+
+	if c.limiter != nil {
+		if err := c.limiter.Reserve(ctx, a.cost); err != nil {
+			settle(nil, err)
+
+			return nil, err
+		}
+	}
+
+	return settle, nil
+}
+
+// waitRetry sleeps for the policy backoff before the next attempt.
+func (c *HTTPCli) waitRetry(ctx context.Context, policy RetryPolicy, attempt int, retryAfter time.Duration, cause error) error {
+	delay := policy.backoff(attempt, retryAfter)
+
+	trace.SpanFromContext(ctx).AddEvent("retry", trace.WithAttributes(
+		attrAttempt.Int(attempt),
+		attrErrorType.String(ErrorClass(cause)),
+	))
+
+	c.logger.Debug().
+		Err(cause).
+		Int("attempt", attempt).
+		Dur("delay", delay).
+		Msg("retrying request")
+
+	return sleepCtx(ctx, delay)
+}
+
+// do sends a single request attempt through the interceptors, if any.
+func (c *HTTPCli) do(ctx context.Context, req *fasthttp.Request, resp *fasthttp.Response) error {
+	if len(c.interceptors) > 0 {
+		return c.intercept(ctx, req, resp)
+	}
+
+	return c.send(ctx, req, resp)
+}
+
+// send sends the request, bounding it by both Conf.Timeout and the context deadline.
+func (c *HTTPCli) send(ctx context.Context, req *fasthttp.Request, resp *fasthttp.Response) error {
+	deadline := time.Now().Add(time.Duration(c.cfg.Timeout) * time.Second)
+
+	ctxDeadline, ok := ctx.Deadline()
+	if ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	} else {
+		ok = false
+	}
+
+	var err error
+	if c.doer != nil {
+		err = c.sendDoer(ctx, req, resp, deadline)
+	} else {
+		err = doFastHTTP(ctx, c.transport, req, resp, deadline)
+	}
+
+	// The transport may notice the context deadline before the context itself does.
+	if ok && (errors.Is(err, fasthttp.ErrTimeout) || errors.Is(err, context.DeadlineExceeded)) {
+		return context.DeadlineExceeded
+	}
+
+	return err
+}
+
 func (c *HTTPCli) getCredits(resp *fasthttp.Response) (creditsLeft int64, creditsUsed int64, err error) {
 	creditsLeftStr := string(resp.Header.Peek(dictionary.APICreditsLeft))
 
@@ -133,7 +336,7 @@ func (c *HTTPCli) logRequest(
 	}
 
 	event.
-		Str("request headers", req.Header.String()).
+		Str("request headers", redactSecrets(req.Header.String())).
 		Int("response code", resp.StatusCode()).
 		Dur("duration", duration).
 		Msg("request")
@@ -0,0 +1,290 @@
+package twelvedata
+
+//go:generate go run ./internal/cmd/genindicators -catalog internal/cmd/genindicators/technical_indicators.json
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/soulgarden/twelvedata/dictionary"
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+	"golang.org/x/sync/singleflight"
+)
+
+// indicatorRequest is a GetIndicator request of a named indicator.
+type indicatorRequest struct {
+	request.GetIndicator
+	name string
+}
+
+func (req indicatorRequest) PathParams() map[string]string {
+	return map[string]string{"indicator": req.name}
+}
+
+// Query encodes the shared parameters and adds the indicator parameters of Params.
+func (req indicatorRequest) Query() (url.Values, error) {
+	values := url.Values{}
+	if err := encoder.Encode(req.GetIndicator, values); err != nil {
+		return nil, err
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(req.Params)) {
+		if values.Has(name) {
+			return nil, fmt.Errorf("parameter %s is set twice", name)
+		}
+
+		value, err := formatIndicatorParam(req.Params[name])
+		if err != nil {
+			return nil, fmt.Errorf("parameter %s: %w", name, err)
+		}
+
+		values.Set(name, value)
+	}
+
+	return values, nil
+}
+
+// formatIndicatorParam formats a string, number or boolean parameter value, typed values such as
+// request.SeriesType included.
+func formatIndicatorParam(value any) (string, error) {
+	param := reflect.ValueOf(value)
+
+	switch param.Kind() { //nolint:exhaustive // other kinds are rejected
+	case reflect.String:
+		return param.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(param.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(param.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(param.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(param.Float(), 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("unsupported value %v of type %T", value, value)
+	}
+}
+
+// newIndicatorEndpoint creates the endpoint of every indicator, named by the indicator path parameter.
+// Its cost is not registered, the "/{indicator}" template would match the path of any other endpoint.
+func newIndicatorEndpoint(httpCli *HTTPCli, cfg *Conf) *Endpoint[indicatorRequest, response.IndicatorSeries, response.Credits, error] {
+	return &Endpoint[indicatorRequest, response.IndicatorSeries, response.Credits, error]{
+		httpCli: httpCli,
+		URL:     cfg.BaseURL + cfg.TechnicalIndicators.IndicatorURL,
+		path:    cfg.TechnicalIndicators.IndicatorURL,
+		cost:    dictionary.IndividualIndicators,
+	}
+}
+
+// indicatorCatalog caches the indicators listed by the technical indicators endpoint, used to check
+// the parameters of GetIndicator calls.
+type indicatorCatalog struct {
+	mu         sync.Mutex
+	indicators map[string]*response.TechnicalIndicator
+	flights    singleflight.Group
+}
+
+// load returns the cached indicators, fetching them with the API key of the first call that needs them.
+// Concurrent calls share that fetch, each one waiting until its own context is done. Dry runs and batches do
+// not fetch them and get nil.
+func (c *indicatorCatalog) load(
+	ctx context.Context,
+	endpoint *Endpoint[request.GetTechnicalIndicators, response.TechnicalIndicators, response.Credits, error],
+	apiKey request.APIKey,
+) (map[string]*response.TechnicalIndicator, error) {
+	c.mu.Lock()
+	indicators := c.indicators
+	c.mu.Unlock()
+
+	if indicators != nil {
+		return indicators, nil
+	}
+
+	if dryRunFromContext(ctx) != nil || batchCallFromContext(ctx) != nil {
+		return nil, nil
+	}
+
+	// The fetch outlives the context of the caller that starts it, the others keep waiting for it.
+	fetchCtx := context.WithoutCancel(ctx)
+
+	ch := c.flights.DoChan("", func() (any, error) {
+		list, _, err := endpoint.CallCtx(fetchCtx, request.GetTechnicalIndicators{APIKey: apiKey})
+		if err != nil {
+			return nil, fmt.Errorf("technical indicators: %w", err)
+		}
+
+		c.mu.Lock()
+		c.indicators = list.Data
+		c.mu.Unlock()
+
+		return list.Data, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, &TimeoutError{Message: ctx.Err().Error(), Cause: ctx.Err()}
+		}
+
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+
+		indicators, _ := res.Val.(map[string]*response.TechnicalIndicator)
+
+		return indicators, nil
+	}
+}
+
+// checkIndicatorParams checks that name is a listed indicator and that params are parameters of it
+// holding values of their type.
+func checkIndicatorParams(
+	name string,
+	indicators map[string]*response.TechnicalIndicator,
+	params map[string]any,
+) error {
+	validationErr := &request.ValidationError{Request: "GetIndicator"}
+
+	indicator, ok := indicators[name]
+	if !ok || indicator == nil {
+		validationErr.Fields = append(validationErr.Fields, request.FieldError{
+			Field: "Name", Param: "indicator", Value: name, Reason: fmt.Sprintf("unknown indicator %q", name),
+		})
+
+		return validationErr
+	}
+
+	for _, param := range slices.Sorted(maps.Keys(params)) {
+		spec, ok := indicator.Parameters[param].(map[string]any)
+		if !ok {
+			validationErr.Fields = append(validationErr.Fields, request.FieldError{
+				Field: "Params", Param: param, Value: params[param],
+				Reason: fmt.Sprintf("%s is not a parameter of %s, expected one of %s", param, name,
+					strings.Join(slices.Sorted(maps.Keys(indicator.Parameters)), ", ")),
+			})
+
+			continue
+		}
+
+		if reason := checkIndicatorParam(spec, params[param]); reason != "" {
+			validationErr.Fields = append(validationErr.Fields, request.FieldError{
+				Field: "Params", Param: param, Value: params[param], Reason: param + " " + reason,
+			})
+		}
+	}
+
+	if len(validationErr.Fields) == 0 {
+		return nil
+	}
+
+	return validationErr
+}
+
+// checkIndicatorParam returns why value does not match the type and range of a parameter spec, such as
+// {"type": "int", "default": 9}, or an empty string.
+func checkIndicatorParam(spec map[string]any, value any) string {
+	formatted, err := formatIndicatorParam(value)
+	if err != nil {
+		return "is not a string, a number or a boolean"
+	}
+
+	switch spec["type"] {
+	case "int":
+		if number, err := strconv.ParseFloat(formatted, 64); err != nil || number != math.Trunc(number) {
+			return fmt.Sprintf("must be an integer, got %q", formatted)
+		}
+	case "float":
+		if _, err := strconv.ParseFloat(formatted, 64); err != nil {
+			return fmt.Sprintf("must be a number, got %q", formatted)
+		}
+	case "bool":
+		if _, err := strconv.ParseBool(formatted); err != nil {
+			return fmt.Sprintf("must be a boolean, got %q", formatted)
+		}
+	}
+
+	allowed, _ := spec["range"].([]any)
+	if spec["type"] != "string" || len(allowed) == 0 {
+		return ""
+	}
+
+	values := make([]string, 0, len(allowed))
+	for _, value := range allowed {
+		values = append(values, fmt.Sprint(value))
+	}
+
+	if !slices.ContainsFunc(values, func(value string) bool { return strings.EqualFold(value, formatted) }) {
+		return fmt.Sprintf("has unknown value %q, expected one of %s", formatted, strings.Join(values, ", "))
+	}
+
+	return ""
+}
+
+// GetIndicatorCtx calls any indicator listed by the technical indicators endpoint, such as "aroon" or
+// "supertrend". The first call fetches that list to check the indicator name and req.Params.
+func (cli client) GetIndicatorCtx(
+	ctx context.Context,
+	name string,
+	req request.GetIndicator,
+) (response.IndicatorSeries, response.Credits, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return response.IndicatorSeries{}, nil, &request.ValidationError{
+			Request: "GetIndicator",
+			Fields:  []request.FieldError{{Field: "Name", Param: "indicator", Value: name, Reason: "is required"}},
+		}
+	}
+
+	indicators, err := cli.indicators.load(ctx, cli.getTechnicalIndicators, req.APIKey)
+	if err != nil {
+		return response.IndicatorSeries{}, nil, err
+	}
+
+	if indicators != nil {
+		if err := checkIndicatorParams(name, indicators, req.Params); err != nil {
+			return response.IndicatorSeries{}, nil, err
+		}
+	}
+
+	// Spans, metrics and dry runs name the call by the path of the indicator, such as "/aroon".
+	endpoint := *cli.getIndicator
+	endpoint.path = strings.ReplaceAll(endpoint.path, "{indicator}", name)
+
+	return endpoint.CallCtx(ctx, indicatorRequest{GetIndicator: req, name: name})
+}
+
+func (cli client) GetIndicator(name string, req request.GetIndicator) (response.IndicatorSeries, response.Credits, error) {
+	return cli.GetIndicatorCtx(context.Background(), name, req)
+}
+
+// CallIndicator calls the indicator of params with GetIndicatorCtx, params being added to req.Params.
+// The generated Indicator functions, such as IndicatorAroon, call it with the typed parameters of
+// every indicator.
+func CallIndicator(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.IndicatorParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	merged := maps.Clone(req.Params)
+	if merged == nil {
+		merged = map[string]any{}
+	}
+
+	maps.Copy(merged, params.Params())
+	req.Params = merged
+
+	return cli.GetIndicatorCtx(ctx, params.Indicator(), req)
+}
@@ -0,0 +1,268 @@
+package twelvedata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/soulgarden/twelvedata/request"
+)
+
+const indicatorCatalogBody = `{
+  "data": {
+    "aroon": {
+      "enable": true,
+      "full_name": "Aroon Indicator",
+      "type": "Momentum Indicators",
+      "parameters": {"time_period": {"default": 14, "type": "int"}},
+      "output_values": {"aroon_down": {}, "aroon_up": {}}
+    },
+    "keltner": {
+      "enable": true,
+      "full_name": "Keltner Channels",
+      "type": "Overlap Studies",
+      "parameters": {
+        "multiplier": {"default": 2, "type": "int"},
+        "ma_type": {"default": "EMA", "range": ["SMA", "EMA", "WMA"], "type": "string"},
+        "series_type": {"default": "close", "range": ["open", "high", "low", "close"], "type": "string"}
+      },
+      "output_values": {"upper_line": {}, "middle_line": {}, "lower_line": {}}
+    }
+  },
+  "status": "ok"
+}`
+
+const aroonBody = `{
+  "meta": {
+    "symbol": "AAPL",
+    "interval": "1day",
+    "currency": "USD",
+    "exchange_timezone": "America/New_York",
+    "exchange": "NASDAQ",
+    "mic_code": "XNGS",
+    "type": "Common Stock",
+    "indicator": {"name": "AROON - Aroon Indicator", "time_period": 14}
+  },
+  "values": [
+    {"datetime": "2024-01-02", "aroon_down": "100.00000", "aroon_up": "7.14286"},
+    {"datetime": "2024-01-01", "aroon_down": "92.85714", "aroon_up": null}
+  ],
+  "status": "ok"
+}`
+
+// indicatorServer serves the technical indicators catalog and aroon, recording the URLs requested.
+type indicatorServer struct {
+	mu           sync.Mutex
+	urls         []string
+	catalogDelay time.Duration
+}
+
+func (s *indicatorServer) start(t *testing.T) Client {
+	t.Helper()
+
+	serverURL := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.urls = append(s.urls, r.URL.String())
+		s.mu.Unlock()
+
+		w.Header().Set("Api-credits-left", "100")
+		w.Header().Set("Api-credits-used", "1")
+
+		body := aroonBody
+		if r.URL.Path == "/technical_indicators" {
+			body = indicatorCatalogBody
+
+			time.Sleep(s.catalogDelay)
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Error(err)
+		}
+	})
+
+	return newTestClient(t, serverURL, Conf{
+		ReferenceData:       ReferenceData{TechnicalIndicatorsURL: "/technical_indicators"},
+		TechnicalIndicators: TechnicalIndicators{IndicatorURL: "/{indicator}"},
+	})
+}
+
+func TestClient_GetIndicator(t *testing.T) {
+	server := &indicatorServer{}
+	cli := server.start(t)
+
+	req := request.GetIndicator{
+		Symbol:   "AAPL",
+		Interval: request.Interval1Day,
+		Params:   map[string]any{"time_period": 14},
+	}
+
+	series, creds, err := cli.GetIndicator("AROON", req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if series.Meta.Symbol != "AAPL" || series.Meta.Indicator["name"] != "AROON - Aroon Indicator" || len(series.Values) != 2 {
+		t.Fatalf("unexpected series %+v", series)
+	}
+
+	if got := series.Values[0]; got.Datetime != "2024-01-02" || got.Values["aroon_down"] != 100 || got.Values["aroon_up"] != 7.14286 {
+		t.Errorf("unexpected first value %+v", got)
+	}
+
+	if _, ok := series.Values[1].Values["aroon_up"]; ok || len(series.Values[1].Values) != 1 {
+		t.Errorf("expected the null output to be left out, got %+v", series.Values[1])
+	}
+
+	if creds.GetCreditsUsed() != 1 {
+		t.Errorf("expected the credits of the indicator call, got %d", creds.GetCreditsUsed())
+	}
+
+	if _, _, err := cli.GetIndicatorCtx(context.Background(), "aroon", req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{
+		"/technical_indicators",
+		"/aroon?interval=1day&symbol=AAPL&time_period=14",
+		"/aroon?interval=1day&symbol=AAPL&time_period=14",
+	}
+
+	if len(server.urls) != len(want) {
+		t.Fatalf("expected URLs %v, got %v", want, server.urls)
+	}
+
+	for i := range want {
+		if server.urls[i] != want[i] {
+			t.Errorf("URL %d = %s, want %s", i, server.urls[i], want[i])
+		}
+	}
+}
+
+func TestClient_GetIndicatorInvalidParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  map[string]any
+		wantErr string
+	}{
+		{
+			name:    "",
+			wantErr: "invalid GetIndicator: Name: is required",
+		},
+		{
+			name:    "supertrend",
+			wantErr: `invalid GetIndicator: Name: unknown indicator "supertrend"`,
+		},
+		{
+			name:   "keltner",
+			params: map[string]any{"time_period": 20, "multiplier": 1.5, "ma_type": request.MAType("KAMA"), "series_type": request.SeriesTypeHigh},
+			wantErr: "invalid GetIndicator: Params: ma_type has unknown value \"KAMA\", expected one of SMA, EMA, WMA; " +
+				"Params: multiplier must be an integer, got \"1.5\"; " +
+				"Params: time_period is not a parameter of keltner, expected one of ma_type, multiplier, series_type",
+		},
+	}
+
+	server := &indicatorServer{}
+	cli := server.start(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, creds, err := cli.GetIndicator(tt.name, request.GetIndicator{Symbol: "AAPL", Interval: "1h", Params: tt.params})
+
+			var validationErr *request.ValidationError
+			if !errors.As(err, &validationErr) || err.Error() != tt.wantErr {
+				t.Fatalf("error = %v, want %s", err, tt.wantErr)
+			}
+
+			if creds != nil {
+				t.Errorf("expected no credits, got %v", creds)
+			}
+		})
+	}
+
+	if len(server.urls) != 1 || server.urls[0] != "/technical_indicators" {
+		t.Errorf("expected only the catalog to be requested, got %v", server.urls)
+	}
+}
+
+func TestIndicatorTypedParams(t *testing.T) {
+	server := &indicatorServer{}
+	cli := server.start(t)
+
+	req := request.GetIndicator{Symbol: "AAPL", Interval: request.Interval1Day, Params: map[string]any{"multiplier": 3}}
+
+	if _, _, err := IndicatorKeltner(context.Background(), cli, req, request.KeltnerParams{
+		MAType:     request.MATypeSMA,
+		SeriesType: request.SeriesTypeHigh,
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := "/keltner?interval=1day&ma_type=SMA&multiplier=3&series_type=high&symbol=AAPL"; server.urls[1] != want {
+		t.Errorf("URL = %s, want %s", server.urls[1], want)
+	}
+
+	if len(req.Params) != 1 {
+		t.Errorf("expected the params of the request to be left untouched, got %v", req.Params)
+	}
+}
+
+func TestClient_GetIndicatorDryRun(t *testing.T) {
+	server := &indicatorServer{}
+	cli := server.start(t)
+
+	ctx, dryRun := WithDryRun(context.Background())
+
+	if _, _, err := IndicatorAroon(ctx, cli, request.GetIndicator{Symbol: "AAPL", Interval: "1h"},
+		request.AroonParams{TimePeriod: 25}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	requests := dryRun.Requests()
+	if len(server.urls) != 0 || len(requests) != 1 || requests[0].Endpoint != "/aroon" ||
+		requests[0].EstimatedCost != 10 {
+		t.Fatalf("expected one recorded indicator call without the catalog, got %+v, %v", requests, server.urls)
+	}
+}
+
+func TestClient_GetIndicatorSharedCatalog(t *testing.T) {
+	server := &indicatorServer{catalogDelay: 50 * time.Millisecond}
+	cli := server.start(t)
+
+	req := request.GetIndicator{Symbol: "AAPL", Interval: request.Interval1Day}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	var wg sync.WaitGroup
+
+	wg.Go(func() {
+		if _, _, err := cli.GetIndicatorCtx(cancelled, "aroon", req); !errors.Is(err, context.Canceled) {
+			t.Errorf("expected the cancelled call to stop waiting, got %v", err)
+		}
+	})
+
+	for range 4 {
+		wg.Go(func() {
+			if _, _, err := cli.GetIndicator("aroon", req); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+
+	wg.Wait()
+
+	var catalogCalls int
+
+	for _, url := range server.urls {
+		if url == "/technical_indicators" {
+			catalogCalls++
+		}
+	}
+
+	if catalogCalls != 1 || len(server.urls) != 5 {
+		t.Errorf("expected one shared catalog call and 4 indicator calls, got %v", server.urls)
+	}
+}
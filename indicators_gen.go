@@ -0,0 +1,1136 @@
+// Code generated by genindicators from technical_indicators.json. DO NOT EDIT.
+
+package twelvedata
+
+import (
+	"context"
+
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+// indicatorPaths are the paths of the indicators in the catalog, as called by GetIndicatorCtx.
+var indicatorPaths = []string{
+	"/ad",
+	"/add",
+	"/adosc",
+	"/adx",
+	"/adxr",
+	"/apo",
+	"/aroon",
+	"/aroonosc",
+	"/atr",
+	"/avg",
+	"/avgprice",
+	"/bbands",
+	"/beta",
+	"/bop",
+	"/cci",
+	"/ceil",
+	"/cmo",
+	"/coppock",
+	"/correl",
+	"/crsi",
+	"/dema",
+	"/div",
+	"/dpo",
+	"/dx",
+	"/ema",
+	"/exp",
+	"/floor",
+	"/heikinashicandles",
+	"/hlc3",
+	"/ht_dcperiod",
+	"/ht_dcphase",
+	"/ht_phasor",
+	"/ht_sine",
+	"/ht_trendline",
+	"/ht_trendmode",
+	"/ichimoku",
+	"/kama",
+	"/keltner",
+	"/kst",
+	"/linearreg",
+	"/linearregangle",
+	"/linearregintercept",
+	"/linearregslope",
+	"/ln",
+	"/log10",
+	"/ma",
+	"/macd",
+	"/macd_slope",
+	"/macdext",
+	"/mama",
+	"/max",
+	"/maxindex",
+	"/mcginley_dynamic",
+	"/medprice",
+	"/mfi",
+	"/midpoint",
+	"/midprice",
+	"/min",
+	"/minindex",
+	"/minmax",
+	"/minmaxindex",
+	"/minus_di",
+	"/minus_dm",
+	"/mom",
+	"/mult",
+	"/natr",
+	"/obv",
+	"/percent_b",
+	"/pivot_points_hl",
+	"/plus_di",
+	"/plus_dm",
+	"/ppo",
+	"/roc",
+	"/rocp",
+	"/rocr",
+	"/rocr100",
+	"/rsi",
+	"/rvol",
+	"/sar",
+	"/sarext",
+	"/sma",
+	"/sqrt",
+	"/stddev",
+	"/stoch",
+	"/stochf",
+	"/stochrsi",
+	"/sub",
+	"/sum",
+	"/supertrend",
+	"/supertrend_heikinashicandles",
+	"/t3ma",
+	"/tema",
+	"/trange",
+	"/trima",
+	"/tsf",
+	"/typprice",
+	"/ultosc",
+	"/var",
+	"/vwap",
+	"/wclprice",
+	"/willr",
+	"/wma",
+}
+
+// IndicatorAD returns the Chaikin A/D Line of req, with values keyed by ad.
+func IndicatorAD(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.ADParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorAdd returns the Addition of req, with values keyed by add.
+func IndicatorAdd(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.AddParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorADOSC returns the Chaikin A/D Oscillator of req, with values keyed by adosc.
+func IndicatorADOSC(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.ADOSCParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorADX returns the Average Directional Index of req, with values keyed by adx.
+func IndicatorADX(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.ADXParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorADXR returns the Average Directional Movement Index Rating of req, with values keyed by adxr.
+func IndicatorADXR(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.ADXRParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorAPO returns the Absolute Price Oscillator of req, with values keyed by apo.
+func IndicatorAPO(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.APOParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorAroon returns the Aroon Indicator of req, with values keyed by aroon_down and aroon_up.
+func IndicatorAroon(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.AroonParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorAroonOsc returns the Aroon Oscillator of req, with values keyed by aroonosc.
+func IndicatorAroonOsc(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.AroonOscParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorATR returns the Average True Range of req, with values keyed by atr.
+func IndicatorATR(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.ATRParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorAvg returns the Average of req, with values keyed by avg.
+func IndicatorAvg(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.AvgParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorAvgPrice returns the Average Price of req, with values keyed by avgprice.
+func IndicatorAvgPrice(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.AvgPriceParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorBBands returns the Bollinger Bands of req, with values keyed by lower_band, middle_band and upper_band.
+func IndicatorBBands(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.BBandsParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorBeta returns the Beta of req, with values keyed by beta.
+func IndicatorBeta(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.BetaParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorBOP returns the Balance Of Power of req, with values keyed by bop.
+func IndicatorBOP(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.BOPParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorCCI returns the Commodity Channel Index of req, with values keyed by cci.
+func IndicatorCCI(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.CCIParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorCeil returns the Vector Ceil of req, with values keyed by ceil.
+func IndicatorCeil(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.CeilParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorCMO returns the Chande Momentum Oscillator of req, with values keyed by cmo.
+func IndicatorCMO(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.CMOParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorCoppock returns the Coppock Curve of req, with values keyed by coppock.
+func IndicatorCoppock(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.CoppockParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorCorrel returns the Pearson's Correlation Coefficient of req, with values keyed by correl.
+func IndicatorCorrel(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.CorrelParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorCRSI returns the ConnorsRSI of req, with values keyed by crsi.
+func IndicatorCRSI(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.CRSIParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorDEMA returns the Double Exponential Moving Average of req, with values keyed by dema.
+func IndicatorDEMA(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.DEMAParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorDiv returns the Division of req, with values keyed by div.
+func IndicatorDiv(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.DivParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorDPO returns the Detrended Price Oscillator of req, with values keyed by dpo.
+func IndicatorDPO(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.DPOParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorDX returns the Directional Movement Index of req, with values keyed by dx.
+func IndicatorDX(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.DXParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorEMA returns the Exponential Moving Average of req, with values keyed by ema.
+func IndicatorEMA(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.EMAParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorExp returns the Exponential of req, with values keyed by exp.
+func IndicatorExp(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.ExpParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorFloor returns the Vector Floor of req, with values keyed by floor.
+func IndicatorFloor(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.FloorParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorHeikinAshiCandles returns the Heikin-Ashi Candles of req, with values keyed by heikincloses, heikinhighs, heikinlows and heikinopens.
+func IndicatorHeikinAshiCandles(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.HeikinAshiCandlesParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorHLC3 returns the High, Low, Close Average of req, with values keyed by hlc3.
+func IndicatorHLC3(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.HLC3Params,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorHTDCPeriod returns the Hilbert Transform Dominant Cycle Period of req, with values keyed by ht_dcperiod.
+func IndicatorHTDCPeriod(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.HTDCPeriodParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorHTDCPhase returns the Hilbert Transform Dominant Cycle Phase of req, with values keyed by ht_dcphase.
+func IndicatorHTDCPhase(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.HTDCPhaseParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorHTPhasor returns the Hilbert Transform Phasor Components of req, with values keyed by in_phase and quadrature.
+func IndicatorHTPhasor(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.HTPhasorParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorHTSine returns the Hilbert Transform SineWave of req, with values keyed by ht_leadsine and ht_sine.
+func IndicatorHTSine(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.HTSineParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorHTTrendline returns the Hilbert Transform Instantaneous Trendline of req, with values keyed by ht_trendline.
+func IndicatorHTTrendline(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.HTTrendlineParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorHTTrendMode returns the Hilbert Transform Trend vs Cycle Mode of req, with values keyed by ht_trendmode.
+func IndicatorHTTrendMode(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.HTTrendModeParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorIchimoku returns the Ichimoku Kinkō Hyō of req, with values keyed by chikou_span, kijun_sen, senkou_span_a, senkou_span_b and tenkan_sen.
+func IndicatorIchimoku(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.IchimokuParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorKAMA returns the Kaufman Adaptive Moving Average of req, with values keyed by kama.
+func IndicatorKAMA(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.KAMAParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorKeltner returns the Keltner Channels of req, with values keyed by lower_line, middle_line and upper_line.
+func IndicatorKeltner(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.KeltnerParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorKST returns the Know Sure Thing of req, with values keyed by kst and kst_signal.
+func IndicatorKST(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.KSTParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorLinearReg returns the Linear Regression of req, with values keyed by linearreg.
+func IndicatorLinearReg(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.LinearRegParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorLinearRegAngle returns the Linear Regression Angle of req, with values keyed by linearregangle.
+func IndicatorLinearRegAngle(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.LinearRegAngleParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorLinearRegIntercept returns the Linear Regression Intercept of req, with values keyed by linearregintercept.
+func IndicatorLinearRegIntercept(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.LinearRegInterceptParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorLinearRegSlope returns the Linear Regression Slope of req, with values keyed by linearregslope.
+func IndicatorLinearRegSlope(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.LinearRegSlopeParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorLn returns the Natural Logarithm of req, with values keyed by ln.
+func IndicatorLn(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.LnParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorLog10 returns the Base-10 Logarithm of req, with values keyed by log10.
+func IndicatorLog10(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.Log10Params,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorMA returns the Moving Average of req, with values keyed by ma.
+func IndicatorMA(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.MAParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorMACD returns the Moving Average Convergence Divergence of req, with values keyed by macd, macd_hist and macd_signal.
+func IndicatorMACD(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.MACDParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorMACDSlope returns the Moving Average Convergence Divergence Regression Slope of req, with values keyed by macd_hist_slope, macd_signal_slope and macd_slope.
+func IndicatorMACDSlope(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.MACDSlopeParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorMACDExt returns the Moving Average Convergence Divergence Extended of req, with values keyed by macd, macd_hist and macd_signal.
+func IndicatorMACDExt(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.MACDExtParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorMAMA returns the MESA Adaptive Moving Average of req, with values keyed by fama and mama.
+func IndicatorMAMA(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.MAMAParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorMax returns the Highest Value Over Period of req, with values keyed by max.
+func IndicatorMax(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.MaxParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorMaxIndex returns the Index of Highest Value Over Period of req, with values keyed by maxidx.
+func IndicatorMaxIndex(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.MaxIndexParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorMcGinleyDynamic returns the McGinley Dynamic of req, with values keyed by mcginley_dynamic.
+func IndicatorMcGinleyDynamic(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.McGinleyDynamicParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorMedPrice returns the Median Price of req, with values keyed by medprice.
+func IndicatorMedPrice(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.MedPriceParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorMFI returns the Money Flow Index of req, with values keyed by mfi.
+func IndicatorMFI(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.MFIParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorMidPoint returns the MidPoint Over Period of req, with values keyed by midpoint.
+func IndicatorMidPoint(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.MidPointParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorMidPrice returns the Midpoint Price Over Period of req, with values keyed by midprice.
+func IndicatorMidPrice(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.MidPriceParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorMin returns the Lowest Value Over Period of req, with values keyed by min.
+func IndicatorMin(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.MinParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorMinIndex returns the Index of Lowest Value Over Period of req, with values keyed by minidx.
+func IndicatorMinIndex(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.MinIndexParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorMinMax returns the Lowest and Highest Values Over Period of req, with values keyed by max and min.
+func IndicatorMinMax(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.MinMaxParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorMinMaxIndex returns the Indexes of Lowest and Highest Values Over Period of req, with values keyed by maxidx and minidx.
+func IndicatorMinMaxIndex(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.MinMaxIndexParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorMinusDI returns the Minus Directional Indicator of req, with values keyed by minus_di.
+func IndicatorMinusDI(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.MinusDIParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorMinusDM returns the Minus Directional Movement of req, with values keyed by minus_dm.
+func IndicatorMinusDM(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.MinusDMParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorMOM returns the Momentum of req, with values keyed by mom.
+func IndicatorMOM(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.MOMParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorMult returns the Multiplication of req, with values keyed by mult.
+func IndicatorMult(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.MultParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorNATR returns the Normalized Average True Range of req, with values keyed by natr.
+func IndicatorNATR(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.NATRParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorOBV returns the On Balance Volume of req, with values keyed by obv.
+func IndicatorOBV(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.OBVParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorPercentB returns the %B Indicator of req, with values keyed by percent_b.
+func IndicatorPercentB(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.PercentBParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorPivotPointsHL returns the Pivot Points (High/Low) of req, with values keyed by pivot_points_hl.
+func IndicatorPivotPointsHL(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.PivotPointsHLParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorPlusDI returns the Plus Directional Indicator of req, with values keyed by plus_di.
+func IndicatorPlusDI(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.PlusDIParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorPlusDM returns the Plus Directional Movement of req, with values keyed by plus_dm.
+func IndicatorPlusDM(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.PlusDMParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorPPO returns the Percentage Price Oscillator of req, with values keyed by ppo.
+func IndicatorPPO(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.PPOParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorROC returns the Rate of Change of req, with values keyed by roc.
+func IndicatorROC(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.ROCParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorROCP returns the Rate of Change Percentage of req, with values keyed by rocp.
+func IndicatorROCP(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.ROCPParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorROCR returns the Rate of Change Ratio of req, with values keyed by rocr.
+func IndicatorROCR(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.ROCRParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorROCR100 returns the Rate of Change Ratio 100 Scale of req, with values keyed by rocr100.
+func IndicatorROCR100(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.ROCR100Params,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorRSI returns the Relative Strength Index of req, with values keyed by rsi.
+func IndicatorRSI(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.RSIParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorRVOL returns the Relative Volume Indicator of req, with values keyed by rvol.
+func IndicatorRVOL(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.RVOLParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorSAR returns the Parabolic SAR of req, with values keyed by sar.
+func IndicatorSAR(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.SARParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorSARExt returns the Parabolic SAR Extended of req, with values keyed by sarext.
+func IndicatorSARExt(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.SARExtParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorSMA returns the Simple Moving Average of req, with values keyed by sma.
+func IndicatorSMA(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.SMAParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorSqrt returns the Square Root of req, with values keyed by sqrt.
+func IndicatorSqrt(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.SqrtParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorStdDev returns the Standard Deviation of req, with values keyed by stddev.
+func IndicatorStdDev(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.StdDevParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorStoch returns the Stochastic Oscillator of req, with values keyed by slow_d and slow_k.
+func IndicatorStoch(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.StochParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorStochF returns the Stochastic Fast of req, with values keyed by fast_d and fast_k.
+func IndicatorStochF(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.StochFParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorStochRSI returns the Stochastic RSI of req, with values keyed by d and k.
+func IndicatorStochRSI(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.StochRSIParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorSub returns the Subtraction of req, with values keyed by sub.
+func IndicatorSub(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.SubParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorSum returns the Summation of req, with values keyed by sum.
+func IndicatorSum(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.SumParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorSuperTrend returns the SuperTrend of req, with values keyed by supertrend.
+func IndicatorSuperTrend(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.SuperTrendParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorSuperTrendHeikinAshiCandles returns the SuperTrend Heikin-Ashi Candles of req, with values keyed by heikincloses, heikinhighs, heikinlows, heikinopens and supertrend.
+func IndicatorSuperTrendHeikinAshiCandles(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.SuperTrendHeikinAshiCandlesParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorT3MA returns the Triple Exponential Moving Average (T3) of req, with values keyed by t3ma.
+func IndicatorT3MA(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.T3MAParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorTEMA returns the Triple Exponential Moving Average of req, with values keyed by tema.
+func IndicatorTEMA(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.TEMAParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorTRange returns the True Range of req, with values keyed by trange.
+func IndicatorTRange(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.TRangeParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorTRIMA returns the Triangular Moving Average of req, with values keyed by trima.
+func IndicatorTRIMA(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.TRIMAParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorTSF returns the Time Series Forecast of req, with values keyed by tsf.
+func IndicatorTSF(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.TSFParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorTypPrice returns the Typical Price of req, with values keyed by typprice.
+func IndicatorTypPrice(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.TypPriceParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorUltOsc returns the Ultimate Oscillator of req, with values keyed by ultosc.
+func IndicatorUltOsc(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.UltOscParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorVar returns the Variance of req, with values keyed by var.
+func IndicatorVar(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.VarParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorVWAP returns the Volume Weighted Average Price of req, with values keyed by vwap.
+func IndicatorVWAP(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.VWAPParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorWCLPrice returns the Weighted Close Price of req, with values keyed by wclprice.
+func IndicatorWCLPrice(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.WCLPriceParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorWillR returns the Williams %R of req, with values keyed by willr.
+func IndicatorWillR(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.WillRParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+
+// IndicatorWMA returns the Weighted Moving Average of req, with values keyed by wma.
+func IndicatorWMA(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.WMAParams,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
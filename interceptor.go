@@ -0,0 +1,178 @@
+package twelvedata
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/valyala/fasthttp"
+)
+
+// HTTPRequest is an outgoing API request as seen by interceptors.
+type HTTPRequest struct {
+	Method string
+	URL    string
+	Header http.Header
+	Body   []byte
+
+	// Endpoint is the endpoint path, e.g. "/time_series".
+	Endpoint string
+	// Request is the typed request value passed to Endpoint.Call, e.g. request.GetTimeSeries.
+	Request any
+}
+
+// HTTPResponse is a raw API response as seen by interceptors.
+type HTTPResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Doer sends a single HTTP request attempt.
+type Doer interface {
+	Do(ctx context.Context, req *HTTPRequest) (*HTTPResponse, error)
+}
+
+// DoerFunc adapts a function to the Doer interface.
+type DoerFunc func(ctx context.Context, req *HTTPRequest) (*HTTPResponse, error)
+
+// Do calls f(ctx, req).
+func (f DoerFunc) Do(ctx context.Context, req *HTTPRequest) (*HTTPResponse, error) {
+	return f(ctx, req)
+}
+
+// Interceptor wraps a Doer to inspect or change requests and responses,
+// for example to add headers, audit calls or inject faults.
+type Interceptor func(next Doer) Doer
+
+// WithInterceptors registers interceptors on HTTPCli. The first interceptor is the outermost one.
+// Interceptors run for every attempt, so a retried request passes through them again.
+func WithInterceptors(interceptors ...Interceptor) HTTPCliOption {
+	return func(c *HTTPCli) {
+		c.interceptors = append(c.interceptors, interceptors...)
+	}
+}
+
+// callInfo identifies the endpoint call a transport request belongs to.
+type callInfo struct {
+	endpoint string
+	request  any
+}
+
+type callInfoKey struct{}
+
+func withCallInfo(ctx context.Context, endpoint string, req any) context.Context {
+	return context.WithValue(ctx, callInfoKey{}, callInfo{endpoint: endpoint, request: req})
+}
+
+func callInfoFromContext(ctx context.Context) callInfo {
+	info, _ := ctx.Value(callInfoKey{}).(callInfo)
+
+	return info
+}
+
+// intercept runs req through the interceptor chain with send as the innermost Doer
+// and writes the final response into resp.
+func (c *HTTPCli) intercept(ctx context.Context, req *fasthttp.Request, resp *fasthttp.Response) error {
+	var doer Doer = DoerFunc(func(ctx context.Context, httpReq *HTTPRequest) (*HTTPResponse, error) {
+		transportReq := fasthttp.AcquireRequest()
+		defer fasthttp.ReleaseRequest(transportReq)
+
+		toFastHTTPRequest(httpReq, transportReq)
+
+		transportResp := fasthttp.AcquireResponse()
+		defer fasthttp.ReleaseResponse(transportResp)
+
+		if err := c.send(ctx, transportReq, transportResp); err != nil {
+			return nil, err
+		}
+
+		return fromFastHTTPResponse(transportResp), nil
+	})
+
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		doer = c.interceptors[i](doer)
+	}
+
+	info := callInfoFromContext(ctx)
+
+	httpReq := fromFastHTTPRequest(req)
+	httpReq.Endpoint = info.endpoint
+	httpReq.Request = info.request
+
+	httpResp, err := doer.Do(ctx, httpReq)
+	if err != nil {
+		return err
+	}
+
+	toFastHTTPResponse(httpResp, resp)
+
+	return nil
+}
+
+func fromFastHTTPRequest(req *fasthttp.Request) *HTTPRequest {
+	header := http.Header{}
+	for key, value := range req.Header.All() {
+		header.Add(string(key), string(value))
+	}
+
+	var body []byte
+	if len(req.Body()) > 0 {
+		body = append([]byte(nil), req.Body()...)
+	}
+
+	return &HTTPRequest{
+		Method: string(req.Header.Method()),
+		URL:    req.URI().String(),
+		Header: header,
+		Body:   body,
+	}
+}
+
+func toFastHTTPRequest(httpReq *HTTPRequest, req *fasthttp.Request) {
+	req.SetRequestURI(httpReq.URL)
+	req.Header.SetMethod(httpReq.Method)
+
+	for key, values := range httpReq.Header {
+		if key == fasthttp.HeaderContentLength {
+			continue
+		}
+
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	if httpReq.Body != nil {
+		req.SetBody(httpReq.Body)
+	}
+}
+
+func fromFastHTTPResponse(resp *fasthttp.Response) *HTTPResponse {
+	header := http.Header{}
+	for key, value := range resp.Header.All() {
+		header.Add(string(key), string(value))
+	}
+
+	return &HTTPResponse{
+		StatusCode: resp.StatusCode(),
+		Header:     header,
+		Body:       append([]byte(nil), resp.Body()...),
+	}
+}
+
+func toFastHTTPResponse(httpResp *HTTPResponse, resp *fasthttp.Response) {
+	resp.Reset()
+	resp.SetStatusCode(httpResp.StatusCode)
+
+	for key, values := range httpResp.Header {
+		if key == fasthttp.HeaderContentLength {
+			continue
+		}
+
+		for _, value := range values {
+			resp.Header.Add(key, value)
+		}
+	}
+
+	resp.SetBody(httpResp.Body)
+}
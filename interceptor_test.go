@@ -0,0 +1,186 @@
+package twelvedata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+func TestHTTPCli_Interceptors_SeeCall(t *testing.T) {
+	var gotHeader string
+
+	serverURL := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Get("X-Audit")
+
+		w.Header().Set("Api-credits-left", "99")
+		w.Header().Set("Api-credits-used", "1")
+
+		if _, err := w.Write([]byte(`{"symbol":"AAPL","name":"Apple Inc"}`)); err != nil {
+			t.Error(err)
+		}
+	})
+
+	var (
+		seenReq  *HTTPRequest
+		seenResp *HTTPResponse
+	)
+
+	audit := func(next Doer) Doer {
+		return DoerFunc(func(ctx context.Context, req *HTTPRequest) (*HTTPResponse, error) {
+			req.Header.Set("X-Audit", "job-42")
+
+			resp, err := next.Do(ctx, req)
+
+			seenReq, seenResp = req, resp
+
+			return resp, err
+		})
+	}
+
+	cli := newTestClient(t, serverURL, quoteConf, WithInterceptors(audit))
+
+	resp, creds, err := cli.GetQuote(request.GetQuote{Symbol: "AAPL"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.Name != "Apple Inc" || creds.GetCreditsLeft() != 99 {
+		t.Fatalf("unexpected response: %+v %+v", resp, creds)
+	}
+
+	if gotHeader != "job-42" {
+		t.Errorf("interceptor header not sent, got %q", gotHeader)
+	}
+
+	if seenReq.Endpoint != "/quote" || seenReq.Method != http.MethodGet || !strings.HasSuffix(seenReq.URL, "/quote?symbol=AAPL") {
+		t.Errorf("unexpected request: %+v", seenReq)
+	}
+
+	if typed, ok := seenReq.Request.(request.GetQuote); !ok || typed.Symbol != "AAPL" {
+		t.Errorf("unexpected typed request: %#v", seenReq.Request)
+	}
+
+	if seenResp.StatusCode != http.StatusOK || seenResp.Header.Get("Api-credits-used") != "1" {
+		t.Errorf("unexpected raw response: %+v", seenResp)
+	}
+}
+
+func TestHTTPCli_Interceptors_Order(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusOK, 100, 1, `{"status":"ok"}`, "/")
+
+	var order []string
+
+	named := func(name string) Interceptor {
+		return func(next Doer) Doer {
+			return DoerFunc(func(ctx context.Context, req *HTTPRequest) (*HTTPResponse, error) {
+				order = append(order, name+" before")
+				resp, err := next.Do(ctx, req)
+				order = append(order, name+" after")
+
+				return resp, err
+			})
+		}
+	}
+
+	httpCli := newTestHTTPCli(serverURL)
+	WithInterceptors(named("outer"), named("inner"))(httpCli)
+
+	endpoint := NewEndpoint[headerRequest, testResponse, response.Credits, error](httpCli, serverURL)
+	if _, _, err := endpoint.Call(headerRequest{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"outer before", "inner before", "inner after", "outer after"}
+	if strings.Join(order, ",") != strings.Join(want, ",") {
+		t.Fatalf("unexpected order: %v", order)
+	}
+}
+
+func TestHTTPCli_Interceptors_RewriteResponse(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusOK, 100, 1, `{"status":"ok"}`, "/")
+
+	rewrite := func(next Doer) Doer {
+		return DoerFunc(func(ctx context.Context, req *HTTPRequest) (*HTTPResponse, error) {
+			resp, err := next.Do(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			resp.Body = []byte(`{"status":"rewritten"}`)
+
+			return resp, nil
+		})
+	}
+
+	httpCli := newTestHTTPCli(serverURL)
+	WithInterceptors(rewrite)(httpCli)
+
+	endpoint := NewEndpoint[headerRequest, testResponse, response.Credits, error](httpCli, serverURL)
+
+	resp, _, err := endpoint.Call(headerRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.Status != "rewritten" {
+		t.Fatalf("unexpected status: %s", resp.Status)
+	}
+}
+
+func TestHTTPCli_Interceptors_FaultInjectionIsRetried(t *testing.T) {
+	var calls atomic.Int32
+
+	serverURL := newSequenceServer(t, &calls, nil, http.StatusOK)
+
+	var injected atomic.Bool
+
+	faulty := func(next Doer) Doer {
+		return DoerFunc(func(ctx context.Context, req *HTTPRequest) (*HTTPResponse, error) {
+			if injected.CompareAndSwap(false, true) {
+				return &HTTPResponse{
+					StatusCode: http.StatusServiceUnavailable,
+					Header:     http.Header{},
+					Body:       []byte(`{"code":503,"message":"injected","status":"error"}`),
+				}, nil
+			}
+
+			return next.Do(ctx, req)
+		})
+	}
+
+	httpCli := newRetryHTTPCli(serverURL, fastRetryPolicy(2))
+	WithInterceptors(faulty)(httpCli)
+
+	endpoint := NewEndpoint[headerRequest, testResponse, response.Credits, error](httpCli, serverURL)
+	if _, _, err := endpoint.Call(headerRequest{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("expected 1 request to reach the server, got %d", got)
+	}
+}
+
+func TestHTTPCli_Interceptors_Error(t *testing.T) {
+	errBlocked := errors.New("blocked by policy")
+
+	block := func(Doer) Doer {
+		return DoerFunc(func(context.Context, *HTTPRequest) (*HTTPResponse, error) {
+			return nil, errBlocked
+		})
+	}
+
+	httpCli := newTestHTTPCli("http://127.0.0.1:1")
+	WithInterceptors(block)(httpCli)
+
+	endpoint := NewEndpoint[headerRequest, testResponse, response.Credits, error](httpCli, "http://127.0.0.1:1")
+	if _, _, err := endpoint.Call(headerRequest{}); !errors.Is(err, errBlocked) {
+		t.Fatalf("expected interceptor error, got %v", err)
+	}
+}
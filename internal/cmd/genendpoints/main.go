@@ -0,0 +1,880 @@
+// Command genendpoints generates the wiring of every REST endpoint from the declarative manifest endpoints.json:
+// the Conf URL sections, the credit costs of package dictionary, the Validate and PathParams methods of package
+// request, the client struct, NewClient, the Endpoints and EndpointsCtx interfaces and a test checking that every
+// method is wired to its Conf URL and credit cost.
+//
+// The request and response types of a new endpoint are scaffolded in packages request and response when they
+// do not exist yet, from the params and fields of its manifest entry. They are not generated again afterwards.
+//
+// Add an endpoint to the manifest and regenerate with:
+//
+//	go generate ./...
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+// manifest is the content of endpoints.json.
+type manifest struct {
+	Sections    []section    `json:"sections"`
+	Groups      []group      `json:"groups"`
+	ExtraURLs   []extraURL   `json:"extra_urls"`
+	ExtraFields []extraField `json:"extra_fields"`
+}
+
+// section is a struct of Conf holding endpoint URLs, such as CoreData.
+type section struct {
+	Name string   `json:"name"`
+	Doc  []string `json:"doc"`
+}
+
+// group is a set of endpoints commented together in the client struct and the interfaces.
+type group struct {
+	Name       string     `json:"name"`
+	Section    string     `json:"section"`
+	Subsection string     `json:"subsection"`
+	Endpoints  []endpoint `json:"endpoints"`
+}
+
+type endpoint struct {
+	Method       string      `json:"method"`
+	Request      string      `json:"request"`  // request type, Method by default
+	Response     string      `json:"response"` // response type, Method without Get by default
+	Subsection   string      `json:"subsection"`
+	URLField     string      `json:"url_field"`
+	URLJSON      string      `json:"url_json"`
+	Path         string      `json:"path"`
+	Cost         int64       `json:"cost"`
+	Title        string      `json:"title"` // what is requested, e.g. "time series data"
+	CreditsNote  string      `json:"credits_note"`
+	Deprecated   []string    `json:"deprecated_credits"`
+	Validate     *bool       `json:"validate"`
+	Required     []string    `json:"required"`
+	PathParams   []pathParam `json:"path_params"`
+	Params       []field     `json:"params"` // request fields of the scaffold
+	Fields       []field     `json:"fields"` // response fields of the scaffold
+	SampleFields string      `json:"sample"` // other fields of the generated test request, as Go code
+
+	// Set by resolve.
+	Group   string `json:"-"`
+	Section string `json:"-"`
+	Credits string `json:"-"`
+	Field   string `json:"-"`
+}
+
+type pathParam struct {
+	Field  string `json:"field"`
+	Param  string `json:"param"`
+	Type   string `json:"type"`   // enumeration of package request
+	Sample string `json:"sample"` // value of the generated test request
+}
+
+type field struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// extraURL is a Conf URL of a hand-written endpoint.
+type extraURL struct {
+	Section  string `json:"section"`
+	Comment  string `json:"comment"`
+	URLField string `json:"url_field"`
+	URLJSON  string `json:"url_json"`
+	Path     string `json:"path"`
+}
+
+// extraField is a client field of hand-written methods, initialized by NewClient.
+type extraField struct {
+	Comment string `json:"comment"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Init    string `json:"init"`
+}
+
+// sampleValues are the values of required parameters in the generated test requests.
+var sampleValues = map[string]string{
+	"symbol":   `"AAPL"`,
+	"interval": "request.Interval1Day",
+	"base":     `"EUR"`,
+	"quote":    `"USD"`,
+}
+
+// acronyms are the parameter name parts upper-cased in Go field names.
+var acronyms = map[string]bool{
+	"dp": true, "eod": true, "etf": true, "figi": true, "id": true, "ipo": true, "isin": true, "url": true,
+}
+
+func main() {
+	manifestPath := flag.String("manifest", "endpoints.json", "endpoint manifest")
+	dir := flag.String("dir", ".", "root directory of the module")
+	flag.Parse()
+
+	data, err := os.ReadFile(*manifestPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	files, err := generate(data)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	scaffolds, err := scaffold(data, *dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for name, src := range scaffolds {
+		files[name] = src
+	}
+
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(*dir, name), src, 0o644); err != nil { //nolint:gosec // generated source
+			log.Fatal(err)
+		}
+	}
+}
+
+// generate returns the generated sources keyed by their path relative to the module root.
+func generate(data []byte) (map[string][]byte, error) {
+	m, err := load(data)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string][]byte{}
+
+	for _, out := range []struct {
+		name string
+		tmpl *template.Template
+		data any
+	}{
+		{"endpoints_gen.go", clientTemplate, m},
+		{"endpoints_gen_test.go", wiringTemplate, m},
+		{"conf_gen.go", confTemplate, confSections(m)},
+		{"dictionary/credits_gen.go", creditsTemplate, m},
+		{"request/endpoints_gen.go", validateTemplate, validators(m)},
+	} {
+		src, err := render(out.tmpl, out.data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", out.name, err)
+		}
+
+		files[out.name] = src
+	}
+
+	return files, nil
+}
+
+// load parses a manifest and resolves the defaults of its endpoints.
+func load(data []byte) (*manifest, error) {
+	var m manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("parse manifest: %w", err)
+	}
+
+	if err := m.resolve(); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// resolve sets the defaults of the endpoints and rejects duplicated methods, URLs and credit costs.
+func (m *manifest) resolve() error {
+	sections := map[string]bool{}
+	for _, section := range m.Sections {
+		sections[section.Name] = true
+	}
+
+	seen := map[string]string{}
+	unique := func(kind, name, owner string) error {
+		if other, ok := seen[kind+" "+name]; ok {
+			return fmt.Errorf("%s: %s %s is also used by %s", owner, kind, name, other)
+		}
+
+		seen[kind+" "+name] = owner
+
+		return nil
+	}
+
+	var errs []error
+
+	for i := range m.Groups {
+		group := &m.Groups[i]
+		if !sections[group.Section] {
+			errs = append(errs, fmt.Errorf("group %s: unknown section %q", group.Name, group.Section))
+		}
+
+		for j := range group.Endpoints {
+			ep := &group.Endpoints[j]
+
+			if !strings.HasPrefix(ep.Method, "Get") || ep.URLField == "" || ep.URLJSON == "" || ep.Path == "" {
+				errs = append(errs, fmt.Errorf("endpoint %q: expected a Get method, a url_field, a url_json and a path", ep.Method))
+
+				continue
+			}
+
+			name := strings.TrimPrefix(ep.Method, "Get")
+			ep.Group, ep.Section, ep.Credits = group.Name, group.Section, name
+			ep.Field = "get" + name
+
+			if ep.Request == "" {
+				ep.Request = ep.Method
+			}
+
+			if ep.Response == "" {
+				ep.Response = name
+			}
+
+			if ep.Subsection == "" {
+				ep.Subsection = group.Subsection
+			}
+
+			errs = append(errs,
+				unique("method", ep.Method, ep.Method),
+				unique("URL", ep.Section+"."+ep.URLField, ep.Method),
+				unique("credits", ep.Credits, ep.Method))
+
+			for _, alias := range ep.Deprecated {
+				errs = append(errs, unique("credits", alias, ep.Method))
+			}
+
+			for _, param := range ep.Required {
+				if _, ok := sampleValues[param]; !ok {
+					errs = append(errs, fmt.Errorf("%s: no sample value of the required parameter %s", ep.Method, param))
+				}
+			}
+
+			errs = append(errs, ep.checkPathParams())
+		}
+	}
+
+	for _, extra := range m.ExtraURLs {
+		if !sections[extra.Section] {
+			errs = append(errs, fmt.Errorf("extra URL %s: unknown section %q", extra.URLField, extra.Section))
+		}
+
+		errs = append(errs, unique("URL", extra.Section+"."+extra.URLField, extra.URLField))
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkPathParams checks that the path params are the placeholders of the path.
+func (ep endpoint) checkPathParams() error {
+	placeholders := strings.Count(ep.Path, "{")
+
+	for _, param := range ep.PathParams {
+		if !strings.Contains(ep.Path, "{"+param.Param+"}") {
+			return fmt.Errorf("%s: path param %s is not in %s", ep.Method, param.Param, ep.Path)
+		}
+
+		if param.Field == "" || param.Type == "" || param.Sample == "" {
+			return fmt.Errorf("%s: path param %s needs a field, a type and a sample", ep.Method, param.Param)
+		}
+	}
+
+	if placeholders != len(ep.PathParams) {
+		return fmt.Errorf("%s: expected a path param for every placeholder of %s", ep.Method, ep.Path)
+	}
+
+	return nil
+}
+
+// endpoints returns the endpoints of every group.
+func (m *manifest) endpoints() []endpoint {
+	var endpoints []endpoint
+	for _, group := range m.Groups {
+		endpoints = append(endpoints, group.Endpoints...)
+	}
+
+	return endpoints
+}
+
+// alias is the former name of a credit cost constant.
+type alias struct {
+	Name, Of string
+}
+
+// Aliases returns the former names of the credit cost constants.
+func (m *manifest) Aliases() []alias {
+	var aliases []alias
+
+	for _, ep := range m.endpoints() {
+		for _, name := range ep.Deprecated {
+			aliases = append(aliases, alias{Name: name, Of: ep.Credits})
+		}
+	}
+
+	return aliases
+}
+
+// ResponseType returns the response type qualified by its package, e.g. "[]response.MarketState".
+func (ep endpoint) ResponseType() string {
+	if elem, ok := strings.CutPrefix(ep.Response, "[]"); ok {
+		return "[]response." + elem
+	}
+
+	return "response." + ep.Response
+}
+
+// Sample returns the request literal of the generated test, with its required parameters and the sample
+// fields of the manifest set.
+func (ep endpoint) Sample() string {
+	fields := make([]string, 0, len(ep.Required)+len(ep.PathParams))
+	for _, param := range ep.Required {
+		fields = append(fields, fieldGoName(param)+": "+sampleValues[param])
+	}
+
+	for _, param := range ep.PathParams {
+		fields = append(fields, param.Field+": "+param.Sample)
+	}
+
+	if ep.SampleFields != "" {
+		fields = append(fields, ep.SampleFields)
+	}
+
+	return "request." + ep.Request + "{" + strings.Join(fields, ", ") + "}"
+}
+
+// TestURL returns the unique URL the generated test configures for the endpoint.
+func (ep endpoint) TestURL() string {
+	return "/" + ep.Section + "/" + ep.URLField
+}
+
+// confSection is a Conf struct as rendered by the template.
+type confSection struct {
+	section
+	Fields []confField
+}
+
+type confField struct {
+	Comment string // comment opening a subsection
+	Name    string
+	Tag     string
+}
+
+// confSections returns the Conf structs with their fields in manifest order, the tags aligned.
+func confSections(m *manifest) []confSection {
+	sections := make([]confSection, 0, len(m.Sections))
+
+	for _, section := range m.Sections {
+		type urlField struct{ subsection, name, json, path string }
+
+		var fields []urlField
+
+		for _, ep := range m.endpoints() {
+			if ep.Section == section.Name {
+				fields = append(fields, urlField{ep.Subsection, ep.URLField, ep.URLJSON, ep.Path})
+			}
+		}
+
+		for _, extra := range m.ExtraURLs {
+			if extra.Section == section.Name {
+				fields = append(fields, urlField{extra.Comment, extra.URLField, extra.URLJSON, extra.Path})
+			}
+		}
+
+		width := 0
+		for _, f := range fields {
+			width = max(width, len(f.path))
+		}
+
+		conf := confSection{section: section}
+
+		for i, f := range fields {
+			item := confField{
+				Name: f.name,
+				Tag:  fmt.Sprintf("`%-*s json:%q`", width+len(`default:""`), fmt.Sprintf("default:%q", f.path), f.json),
+			}
+
+			if f.subsection != "" && (i == 0 || fields[i-1].subsection != f.subsection) {
+				item.Comment = f.subsection
+			}
+
+			conf.Fields = append(conf.Fields, item)
+		}
+
+		sections = append(sections, conf)
+	}
+
+	return sections
+}
+
+// validator is a Validate method of package request, with a PathParams method for path params.
+type validator struct {
+	Request    string
+	Title      string
+	Required   []string
+	PathParams []pathParam
+}
+
+// Func returns the check of the fields: validateInstrument when the symbol is required, the identifiers of
+// the instrument being exclusive, validateFields for catalogs and calendars filtering by them.
+func (v validator) Func() string {
+	if slices.Contains(v.Required, "symbol") {
+		return "validateInstrument"
+	}
+
+	return "validateFields"
+}
+
+// validators returns the Validate method of every request type, shared request types once.
+// Endpoints sharing a request type must declare the same parameters.
+func validators(m *manifest) []validator {
+	var (
+		list []validator
+		seen = map[string]bool{}
+	)
+
+	for _, ep := range m.endpoints() {
+		if seen[ep.Request] || (ep.Validate != nil && !*ep.Validate) {
+			continue
+		}
+
+		seen[ep.Request] = true
+		list = append(list, validator{Request: ep.Request, Title: ep.Title, Required: ep.Required, PathParams: ep.PathParams})
+	}
+
+	return list
+}
+
+func render(tmpl *template.Template, data any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// scaffold returns the sources of the request and response types missing in packages request and response.
+// A missing type whose file already exists is an error, files are never overwritten.
+func scaffold(data []byte, dir string) (map[string][]byte, error) {
+	m, err := load(data)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string][]byte{}
+
+	for _, pkg := range []struct {
+		name string
+		tmpl *template.Template
+		typ  func(endpoint) string
+	}{
+		{"request", requestTemplate, func(ep endpoint) string { return ep.Request }},
+		{"response", responseTemplate, func(ep endpoint) string { return strings.TrimPrefix(ep.Response, "[]") }},
+	} {
+		types, err := declaredTypes(filepath.Join(dir, pkg.name))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ep := range m.endpoints() {
+			typ := pkg.typ(ep)
+			if types[typ] {
+				continue
+			}
+
+			name := filepath.Join(pkg.name, snakeCase(strings.TrimPrefix(typ, "Get"))+".go")
+			if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+				return nil, fmt.Errorf("%s: %s is missing but the file exists", name, typ)
+			}
+
+			src, err := render(pkg.tmpl, struct {
+				endpoint
+				Type string
+			}{ep, typ})
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+
+			files[name] = src
+			types[typ] = true
+		}
+	}
+
+	return files, nil
+}
+
+// declaredTypes returns the types declared by the non-test Go files of a package directory.
+func declaredTypes(dir string) (map[string]bool, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	types := map[string]bool{}
+	fset := token.NewFileSet()
+
+	for _, entry := range entries {
+		if !strings.HasSuffix(entry.Name(), ".go") || strings.HasSuffix(entry.Name(), "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, entry.Name()), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, decl := range file.Decls {
+			if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.TYPE {
+				for _, spec := range gen.Specs {
+					types[spec.(*ast.TypeSpec).Name.Name] = true //nolint:forcetypeassert // type declarations hold type specs
+				}
+			}
+		}
+	}
+
+	return types, nil
+}
+
+// fieldGoName converts a parameter name such as "mic_code" to "MicCode".
+func fieldGoName(name string) string {
+	var goName strings.Builder
+
+	for _, part := range strings.Split(name, "_") {
+		switch {
+		case acronyms[part]:
+			goName.WriteString(strings.ToUpper(part))
+		case part != "":
+			goName.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+
+	return goName.String()
+}
+
+// snakeCase converts a Go name such as "ETFFullData" to "etf_full_data".
+func snakeCase(name string) string {
+	var snake strings.Builder
+
+	for i, r := range name {
+		upper := r >= 'A' && r <= 'Z'
+		if upper && i > 0 {
+			prevLower := name[i-1] >= 'a' && name[i-1] <= 'z'
+			nextLower := i+1 < len(name) && name[i+1] >= 'a' && name[i+1] <= 'z'
+
+			if prevLower || (nextLower && name[i-1] >= 'A' && name[i-1] <= 'Z') {
+				snake.WriteByte('_')
+			}
+		}
+
+		snake.WriteString(strings.ToLower(string(r)))
+	}
+
+	return snake.String()
+}
+
+var funcs = template.FuncMap{"goName": fieldGoName}
+
+const header = `// Code generated by genendpoints from endpoints.json. DO NOT EDIT.
+`
+
+var clientTemplate = template.Must(template.New("client").Parse(header + `
+package twelvedata
+
+import (
+	"context"
+
+	"github.com/soulgarden/twelvedata/dictionary"
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+// Endpoints defines a method for every endpoint of the manifest.
+type Endpoints interface {
+{{- range $i, $g := .Groups}}
+{{if $i}}
+{{end}}	// {{$g.Name}}
+{{- range $g.Endpoints}}
+	{{.Method}}(request.{{.Request}}) ({{.ResponseType}}, response.Credits, error)
+{{- end}}
+{{- end}}
+}
+
+// EndpointsCtx defines the context-aware variants of the Endpoints methods.
+type EndpointsCtx interface {
+{{- range $i, $g := .Groups}}
+{{if $i}}
+{{end}}	// {{$g.Name}}
+{{- range $g.Endpoints}}
+	{{.Method}}Ctx(context.Context, request.{{.Request}}) ({{.ResponseType}}, response.Credits, error)
+{{- end}}
+{{- end}}
+}
+
+type client struct {
+{{- range $i, $g := .Groups}}
+{{if $i}}
+{{end}}	// {{$g.Name}}
+{{- range $g.Endpoints}}
+	{{.Field}} *Endpoint[request.{{.Request}}, {{.ResponseType}}, response.Credits, error]
+{{- end}}
+{{- end}}
+{{- range .ExtraFields}}
+{{- if .Comment}}
+
+	// {{.Comment}}
+{{- end}}
+	{{.Name}} {{.Type}}
+{{- end}}
+}
+
+// NewClient creates a new Twelve Data API client instance with the provided HTTP client and configuration.
+// The httpCli parameter should be configured with appropriate timeout and other HTTP settings,
+// while cfg contains the API endpoints, authentication, and other client configuration.
+func NewClient(httpCli *HTTPCli, cfg *Conf) Client {
+	return client{
+{{- range $i, $g := .Groups}}
+{{if $i}}
+{{end}}		// {{$g.Name}}
+{{- range $g.Endpoints}}
+		{{.Field}}: newEndpoint[request.{{.Request}}, {{.ResponseType}}](httpCli, cfg, cfg.{{.Section}}.{{.URLField}}, dictionary.{{.Credits}}),
+{{- end}}
+{{- end}}
+{{- range .ExtraFields}}
+{{- if .Comment}}
+
+		// {{.Comment}}
+{{- end}}
+		{{.Name}}: {{.Init}},
+{{- end}}
+	}
+}
+{{range .Groups}}{{range .Endpoints}}
+func (cli client) {{.Method}}(req request.{{.Request}}) ({{.ResponseType}}, response.Credits, error) {
+	return cli.{{.Field}}.Call(req)
+}
+
+func (cli client) {{.Method}}Ctx(ctx context.Context, req request.{{.Request}}) ({{.ResponseType}}, response.Credits, error) {
+	return cli.{{.Field}}.CallCtx(ctx, req)
+}
+{{end}}{{end}}`))
+
+var wiringTemplate = template.Must(template.New("wiring").Parse(header + `
+package twelvedata
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/soulgarden/twelvedata/dictionary"
+	"github.com/soulgarden/twelvedata/request"
+)
+
+// endpointWiring calls one endpoint with and without a context.
+type endpointWiring struct {
+	method  string
+	url     string
+	cost    int64
+	call    func(Client) error
+	callCtx func(context.Context, Client) error
+}
+
+// wiringConf sets the URL of every endpoint to the name of its Conf field.
+func wiringConf(baseURL string) *Conf {
+	return &Conf{
+		BaseURL: baseURL,
+{{- range .Sections}}{{$section := .Name}}
+		{{.Name}}: {{.Name}}{
+{{- range $.Groups}}{{range .Endpoints}}{{if eq .Section $section}}
+			{{.URLField}}: "{{.TestURL}}",
+{{- end}}{{end}}{{end}}
+		},
+{{- end}}
+	}
+}
+
+func wiringEndpoints() []endpointWiring {
+	return []endpointWiring{
+{{- range .Groups}}{{range .Endpoints}}
+		{
+			method: "{{.Method}}",
+			url:    "{{.TestURL}}",
+			cost:   dictionary.{{.Credits}},
+			call: func(cli Client) error {
+				_, _, err := cli.{{.Method}}({{.Sample}})
+
+				return err
+			},
+			callCtx: func(ctx context.Context, cli Client) error {
+				_, _, err := cli.{{.Method}}Ctx(ctx, {{.Sample}})
+
+				return err
+			},
+		},
+{{- end}}{{end}}
+	}
+}
+
+// TestEndpointsWiring checks that every method calls the URL of its Conf field at the cost of its credit
+// constant.
+func TestEndpointsWiring(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		path string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		path = r.URL.Path
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusNotFound)
+
+		if _, err := w.Write([]byte(` + "`" + `{"code":404,"message":"not found","status":"error"}` + "`" + `)); err != nil {
+			t.Error(err)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	cli := NewClient(newTestHTTPCli(server.URL), wiringConf(server.URL))
+
+	for _, tt := range wiringEndpoints() {
+		t.Run(tt.method, func(t *testing.T) {
+			ctx, dryRun := WithDryRun(context.Background())
+			if err := tt.callCtx(ctx, cli); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			requests := dryRun.Requests()
+			if len(requests) != 1 || requests[0].Endpoint != tt.url || requests[0].EstimatedCost != tt.cost {
+				t.Fatalf("%sCtx: expected %s at cost %d, got %+v", tt.method, tt.url, tt.cost, requests)
+			}
+
+			_ = tt.call(cli)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if path != tt.url {
+				t.Errorf("%s: expected %s, got %s", tt.method, tt.url, path)
+			}
+		})
+	}
+}
+`))
+
+var confTemplate = template.Must(template.New("conf").Parse(header + `
+package twelvedata
+{{range .}}
+{{- range .Doc}}
+// {{.}}
+{{- end}}
+// nolint: lll
+type {{.Name}} struct {
+{{- range $i, $f := .Fields}}
+{{- if $f.Comment}}
+{{if $i}}
+{{end}}	// {{$f.Comment}}
+{{- end}}
+	{{$f.Name}} string {{$f.Tag}}
+{{- end}}
+}
+{{end}}`))
+
+var creditsTemplate = template.Must(template.New("credits").Parse(header + `
+package dictionary
+
+// API credit costs of the endpoints, named after their Client method without the Get prefix.
+const (
+{{- range $i, $g := .Groups}}
+{{if $i}}
+{{end}}	// {{$g.Name}}.
+{{range $g.Endpoints}}
+	// {{.Credits}} represents the API credit cost for {{.Title}} requests.
+{{- if .CreditsNote}}
+	// {{.CreditsNote}}
+{{- end}}
+	{{.Credits}} = {{.Cost}}
+{{- end}}
+{{- end}}
+)
+{{- if .Aliases}}
+
+// Former names of API credit costs.
+const (
+{{- range .Aliases}}
+	// {{.Name}} is the former name of {{.Of}}.
+	//
+	// Deprecated: use {{.Of}}.
+	{{.Name}} = {{.Of}}
+{{- end}}
+)
+{{- end}}
+`))
+
+var validateTemplate = template.Must(template.New("validate").Parse(header + `
+package request
+{{range .}}
+// Validate checks the parameters of req before it is sent.
+func (req {{.Request}}) Validate() error {
+{{- if .PathParams}}
+	v := {{.Func}}(req{{range .Required}}, "{{.}}"{{end}})
+{{- range .PathParams}}
+	v.pathParam("{{.Field}}", "{{.Param}}", req.{{.Field}})
+{{- end}}
+
+	return v.err()
+{{- else}}
+	return {{.Func}}(req{{range .Required}}, "{{.}}"{{end}}).err()
+{{- end}}
+}
+{{- if .PathParams}}
+
+// PathParams returns URL path parameters for the {{.Title}} endpoint.
+func (req {{.Request}}) PathParams() map[string]string {
+	return map[string]string{
+{{- range .PathParams}}
+		"{{.Param}}": string(req.{{.Field}}),
+{{- end}}
+	}
+}
+{{- end}}
+{{end}}`))
+
+var requestTemplate = template.Must(template.New("request").Funcs(funcs).Parse(`package request
+
+// {{.Type}} represents request parameters for {{.Title}}.
+type {{.Type}} struct {
+	APIKey
+{{- range .PathParams}}
+	{{.Field}} {{.Type}} ` + "`" + `schema:"-"` + "`" + ` // URL path parameter, not query param
+{{- end}}
+{{- range .Params}}
+	{{goName .Name}} {{.Type}} ` + "`" + `schema:"{{.Name}},omitempty"` + "`" + `
+{{- end}}
+}
+`))
+
+var responseTemplate = template.Must(template.New("response").Funcs(funcs).Parse(`package response
+
+// {{.Type}} represents the response of {{.Title}} requests.
+type {{.Type}} struct {
+{{- range .Fields}}
+	{{goName .Name}} {{.Type}} ` + "`" + `json:"{{.Name}}"` + "`" + `
+{{- end}}
+	Status string ` + "`" + `json:"status"` + "`" + `
+}
+`))
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	data, err := os.ReadFile("endpoints.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := generate(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, want := range files {
+		got, err := os.ReadFile(filepath.Join("../../..", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date, run go generate ./...", name)
+		}
+	}
+
+	scaffolds, err := scaffold(data, "../../..")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name := range scaffolds {
+		t.Errorf("%s is missing, run go generate ./...", name)
+	}
+}
+
+const newEndpointManifest = `{
+  "sections": [{"name": "Advanced", "doc": ["Advanced contains URL configurations."]}],
+  "groups": [
+    {
+      "name": "Advanced",
+      "section": "Advanced",
+      "endpoints": [
+        {
+          "method": "GetOptionsChain",
+          "url_field": "OptionsChainURL",
+          "url_json": "options_chain_url",
+          "path": "/options/chain/{side}",
+          "cost": 100,
+          "title": "options chain",
+          "required": ["symbol"],
+          "path_params": [{"field": "Side", "param": "side", "type": "OptionSide", "sample": "request.OptionSideCall"}],
+          "params": [{"name": "symbol", "type": "string"}, {"name": "expiration_date", "type": "string"}],
+          "fields": [{"name": "calls", "type": "[]OptionContract"}],
+          "sample": "ExpirationDate: \"2025-01-17\""
+        }
+      ]
+    }
+  ]
+}`
+
+func TestScaffold(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, pkg := range []string{"request", "response"} {
+		if err := os.Mkdir(filepath.Join(dir, pkg), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, err := scaffold([]byte(newEndpointManifest), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string][]string{
+		"request/options_chain.go": {
+			"type GetOptionsChain struct {",
+			"Side           OptionSide `schema:\"-\"`",
+			"ExpirationDate string     `schema:\"expiration_date,omitempty\"`",
+		},
+		"response/options_chain.go": {
+			"type OptionsChain struct {",
+			"Calls  []OptionContract `json:\"calls\"`",
+			"Status string           `json:\"status\"`",
+		},
+	}
+
+	if len(files) != len(want) {
+		t.Fatalf("expected %d scaffolds, got %v", len(want), files)
+	}
+
+	for name, lines := range want {
+		for _, line := range lines {
+			if !strings.Contains(string(files[name]), line) {
+				t.Errorf("%s: expected %q in\n%s", name, line, files[name])
+			}
+		}
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "response/options_chain.go"), []byte("package response\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := scaffold([]byte(newEndpointManifest), dir); err == nil {
+		t.Error("expected an error for a missing type whose file exists")
+	}
+}
+
+func TestGenerateNewEndpoint(t *testing.T) {
+	files, err := generate([]byte(newEndpointManifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, line := range map[string]string{
+		"endpoints_gen.go": "getOptionsChain: newEndpoint[request.GetOptionsChain, response.OptionsChain]" +
+			"(httpCli, cfg, cfg.Advanced.OptionsChainURL, dictionary.OptionsChain),",
+		"conf_gen.go":               "OptionsChainURL string `default:\"/options/chain/{side}\" json:\"options_chain_url\"`",
+		"dictionary/credits_gen.go": "OptionsChain = 100",
+		"request/endpoints_gen.go":  `v.pathParam("Side", "side", req.Side)`,
+		"endpoints_gen_test.go": "cli.GetOptionsChainCtx(ctx, request.GetOptionsChain{Symbol: \"AAPL\", " +
+			"Side: request.OptionSideCall, ExpirationDate: \"2025-01-17\"})",
+	} {
+		if !strings.Contains(string(files[name]), line) {
+			t.Errorf("%s: expected %q in\n%s", name, line, files[name])
+		}
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := map[string]struct {
+		from, to string
+		wantErr  string
+	}{
+		"unknown section": {
+			from: `"section": "Advanced"`, to: `"section": "Other"`,
+			wantErr: `unknown section "Other"`,
+		},
+		"path param not in path": {
+			from: `/options/chain/{side}`, to: `/options/chain`,
+			wantErr: "path param side is not in /options/chain",
+		},
+		"required parameter without sample": {
+			from: `"required": ["symbol"]`, to: `"required": ["expiration_date"]`,
+			wantErr: "no sample value of the required parameter expiration_date",
+		},
+		"not a Get method": {
+			from: `"method": "GetOptionsChain"`, to: `"method": "OptionsChain"`,
+			wantErr: "expected a Get method",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := load([]byte(strings.Replace(newEndpointManifest, tt.from, tt.to, 1)))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"OptionsChain":     "options_chain",
+		"ETFFullData":      "etf_full_data",
+		"EDGARFilings":     "edgar_filings",
+		"AnalystRatingsUS": "analyst_ratings_us",
+		"EPSTrend":         "eps_trend",
+	}
+
+	for name, want := range tests {
+		if got := snakeCase(name); got != want {
+			t.Errorf("snakeCase(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+// Command genindicators generates the typed parameters of every technical indicator in package request and
+// the typed Indicator functions of package twelvedata from a snapshot of the technical indicators endpoint.
+//
+// Refresh the snapshot and regenerate with:
+//
+//	curl 'https://api.twelvedata.com/technical_indicators?apikey=demo' > internal/cmd/genindicators/technical_indicators.json
+//	go generate ./...
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+// catalog is the response of the technical indicators endpoint.
+type catalog struct {
+	Data map[string]indicator `json:"data"`
+}
+
+type indicator struct {
+	FullName     string                     `json:"full_name"`
+	Parameters   map[string]parameter       `json:"parameters"`
+	OutputValues map[string]json.RawMessage `json:"output_values"`
+}
+
+type parameter struct {
+	Type    string `json:"type"`
+	Default any    `json:"default"`
+}
+
+// goNames overrides the Go name of indicators that are not acronyms, the others are upper-cased.
+var goNames = map[string]string{
+	"add": "Add", "aroon": "Aroon", "aroonosc": "AroonOsc", "avg": "Avg", "avgprice": "AvgPrice",
+	"bbands": "BBands", "beta": "Beta", "ceil": "Ceil", "coppock": "Coppock", "correl": "Correl",
+	"div": "Div", "exp": "Exp", "floor": "Floor", "heikinashicandles": "HeikinAshiCandles",
+	"ht_dcperiod": "HTDCPeriod", "ht_dcphase": "HTDCPhase", "ht_phasor": "HTPhasor", "ht_sine": "HTSine",
+	"ht_trendline": "HTTrendline", "ht_trendmode": "HTTrendMode", "ichimoku": "Ichimoku", "keltner": "Keltner",
+	"linearreg": "LinearReg", "linearregangle": "LinearRegAngle", "linearregintercept": "LinearRegIntercept",
+	"linearregslope": "LinearRegSlope", "ln": "Ln", "log10": "Log10", "macd_slope": "MACDSlope",
+	"macdext": "MACDExt", "max": "Max", "maxindex": "MaxIndex", "mcginley_dynamic": "McGinleyDynamic",
+	"medprice": "MedPrice", "midpoint": "MidPoint", "midprice": "MidPrice", "min": "Min", "minindex": "MinIndex",
+	"minmax": "MinMax", "minmaxindex": "MinMaxIndex", "minus_di": "MinusDI", "minus_dm": "MinusDM",
+	"mult": "Mult", "percent_b": "PercentB", "pivot_points_hl": "PivotPointsHL", "plus_di": "PlusDI",
+	"plus_dm": "PlusDM", "sarext": "SARExt", "sqrt": "Sqrt", "stddev": "StdDev", "stoch": "Stoch",
+	"stochf": "StochF", "stochrsi": "StochRSI", "sub": "Sub", "sum": "Sum", "supertrend": "SuperTrend",
+	"supertrend_heikinashicandles": "SuperTrendHeikinAshiCandles", "trange": "TRange", "typprice": "TypPrice",
+	"ultosc": "UltOsc", "var": "Var", "wclprice": "WCLPrice", "willr": "WillR",
+}
+
+// acronyms are the parameter name parts upper-cased in Go field names.
+var acronyms = map[string]bool{
+	"atr": true, "dma": true, "kma": true, "ma": true, "roc": true, "rsi": true, "sd": true, "sma": true, "wma": true,
+}
+
+func main() {
+	catalogPath := flag.String("catalog", "technical_indicators.json", "technical indicators endpoint response")
+	requestPath := flag.String("request", "request/indicators_gen.go", "generated typed parameters")
+	wrappersPath := flag.String("wrappers", "indicators_gen.go", "generated Indicator functions")
+	flag.Parse()
+
+	data, err := os.ReadFile(*catalogPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	requestSrc, wrappersSrc, err := generate(data)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*requestPath, requestSrc, 0o644); err != nil { //nolint:gosec // generated source
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*wrappersPath, wrappersSrc, 0o644); err != nil { //nolint:gosec // generated source
+		log.Fatal(err)
+	}
+}
+
+// genIndicator is an indicator as rendered by the templates.
+type genIndicator struct {
+	Name     string
+	GoName   string
+	FullName string
+	Outputs  string
+	Params   []genParam
+}
+
+type genParam struct {
+	Name    string
+	GoName  string
+	GoType  string
+	Default string
+}
+
+// generate returns the sources of package request and package twelvedata for a catalog.
+func generate(data []byte) ([]byte, []byte, error) {
+	var list catalog
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, nil, fmt.Errorf("parse catalog: %w", err)
+	}
+
+	indicators := make([]genIndicator, 0, len(list.Data))
+
+	for _, name := range slices.Sorted(maps.Keys(list.Data)) {
+		spec := list.Data[name]
+
+		item := genIndicator{
+			Name:     name,
+			GoName:   indicatorGoName(name),
+			FullName: spec.FullName,
+			Outputs:  joinWords(slices.Sorted(maps.Keys(spec.OutputValues))),
+		}
+
+		for _, param := range slices.Sorted(maps.Keys(spec.Parameters)) {
+			item.Params = append(item.Params, genParam{
+				Name:    param,
+				GoName:  fieldGoName(param),
+				GoType:  fieldGoType(param, spec.Parameters[param].Type),
+				Default: fmt.Sprint(spec.Parameters[param].Default),
+			})
+		}
+
+		indicators = append(indicators, item)
+	}
+
+	requestSrc, err := render(requestTemplate, indicators)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	wrappersSrc, err := render(wrappersTemplate, indicators)
+	if err != nil {
+		return nil, nil, fmt.Errorf("wrappers: %w", err)
+	}
+
+	return requestSrc, wrappersSrc, nil
+}
+
+func render(tmpl *template.Template, indicators []genIndicator) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, indicators); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+func indicatorGoName(name string) string {
+	if goName, ok := goNames[name]; ok {
+		return goName
+	}
+
+	return strings.ToUpper(strings.ReplaceAll(name, "_", ""))
+}
+
+// fieldGoName converts a parameter name such as "slow_kma_type" to "SlowKMAType".
+func fieldGoName(name string) string {
+	var goName strings.Builder
+
+	for _, part := range strings.Split(name, "_") {
+		switch {
+		case acronyms[part]:
+			goName.WriteString(strings.ToUpper(part))
+		case part != "":
+			goName.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+
+	return goName.String()
+}
+
+// fieldGoType returns the Go type of a parameter, the enumerations of package request for series and
+// moving average types.
+func fieldGoType(name, typ string) string {
+	switch {
+	case strings.HasPrefix(name, "series_type"):
+		return "SeriesType"
+	case strings.HasSuffix(name, "ma_type"):
+		return "MAType"
+	case typ == "int":
+		return "int"
+	case typ == "float":
+		return "float64"
+	case typ == "bool":
+		return "bool"
+	default:
+		return "string"
+	}
+}
+
+// joinWords joins words as "a", "a and b" or "a, b and c".
+func joinWords(words []string) string {
+	if len(words) < 2 {
+		return strings.Join(words, "")
+	}
+
+	return strings.Join(words[:len(words)-1], ", ") + " and " + words[len(words)-1]
+}
+
+var requestTemplate = template.Must(template.New("request").Parse(`// Code generated by genindicators from technical_indicators.json. DO NOT EDIT.
+
+package request
+{{range .}}
+// {{.GoName}}Params are the parameters of the {{.FullName}} indicator, {{.Name}}.
+{{- if .Params}}
+type {{.GoName}}Params struct {
+{{- range .Params}}
+	{{.GoName}} {{.GoType}} // {{.Name}}, {{.Default}} by default
+{{- end}}
+}
+{{- else}}
+type {{.GoName}}Params struct{}
+{{- end}}
+
+// Indicator returns "{{.Name}}".
+func ({{.GoName}}Params) Indicator() string {
+	return "{{.Name}}"
+}
+
+// Params returns the set parameters.
+{{- if .Params}}
+func (p {{.GoName}}Params) Params() map[string]any {
+	params := map[string]any{}
+{{range .Params}}
+{{- if eq .GoType "bool"}}
+	if p.{{.GoName}} {
+{{- else if eq .GoType "int" "float64"}}
+	if p.{{.GoName}} != 0 {
+{{- else}}
+	if p.{{.GoName}} != "" {
+{{- end}}
+		params["{{.Name}}"] = p.{{.GoName}}
+	}
+{{end}}
+	return params
+}
+{{- else}}
+func ({{.GoName}}Params) Params() map[string]any {
+	return map[string]any{}
+}
+{{- end}}
+{{end}}`))
+
+var wrappersTemplate = template.Must(template.New("wrappers").Parse(`// Code generated by genindicators from technical_indicators.json. DO NOT EDIT.
+
+package twelvedata
+
+import (
+	"context"
+
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+// indicatorPaths are the paths of the indicators in the catalog, as called by GetIndicatorCtx.
+var indicatorPaths = []string{
+{{- range .}}
+	"/{{.Name}}",
+{{- end}}
+}
+{{range .}}
+// Indicator{{.GoName}} returns the {{.FullName}} of req, with values keyed by {{.Outputs}}.
+func Indicator{{.GoName}}(
+	ctx context.Context,
+	cli Client,
+	req request.GetIndicator,
+	params request.{{.GoName}}Params,
+) (response.IndicatorSeries, response.Credits, error) {
+	return CallIndicator(ctx, cli, req, params)
+}
+{{end}}`))
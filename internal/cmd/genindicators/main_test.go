@@ -0,0 +1,49 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	data, err := os.ReadFile("technical_indicators.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requestSrc, wrappersSrc, err := generate(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for path, want := range map[string][]byte{
+		"../../../request/indicators_gen.go": requestSrc,
+		"../../../indicators_gen.go":         wrappersSrc,
+	} {
+		got, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date, run go generate ./...", path)
+		}
+	}
+}
+
+func TestFieldGoName(t *testing.T) {
+	tests := map[string]string{
+		"time_period":     "TimePeriod",
+		"series_type_1":   "SeriesType1",
+		"slow_kma_type":   "SlowKMAType",
+		"sd_time_period":  "SDTimePeriod",
+		"long_roc_period": "LongROCPeriod",
+	}
+
+	for name, want := range tests {
+		if got := fieldGoName(name); got != want {
+			t.Errorf("fieldGoName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
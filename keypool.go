@@ -0,0 +1,305 @@
+package twelvedata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/soulgarden/twelvedata/dictionary"
+	"github.com/soulgarden/twelvedata/response"
+	"github.com/valyala/fasthttp"
+)
+
+// defaultKeyCooldown is how long an exhausted or rejected key is skipped by default.
+const defaultKeyCooldown = time.Minute
+
+// APIKeyPool holds several API keys and picks one for every request. Keys answering with
+// InsufficientCreditsError, TooManyRequestsError or APIKeyError are put on cooldown and the request
+// is retried with the next available key. Keys are picked at random, weighted by their last seen
+// Api-credits-left value. APIKeyPool is safe for concurrent use.
+type APIKeyPool struct {
+	mu       sync.Mutex
+	keys     []*pooledKey
+	cooldown time.Duration
+	now      func() time.Time
+	pick     func(n int64) int64 // returns a value in [0, n)
+}
+
+type pooledKey struct {
+	key           string
+	calls         int64
+	failures      int64
+	creditsUsed   int64
+	creditsLeft   int64 // -1 until the first response with an Api-credits-left header
+	cooldownUntil time.Time
+	lastError     string
+}
+
+// APIKeyStats is a snapshot of the usage of a pooled key.
+type APIKeyStats struct {
+	Key           string    // the key masked down to its last four characters
+	Calls         int64     // requests sent with the key
+	Failures      int64     // responses that put the key on cooldown
+	CreditsUsed   int64     // sum of Api-credits-used values
+	CreditsLeft   int64     // last Api-credits-left value, -1 when unknown
+	CooldownUntil time.Time // zero when the key has never been on cooldown
+	LastError     string    // ErrorClass of the last failure
+}
+
+// APIKeyPoolOption configures optional APIKeyPool behaviour.
+type APIKeyPoolOption func(*APIKeyPool)
+
+// WithKeyCooldown sets how long a failing key is skipped, one minute by default.
+// A TooManyRequestsError with a Retry-After header cools the key down for at least that long.
+func WithKeyCooldown(cooldown time.Duration) APIKeyPoolOption {
+	return func(p *APIKeyPool) {
+		p.cooldown = cooldown
+	}
+}
+
+// NewAPIKeyPool creates a pool of keys, empty keys are skipped.
+func NewAPIKeyPool(keys []string, opts ...APIKeyPoolOption) (*APIKeyPool, error) {
+	pool := &APIKeyPool{
+		cooldown: defaultKeyCooldown,
+		now:      time.Now,
+		pick:     rand.Int64N,
+	}
+
+	for _, key := range keys {
+		if key != "" {
+			pool.keys = append(pool.keys, &pooledKey{key: key, creditsLeft: -1})
+		}
+	}
+
+	if len(pool.keys) == 0 {
+		return nil, errors.New("api key pool: no keys")
+	}
+
+	for _, opt := range opts {
+		opt(pool)
+	}
+
+	return pool, nil
+}
+
+// WithAPIKeyPool authenticates every REST call with a key from pool instead of the request or Conf key.
+func WithAPIKeyPool(pool *APIKeyPool) HTTPCliOption {
+	return func(c *HTTPCli) {
+		c.keys = pool
+	}
+}
+
+// Stats returns a snapshot of every key in the pool, in the order the keys were given.
+func (p *APIKeyPool) Stats() []APIKeyStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := make([]APIKeyStats, 0, len(p.keys))
+	for _, key := range p.keys {
+		stats = append(stats, APIKeyStats{
+			Key:           maskKey(key.key),
+			Calls:         key.calls,
+			Failures:      key.failures,
+			CreditsUsed:   key.creditsUsed,
+			CreditsLeft:   key.creditsLeft,
+			CooldownUntil: key.cooldownUntil,
+			LastError:     key.lastError,
+		})
+	}
+
+	return stats
+}
+
+// acquire picks an available key not in tried, weighted by its last seen credits left.
+// Keys with unknown credits weigh as much as the best known one, so fresh keys get tried.
+func (p *APIKeyPool) acquire(tried map[string]bool) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+
+	var (
+		available []*pooledKey
+		maxKnown  int64
+		nextFree  time.Time
+	)
+
+	for _, key := range p.keys {
+		if tried[key.key] {
+			continue
+		}
+
+		if now.Before(key.cooldownUntil) {
+			if nextFree.IsZero() || key.cooldownUntil.Before(nextFree) {
+				nextFree = key.cooldownUntil
+			}
+
+			continue
+		}
+
+		available = append(available, key)
+		maxKnown = max(maxKnown, key.creditsLeft)
+	}
+
+	if len(available) == 0 {
+		return "", &APIKeyPoolExhaustedError{Keys: len(p.keys), RetryAfter: max(nextFree.Sub(now), 0)}
+	}
+
+	weights := make([]int64, len(available))
+
+	var total int64
+
+	for i, key := range available {
+		weight := key.creditsLeft
+		if weight < 0 {
+			weight = maxKnown
+		}
+
+		// Keep every available key reachable, e.g. when all report zero credits left.
+		weights[i] = weight + 1
+		total += weights[i]
+	}
+
+	n := p.pick(total)
+	for i, weight := range weights {
+		if n < weight {
+			return available[i].key, nil
+		}
+
+		n -= weight
+	}
+
+	return available[len(available)-1].key, nil
+}
+
+// report records a response received with key. failure puts the key on cooldown for at least retryAfter.
+func (p *APIKeyPool) report(key string, creditsUsed, creditsLeft int64, creditsLeftKnown bool, failure error, retryAfter time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, pooled := range p.keys {
+		if pooled.key != key {
+			continue
+		}
+
+		pooled.calls++
+		pooled.creditsUsed += creditsUsed
+
+		if creditsLeftKnown {
+			pooled.creditsLeft = creditsLeft
+		}
+
+		if failure != nil {
+			pooled.failures++
+			pooled.lastError = ErrorClass(failure)
+			pooled.cooldownUntil = p.now().Add(max(p.cooldown, retryAfter))
+		}
+
+		return
+	}
+}
+
+// maskKey hides all but the last four characters of key.
+func maskKey(key string) string {
+	const visible = 4
+
+	if len(key) <= visible {
+		return "****"
+	}
+
+	return "****" + key[len(key)-visible:]
+}
+
+// keyFailure returns the error of a response that should put its key on cooldown, or nil.
+func keyFailure(resp *fasthttp.Response) error {
+	statusCode, body := resp.StatusCode(), resp.Body()
+
+	var apiError response.Error
+	if err := json.Unmarshal(body, &apiError); err == nil && apiError.Status == "error" {
+		domainErr := ParseDomainError(&apiError, statusCode, "")
+		if IsInsufficientCreditsError(domainErr) || IsAPIKeyError(domainErr) {
+			return domainErr
+		}
+	}
+
+	// Twelve Data rejects unknown keys with a 401 code without the API key error message.
+	httpErr := classifyResponse(statusCode, body, "")
+	if IsRateLimitError(httpErr) || IsUnauthorizedError(httpErr) {
+		return httpErr
+	}
+
+	return nil
+}
+
+// doRequestPooled sends a built request, rotating through the key pool when one is configured. Every key
+// attempt is charged to the ledger and the limiter like a retry.
+// When every key fails the last failed response is left in resp for the caller to decode.
+func (c *HTTPCli) doRequestPooled(ctx context.Context, built builtRequest, resp *fasthttp.Response) (int64, int64, error) {
+	if c.keys == nil {
+		return c.doRequestCtx(ctx, built.method, built.uri.String(), built.headers, built.body, resp)
+	}
+
+	tried := map[string]bool{}
+
+	var creditsLeft, creditsUsed int64
+
+	for {
+		key, err := c.keys.acquire(tried)
+		if err != nil {
+			if len(tried) > 0 {
+				return creditsLeft, creditsUsed, nil
+			}
+
+			return 0, 0, err
+		}
+
+		tried[key] = true
+
+		uri, headers := withAPIKey(built, key, c.cfg != nil && c.cfg.APIKeyInHeader)
+
+		resp.Reset()
+
+		creditsLeft, creditsUsed, err = c.doRequestCtx(ctx, built.method, uri, headers, built.body, resp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		failure := keyFailure(resp)
+		retryAfter := parseRetryAfter(string(resp.Header.Peek(fasthttp.HeaderRetryAfter)), c.keys.now())
+
+		c.keys.report(key, creditsUsed, creditsLeft, hasCreditsLeft(resp), failure, retryAfter)
+
+		if failure == nil {
+			return creditsLeft, creditsUsed, nil
+		}
+
+		c.logger.Debug().Str("key", maskKey(key)).Err(failure).Msg("api key on cooldown, rotating")
+	}
+}
+
+// withAPIKey returns the URL and headers of built authenticated with key.
+func withAPIKey(built builtRequest, key string, inHeader bool) (string, map[string]string) {
+	headers := make(map[string]string, len(built.headers)+1)
+	for name, value := range built.headers {
+		headers[name] = value
+	}
+
+	if _, ok := headers[dictionary.Authorization]; ok || inHeader {
+		headers[dictionary.Authorization] = "apikey " + key
+	}
+
+	if inHeader {
+		return built.uri.String(), headers
+	}
+
+	values := cloneValues(built.values)
+	values.Set("apikey", key)
+
+	uri := *built.uri
+	uri.RawQuery = values.Encode()
+
+	return uri.String(), headers
+}
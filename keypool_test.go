@@ -0,0 +1,277 @@
+package twelvedata
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/soulgarden/twelvedata/dictionary"
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/valyala/fasthttp"
+)
+
+// newKeyServer answers with the status and body registered for the API key of a request.
+func newKeyServer(t *testing.T, seen *[]string, answers map[string]struct {
+	status int
+	body   string
+},
+) string {
+	t.Helper()
+
+	var mu sync.Mutex
+
+	return newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		key := r.URL.Query().Get("apikey")
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			key = strings.TrimPrefix(auth, "apikey ")
+		}
+
+		mu.Lock()
+		*seen = append(*seen, key)
+		mu.Unlock()
+
+		answer := answers[key]
+
+		w.Header().Set("Api-credits-left", "7")
+		w.Header().Set("Api-credits-used", "1")
+		w.WriteHeader(answer.status)
+
+		if _, err := w.Write([]byte(answer.body)); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func newTestKeyPool(t *testing.T, now *time.Time, keys ...string) *APIKeyPool {
+	t.Helper()
+
+	pool, err := NewAPIKeyPool(keys)
+	if err != nil {
+		t.Fatalf("NewAPIKeyPool() error = %v", err)
+	}
+
+	pool.now = func() time.Time { return *now }
+	pool.pick = func(int64) int64 { return 0 } // always the first available key
+
+	return pool
+}
+
+const rateLimitedBody = `{"code":429,"message":"You have run out of API credits for the current minute.","status":"error"}`
+
+func TestAPIKeyPool_RotatesOnFailure(t *testing.T) {
+	var seen []string
+
+	serverURL := newKeyServer(t, &seen, map[string]struct {
+		status int
+		body   string
+	}{
+		"limited-key": {status: http.StatusTooManyRequests, body: rateLimitedBody},
+		"good-key":    {status: http.StatusOK, body: `{"symbol":"AAPL"}`},
+	})
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	pool := newTestKeyPool(t, &now, "limited-key", "good-key")
+	cli := newTestClient(t, serverURL, quoteConf, WithAPIKeyPool(pool))
+
+	quote, creds, err := cli.GetQuote(request.GetQuote{APIKey: request.APIKey{APIKey: "ignored"}, Symbol: "AAPL"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if quote.Symbol != "AAPL" || creds.GetCreditsLeft() != 7 {
+		t.Fatalf("unexpected result: %+v, %+v", quote, creds)
+	}
+
+	if strings.Join(seen, ",") != "limited-key,good-key" {
+		t.Fatalf("unexpected keys sent: %v", seen)
+	}
+
+	stats := pool.Stats()
+	if stats[0].Key != "****-key" || stats[0].Failures != 1 || stats[0].LastError != "InsufficientCreditsError" ||
+		!stats[0].CooldownUntil.Equal(now.Add(time.Minute)) {
+		t.Errorf("unexpected stats of the limited key: %+v", stats[0])
+	}
+
+	if stats[1].Calls != 1 || stats[1].Failures != 0 || stats[1].CreditsUsed != 1 || stats[1].CreditsLeft != 7 {
+		t.Errorf("unexpected stats of the good key: %+v", stats[1])
+	}
+
+	// The limited key stays on cooldown.
+	if _, _, err := cli.GetQuote(request.GetQuote{Symbol: "AAPL"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if seen[len(seen)-1] != "good-key" || len(seen) != 3 {
+		t.Fatalf("expected the good key only, got %v", seen)
+	}
+}
+
+func TestAPIKeyPool_ChargesEveryKeyAttempt(t *testing.T) {
+	var seen []string
+
+	serverURL := newKeyServer(t, &seen, map[string]struct {
+		status int
+		body   string
+	}{
+		"limited-key": {status: http.StatusTooManyRequests, body: rateLimitedBody},
+		"good-key":    {status: http.StatusOK, body: `{"symbol":"AAPL"}`},
+	})
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ledger := NewCreditLedger()
+	limiter := NewCreditLimiter(10, LimiterFailFast)
+
+	cli := newTestClient(t, serverURL, quoteConf,
+		WithAPIKeyPool(newTestKeyPool(t, &now, "limited-key", "good-key")),
+		WithCreditLedger(ledger),
+		WithCreditLimiter(limiter),
+	)
+
+	if _, _, err := cli.GetQuote(request.GetQuote{Symbol: "AAPL"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(seen) != 2 {
+		t.Fatalf("expected 2 upstream requests, got %v", seen)
+	}
+
+	if got := ledger.Snapshot().Total; got != (CreditUsage{Calls: 2, Credits: 2}) {
+		t.Errorf("expected the ledger to record every key attempt, got %+v", got)
+	}
+
+	if got := limiter.Available(); got != 10-2*dictionary.Quote {
+		t.Errorf("expected the limiter to charge every key attempt, got %d credits available", got)
+	}
+}
+
+func TestAPIKeyPool_Exhausted(t *testing.T) {
+	var seen []string
+
+	serverURL := newKeyServer(t, &seen, map[string]struct {
+		status int
+		body   string
+	}{
+		"key-one": {status: http.StatusTooManyRequests, body: rateLimitedBody},
+		"key-two": {
+			status: http.StatusOK,
+			body:   `{"code":401,"message":"**apikey** parameter is incorrect or not specified.","status":"error"}`,
+		},
+	})
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	pool := newTestKeyPool(t, &now, "key-one", "key-two")
+	cli := newTestClient(t, serverURL, quoteConf, WithAPIKeyPool(pool))
+
+	// Every key failed, the last response is returned.
+	if _, _, err := cli.GetQuote(request.GetQuote{Symbol: "AAPL"}); err == nil || len(seen) != 2 {
+		t.Fatalf("expected the last key error after 2 requests, got %v after %d", err, len(seen))
+	}
+
+	now = now.Add(30 * time.Second)
+
+	_, _, err := cli.GetQuote(request.GetQuote{Symbol: "AAPL"})
+	if !IsAPIKeyPoolExhaustedError(err) || !strings.Contains(err.Error(), "retry after 30s") {
+		t.Fatalf("expected APIKeyPoolExhaustedError, got %v", err)
+	}
+
+	if len(seen) != 2 {
+		t.Fatalf("expected no request while all keys cool down, got %d", len(seen))
+	}
+
+	now = now.Add(30 * time.Second)
+
+	if _, _, err := cli.GetQuote(request.GetQuote{Symbol: "AAPL"}); IsAPIKeyPoolExhaustedError(err) {
+		t.Fatalf("expected the keys to be available after the cooldown, got %v", err)
+	}
+}
+
+func TestAPIKeyPool_HeaderAuth(t *testing.T) {
+	serverURL := mockServerWithRequest(t, http.StatusOK, 100, 1, `{"symbol":"AAPL"}`, expectedRequest{
+		URL:     "/quote?symbol=AAPL",
+		Headers: map[string]string{"Authorization": "apikey pool-key"},
+	})
+
+	now := time.Now()
+
+	conf := quoteConf
+	conf.APIKeyInHeader = true
+
+	cli := newTestClient(t, serverURL, conf, WithAPIKeyPool(newTestKeyPool(t, &now, "pool-key")))
+
+	if _, _, err := cli.GetQuote(request.GetQuote{APIKey: request.APIKey{APIKey: "own-key"}, Symbol: "AAPL"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestAPIKeyPool_WeightedByCreditsLeft(t *testing.T) {
+	now := time.Now()
+	pool := newTestKeyPool(t, &now, "key-a", "key-b", "key-c")
+
+	pool.report("key-a", 0, 9, true, nil, 0)
+	pool.report("key-b", 0, 0, true, nil, 0)
+
+	// Weights: key-a 10, key-b 1, key-c (unknown) 10.
+	tests := []struct {
+		pick int64
+		want string
+	}{
+		{pick: 0, want: "key-a"},
+		{pick: 9, want: "key-a"},
+		{pick: 10, want: "key-b"},
+		{pick: 11, want: "key-c"},
+		{pick: 20, want: "key-c"},
+	}
+
+	for _, tt := range tests {
+		var total int64
+
+		pool.pick = func(n int64) int64 {
+			total = n
+
+			return tt.pick
+		}
+
+		got, err := pool.acquire(nil)
+		if err != nil || got != tt.want || total != 21 {
+			t.Errorf("pick %d: got %q (total %d, err %v), want %q", tt.pick, got, total, err, tt.want)
+		}
+	}
+}
+
+func TestKeyFailure(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   string
+	}{
+		{name: "rate limited", status: http.StatusTooManyRequests, body: `{"status":"error"}`, want: "TooManyRequestsError"},
+		{name: "run out of credits", status: http.StatusOK, body: rateLimitedBody, want: "InsufficientCreditsError"},
+		{name: "invalid key", status: http.StatusOK, body: `{"code":401,"message":"Invalid API key","status":"error"}`, want: "APIKeyError"},
+		{name: "unauthorized", status: http.StatusUnauthorized, body: `{}`, want: "UnauthorizedError"},
+		{name: "symbol not found", status: http.StatusOK, body: `{"code":404,"message":"**symbol** not found: X.","status":"error"}`},
+		{name: "success", status: http.StatusOK, body: `{"symbol":"AAPL"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := fasthttp.AcquireResponse()
+			defer fasthttp.ReleaseResponse(resp)
+
+			resp.SetStatusCode(tt.status)
+			resp.SetBodyString(tt.body)
+
+			if got := ErrorClass(keyFailure(resp)); got != tt.want {
+				t.Errorf("keyFailure() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewAPIKeyPool_NoKeys(t *testing.T) {
+	if _, err := NewAPIKeyPool([]string{"", ""}); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
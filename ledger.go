@@ -0,0 +1,235 @@
+package twelvedata
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+)
+
+// CreditUsage holds the number of calls and credits recorded for one ledger scope.
+type CreditUsage struct {
+	Calls   int64
+	Credits int64
+}
+
+// CreditLedgerSnapshot is a point-in-time copy of the ledger counters for the current UTC day.
+type CreditLedgerSnapshot struct {
+	Day       time.Time
+	Total     CreditUsage
+	Endpoints map[string]CreditUsage // keyed by endpoint path, e.g. "/time_series"
+	Tags      map[string]CreditUsage // keyed by the tag set with WithCreditTag, untagged calls use ""
+	// CreditsLeft is the last Api-credits-left value seen, -1 before the first response.
+	CreditsLeft int64
+}
+
+// CreditThresholdEvent is passed to a threshold callback when creditsLeft drops below its threshold.
+type CreditThresholdEvent struct {
+	Threshold   int64
+	CreditsLeft int64
+	Endpoint    string
+	Tag         string
+}
+
+// CreditLedgerOption configures a CreditLedger.
+type CreditLedgerOption func(*CreditLedger)
+
+// WithDailyCreditCap rejects calls locally once the ledger recorded credits credits in the current UTC day.
+func WithDailyCreditCap(credits int64) CreditLedgerOption {
+	return func(l *CreditLedger) {
+		l.dailyCap = credits
+	}
+}
+
+// WithTagDailyCreditCap rejects calls tagged with tag once the tag used credits credits in the current UTC day.
+func WithTagDailyCreditCap(tag string, credits int64) CreditLedgerOption {
+	return func(l *CreditLedger) {
+		l.tagCaps[tag] = credits
+	}
+}
+
+// WithCreditThreshold calls fn when the Api-credits-left header drops below threshold.
+// The callback fires once per crossing and is re-armed when creditsLeft rises to the threshold again.
+// It is called synchronously from the calling goroutine and must not block.
+func WithCreditThreshold(threshold int64, fn func(CreditThresholdEvent)) CreditLedgerOption {
+	return func(l *CreditLedger) {
+		l.thresholds = append(l.thresholds, &creditThreshold{threshold: threshold, fn: fn})
+	}
+}
+
+type creditThreshold struct {
+	threshold int64
+	fn        func(CreditThresholdEvent)
+	fired     bool
+}
+
+// CreditLedger records the credits reported by the Api-credits-used header per endpoint and per tag,
+// enforces daily caps and notifies about a low Api-credits-left value. It is safe for concurrent use.
+// Counters reset at midnight UTC, when Twelve Data resets daily credits.
+type CreditLedger struct {
+	mu  sync.Mutex
+	now func() time.Time
+
+	dailyCap   int64
+	tagCaps    map[string]int64
+	thresholds []*creditThreshold
+
+	day         time.Time
+	total       CreditUsage
+	endpoints   map[string]CreditUsage
+	tags        map[string]CreditUsage
+	creditsLeft int64
+
+	// pending holds the estimated cost of admitted calls that have not been settled yet,
+	// so concurrent calls cannot overshoot a cap together.
+	pending     int64
+	pendingTags map[string]int64
+}
+
+// NewCreditLedger creates an empty ledger.
+func NewCreditLedger(opts ...CreditLedgerOption) *CreditLedger {
+	l := &CreditLedger{
+		now:         time.Now,
+		tagCaps:     map[string]int64{},
+		endpoints:   map[string]CreditUsage{},
+		tags:        map[string]CreditUsage{},
+		pendingTags: map[string]int64{},
+		creditsLeft: -1,
+	}
+
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	l.day = startOfDay(l.now())
+
+	return l
+}
+
+type creditTagKey struct{}
+
+// WithCreditTag returns a context that attributes the credits of calls made with it to tag,
+// for example a team or job name.
+func WithCreditTag(ctx context.Context, tag string) context.Context {
+	return context.WithValue(ctx, creditTagKey{}, tag)
+}
+
+// CreditTagFromContext returns the tag set with WithCreditTag, or an empty string.
+func CreditTagFromContext(ctx context.Context) string {
+	tag, _ := ctx.Value(creditTagKey{}).(string)
+
+	return tag
+}
+
+// Snapshot returns a copy of the counters for the current UTC day.
+func (l *CreditLedger) Snapshot() CreditLedgerSnapshot {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rollover()
+
+	return CreditLedgerSnapshot{
+		Day:         l.day,
+		Total:       l.total,
+		Endpoints:   maps.Clone(l.endpoints),
+		Tags:        maps.Clone(l.tags),
+		CreditsLeft: l.creditsLeft,
+	}
+}
+
+// admit checks the estimated cost of a call against the daily caps and holds it until settle.
+func (l *CreditLedger) admit(tag string, cost int64) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rollover()
+
+	if l.dailyCap > 0 {
+		if used := l.total.Credits + l.pending; used >= l.dailyCap || used+cost > l.dailyCap {
+			return &CreditCapExceededError{Cap: l.dailyCap, Used: used, Cost: cost}
+		}
+	}
+
+	if limit, ok := l.tagCaps[tag]; ok {
+		if used := l.tags[tag].Credits + l.pendingTags[tag]; used >= limit || used+cost > limit {
+			return &CreditCapExceededError{Tag: tag, Cap: limit, Used: used, Cost: cost}
+		}
+	}
+
+	l.pending += cost
+	l.pendingTags[tag] += cost
+
+	return nil
+}
+
+// settle releases the cost held by admit and records the credits of a call that got a response.
+// creditsLeft is negative when the response had no Api-credits-left header.
+func (l *CreditLedger) settle(endpoint, tag string, held int64, responded bool, creditsUsed, creditsLeft int64) {
+	l.mu.Lock()
+
+	l.pending -= held
+	l.pendingTags[tag] -= held
+
+	if l.pendingTags[tag] == 0 {
+		delete(l.pendingTags, tag)
+	}
+
+	if !responded {
+		l.mu.Unlock()
+
+		return
+	}
+
+	l.rollover()
+	l.total = addUsage(l.total, creditsUsed)
+	l.endpoints[endpoint] = addUsage(l.endpoints[endpoint], creditsUsed)
+	l.tags[tag] = addUsage(l.tags[tag], creditsUsed)
+
+	var fire []*creditThreshold
+
+	if creditsLeft >= 0 {
+		l.creditsLeft = creditsLeft
+
+		for _, threshold := range l.thresholds {
+			switch {
+			case creditsLeft < threshold.threshold && !threshold.fired:
+				threshold.fired = true
+				fire = append(fire, threshold)
+			case creditsLeft >= threshold.threshold:
+				threshold.fired = false
+			}
+		}
+	}
+
+	l.mu.Unlock()
+
+	for _, threshold := range fire {
+		threshold.fn(CreditThresholdEvent{
+			Threshold:   threshold.threshold,
+			CreditsLeft: creditsLeft,
+			Endpoint:    endpoint,
+			Tag:         tag,
+		})
+	}
+}
+
+// rollover resets the daily counters when the UTC day changed. Callers must hold l.mu.
+func (l *CreditLedger) rollover() {
+	if day := startOfDay(l.now()); day.After(l.day) {
+		l.day = day
+		l.total = CreditUsage{}
+		l.endpoints = map[string]CreditUsage{}
+		l.tags = map[string]CreditUsage{}
+	}
+}
+
+func addUsage(usage CreditUsage, credits int64) CreditUsage {
+	usage.Calls++
+	usage.Credits += credits
+
+	return usage
+}
+
+func startOfDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
@@ -0,0 +1,240 @@
+package twelvedata
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/soulgarden/twelvedata/request"
+)
+
+// newCreditsServer replies with Api-credits-used: used and a decreasing Api-credits-left starting at left.
+func newCreditsServer(t *testing.T, calls *atomic.Int32, used, left int64) string {
+	t.Helper()
+
+	return newTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		call := int64(calls.Add(1))
+
+		w.Header().Set("Api-credits-used", strconv.FormatInt(used, 10))
+		w.Header().Set("Api-credits-left", strconv.FormatInt(left-call*used, 10))
+
+		if _, err := w.Write([]byte(`{"meta":{"symbol":"AAPL"}}`)); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+var ledgerConf = Conf{Fundamentals: Fundamentals{ProfileURL: "/profile", LogoURL: "/logo"}}
+
+func TestCreditLedger_Accounting(t *testing.T) {
+	var calls atomic.Int32
+
+	serverURL := newCreditsServer(t, &calls, 10, 1000)
+	ledger := NewCreditLedger()
+	cli := newTestClient(t, serverURL, ledgerConf, WithCreditLedger(ledger))
+
+	ctx := WithCreditTag(context.Background(), "research")
+
+	if _, _, err := cli.GetProfileCtx(ctx, request.GetProfile{Symbol: "AAPL"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, _, err := cli.GetProfile(request.GetProfile{Symbol: "AAPL"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, _, err := cli.GetLogoCtx(ctx, request.GetLogo{Symbol: "AAPL"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	snapshot := ledger.Snapshot()
+
+	if snapshot.Total != (CreditUsage{Calls: 3, Credits: 30}) {
+		t.Errorf("unexpected total: %+v", snapshot.Total)
+	}
+
+	if got := snapshot.Endpoints["/profile"]; got != (CreditUsage{Calls: 2, Credits: 20}) {
+		t.Errorf("unexpected /profile usage: %+v", got)
+	}
+
+	if got := snapshot.Endpoints["/logo"]; got != (CreditUsage{Calls: 1, Credits: 10}) {
+		t.Errorf("unexpected /logo usage: %+v", got)
+	}
+
+	if got := snapshot.Tags["research"]; got != (CreditUsage{Calls: 2, Credits: 20}) {
+		t.Errorf("unexpected research usage: %+v", got)
+	}
+
+	if got := snapshot.Tags[""]; got != (CreditUsage{Calls: 1, Credits: 10}) {
+		t.Errorf("unexpected untagged usage: %+v", got)
+	}
+
+	if snapshot.CreditsLeft != 970 {
+		t.Errorf("unexpected credits left: %d", snapshot.CreditsLeft)
+	}
+
+	// The snapshot is a copy.
+	snapshot.Endpoints["/profile"] = CreditUsage{}
+	if ledger.Snapshot().Endpoints["/profile"].Calls != 2 {
+		t.Error("snapshot shares state with the ledger")
+	}
+}
+
+func TestCreditLedger_DailyCap(t *testing.T) {
+	var calls atomic.Int32
+
+	serverURL := newCreditsServer(t, &calls, 10, 1000)
+	ledger := NewCreditLedger(WithDailyCreditCap(25))
+	cli := newTestClient(t, serverURL, ledgerConf, WithCreditLedger(ledger))
+
+	for range 2 {
+		if _, _, err := cli.GetProfile(request.GetProfile{Symbol: "AAPL"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	_, _, err := cli.GetProfile(request.GetProfile{Symbol: "AAPL"})
+	if !IsCreditCapExceededError(err) {
+		t.Fatalf("expected CreditCapExceededError, got %v", err)
+	}
+
+	if !IsInsufficientCreditsError(err) {
+		t.Fatalf("expected the cap error to match InsufficientCreditsError, got %v", err)
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected 2 requests to reach the server, got %d", got)
+	}
+}
+
+func TestCreditLedger_TagCap(t *testing.T) {
+	var calls atomic.Int32
+
+	serverURL := newCreditsServer(t, &calls, 10, 1000)
+	ledger := NewCreditLedger(WithTagDailyCreditCap("batch-job", 10))
+	cli := newTestClient(t, serverURL, ledgerConf, WithCreditLedger(ledger))
+
+	ctx := WithCreditTag(context.Background(), "batch-job")
+
+	if _, _, err := cli.GetProfileCtx(ctx, request.GetProfile{Symbol: "AAPL"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, _, err := cli.GetProfileCtx(ctx, request.GetProfile{Symbol: "AAPL"})
+	if !IsCreditCapExceededError(err) {
+		t.Fatalf("expected CreditCapExceededError, got %v", err)
+	}
+
+	// Other tags are not affected.
+	if _, _, err := cli.GetProfile(request.GetProfile{Symbol: "AAPL"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCreditLedger_ConcurrentCallsRespectCap(t *testing.T) {
+	ledger := NewCreditLedger(WithDailyCreditCap(100))
+
+	var (
+		wg       sync.WaitGroup
+		admitted atomic.Int32
+	)
+
+	for range 50 {
+		wg.Go(func() {
+			if err := ledger.admit("", 10); err != nil {
+				return
+			}
+
+			admitted.Add(1)
+			ledger.settle("/profile", "", 10, true, 10, -1)
+		})
+	}
+
+	wg.Wait()
+
+	if got := admitted.Load(); got != 10 {
+		t.Fatalf("expected 10 admitted calls, got %d", got)
+	}
+
+	if got := ledger.Snapshot().Total.Credits; got != 100 {
+		t.Fatalf("expected 100 credits, got %d", got)
+	}
+}
+
+func TestCreditLedger_Thresholds(t *testing.T) {
+	var events []CreditThresholdEvent
+
+	ledger := NewCreditLedger(
+		WithCreditThreshold(50, func(event CreditThresholdEvent) { events = append(events, event) }),
+		WithCreditThreshold(10, func(event CreditThresholdEvent) { events = append(events, event) }),
+	)
+
+	for _, left := range []int64{80, 45, 40, 5, 100, 30} {
+		ledger.settle("/quote", "live", 0, true, 1, left)
+	}
+
+	want := []CreditThresholdEvent{
+		{Threshold: 50, CreditsLeft: 45, Endpoint: "/quote", Tag: "live"},
+		{Threshold: 10, CreditsLeft: 5, Endpoint: "/quote", Tag: "live"},
+		{Threshold: 50, CreditsLeft: 30, Endpoint: "/quote", Tag: "live"},
+	}
+
+	if len(events) != len(want) {
+		t.Fatalf("expected %d events, got %+v", len(want), events)
+	}
+
+	for i := range want {
+		if events[i] != want[i] {
+			t.Errorf("event %d = %+v, want %+v", i, events[i], want[i])
+		}
+	}
+}
+
+func TestCreditLedger_DailyReset(t *testing.T) {
+	now := time.Date(2024, 3, 1, 23, 59, 0, 0, time.UTC)
+
+	ledger := NewCreditLedger(WithDailyCreditCap(10))
+	ledger.now = func() time.Time { return now }
+	ledger.day = startOfDay(now)
+
+	if err := ledger.admit("", 10); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ledger.settle("/profile", "", 10, true, 10, -1)
+
+	if err := ledger.admit("", 1); !IsCreditCapExceededError(err) {
+		t.Fatalf("expected CreditCapExceededError, got %v", err)
+	}
+
+	now = now.Add(2 * time.Minute)
+
+	if err := ledger.admit("", 10); err != nil {
+		t.Fatalf("expected the cap to reset at midnight UTC, got %v", err)
+	}
+
+	if snapshot := ledger.Snapshot(); !snapshot.Day.Equal(time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)) || snapshot.Total.Calls != 0 {
+		t.Fatalf("unexpected snapshot after reset: %+v", snapshot)
+	}
+}
+
+func TestCreditLedger_FailedTransportReleasesHold(t *testing.T) {
+	ledger := NewCreditLedger(WithDailyCreditCap(10))
+	cli := newTestClient(t, "http://127.0.0.1:1", ledgerConf, WithCreditLedger(ledger))
+
+	if _, _, err := cli.GetProfile(request.GetProfile{Symbol: "AAPL"}); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if err := ledger.admit("", 10); err != nil {
+		t.Fatalf("expected the failed call to release its hold, got %v", err)
+	}
+
+	if snapshot := ledger.Snapshot(); snapshot.Total.Calls != 0 {
+		t.Fatalf("expected no recorded calls, got %+v", snapshot.Total)
+	}
+}
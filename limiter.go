@@ -0,0 +1,89 @@
+package twelvedata
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// LimiterMode defines what CreditLimiter does when not enough credits are available.
+type LimiterMode int
+
+const (
+	// LimiterWait blocks the call until enough credits are free or the context is done.
+	LimiterWait LimiterMode = iota
+	// LimiterFailFast returns a CreditLimitExceededError immediately.
+	LimiterFailFast
+)
+
+// CreditLimiter paces requests by their credit cost so bursts stay within the plan's
+// credits-per-minute limit instead of running into 429 responses.
+// Credits are replenished continuously at creditsPerMinute/60 per second and at most
+// creditsPerMinute credits can be spent at once.
+type CreditLimiter struct {
+	limiter *rate.Limiter
+	mode    LimiterMode
+}
+
+// NewCreditLimiter creates a limiter for the given plan limit and mode.
+func NewCreditLimiter(creditsPerMinute int, mode LimiterMode) *CreditLimiter {
+	return &CreditLimiter{
+		limiter: rate.NewLimiter(rate.Limit(float64(creditsPerMinute)/time.Minute.Seconds()), creditsPerMinute),
+		mode:    mode,
+	}
+}
+
+// Reserve takes cost credits from the limiter, waiting or failing according to the limiter mode.
+// A cost above the per-minute limit can never be satisfied and always fails.
+func (l *CreditLimiter) Reserve(ctx context.Context, cost int64) error {
+	if cost <= 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	reservation := l.limiter.ReserveN(now, int(cost))
+	if !reservation.OK() {
+		return &CreditLimitExceededError{
+			Cost:    cost,
+			Limit:   int64(l.limiter.Burst()),
+			Message: "request cost exceeds the per-minute credit limit",
+		}
+	}
+
+	delay := reservation.DelayFrom(now)
+	if delay <= 0 {
+		return nil
+	}
+
+	if l.mode == LimiterFailFast {
+		reservation.CancelAt(now)
+
+		return &CreditLimitExceededError{
+			Cost:       cost,
+			Limit:      int64(l.limiter.Burst()),
+			RetryAfter: delay,
+			Message:    "not enough credits available",
+		}
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && deadline.Before(now.Add(delay)) {
+		reservation.CancelAt(now)
+
+		return context.DeadlineExceeded
+	}
+
+	if err := sleepCtx(ctx, delay); err != nil {
+		reservation.Cancel()
+
+		return err
+	}
+
+	return nil
+}
+
+// Available returns the number of credits that can be spent right now.
+func (l *CreditLimiter) Available() int64 {
+	return int64(l.limiter.Tokens())
+}
@@ -0,0 +1,193 @@
+package twelvedata
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/soulgarden/twelvedata/dictionary"
+	"github.com/soulgarden/twelvedata/request"
+)
+
+func newTestCostRegistry() *costRegistry {
+	registry := &costRegistry{}
+	registry.register("/time_series", dictionary.TimeSeries)
+	registry.register("/profile", dictionary.Profile)
+	registry.register("/market_movers/{market}", dictionary.MarketMovers)
+
+	return registry
+}
+
+func TestCostRegistry_estimate(t *testing.T) {
+	registry := newTestCostRegistry()
+
+	tests := []struct {
+		name   string
+		base   int64
+		req    any
+		values url.Values
+		want   int64
+	}{
+		{
+			name:   "single symbol",
+			base:   dictionary.Profile,
+			req:    request.GetProfile{},
+			values: url.Values{"symbol": {"AAPL"}},
+			want:   10,
+		},
+		{
+			name:   "multi symbol",
+			base:   dictionary.Profile,
+			req:    request.GetProfile{},
+			values: url.Values{"symbol": {"AAPL,MSFT, TSLA"}},
+			want:   30,
+		},
+		{
+			name:   "no symbol",
+			base:   dictionary.Stocks,
+			req:    request.GetStock{},
+			values: url.Values{},
+			want:   1,
+		},
+		{
+			name: "batch",
+			base: dictionary.Batches,
+			req: request.GetBatches{Requests: map[string]request.BatchRequest{
+				"a": {URL: "/time_series?symbol=AAPL,MSFT&interval=1min"},
+				"b": {URL: "/profile?symbol=AAPL"},
+				"c": {URL: "/market_movers/stocks"},
+				"d": {URL: "/unknown?symbol=AAPL"},
+			}},
+			values: url.Values{},
+			want:   2 + 10 + 100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := registry.estimate(tt.base, tt.req, tt.values); got != tt.want {
+				t.Errorf("estimate() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreditLimiter_FailFast(t *testing.T) {
+	limiter := NewCreditLimiter(10, LimiterFailFast)
+
+	if err := limiter.Reserve(context.Background(), 8); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err := limiter.Reserve(context.Background(), 5)
+	if !IsCreditLimitExceededError(err) {
+		t.Fatalf("expected CreditLimitExceededError, got %v", err)
+	}
+
+	limitErr := err.(*CreditLimitExceededError) //nolint:errorlint,forcetypeassert
+	if limitErr.RetryAfter <= 0 {
+		t.Fatalf("expected positive RetryAfter, got %s", limitErr.RetryAfter)
+	}
+
+	// The rejected reservation must not consume credits.
+	if err := limiter.Reserve(context.Background(), 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCreditLimiter_CostAboveLimit(t *testing.T) {
+	limiter := NewCreditLimiter(10, LimiterWait)
+
+	err := limiter.Reserve(context.Background(), 11)
+	if !IsCreditLimitExceededError(err) {
+		t.Fatalf("expected CreditLimitExceededError, got %v", err)
+	}
+}
+
+func TestCreditLimiter_Wait(t *testing.T) {
+	limiter := NewCreditLimiter(600, LimiterWait) // 10 credits per second
+
+	if err := limiter.Reserve(context.Background(), 600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	start := time.Now()
+
+	if err := limiter.Reserve(context.Background(), 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Fatalf("expected to wait for credits, waited %s", elapsed)
+	}
+}
+
+func TestCreditLimiter_WaitRespectsDeadline(t *testing.T) {
+	limiter := NewCreditLimiter(60, LimiterWait) // 1 credit per second
+
+	if err := limiter.Reserve(context.Background(), 60); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	if err := limiter.Reserve(ctx, 30); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("reserve ignored the context deadline, took %s", elapsed)
+	}
+}
+
+func TestClient_CreditLimiter(t *testing.T) {
+	var calls atomic.Int32
+
+	serverURL := newTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+
+		if _, err := w.Write([]byte(`{"meta":{"symbol":"AAPL"}}`)); err != nil {
+			t.Error(err)
+		}
+	})
+
+	cli := newTestClient(t, serverURL, profileConf, WithCreditLimiter(NewCreditLimiter(25, LimiterFailFast)))
+
+	if _, _, err := cli.GetProfile(request.GetProfile{Symbol: "AAPL"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, _, err := cli.GetProfile(request.GetProfile{Symbol: "AAPL"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, _, err := cli.GetProfile(request.GetProfile{Symbol: "AAPL"}); !IsCreditLimitExceededError(err) {
+		t.Fatalf("expected CreditLimitExceededError, got %v", err)
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected 2 requests to reach the server, got %d", got)
+	}
+}
+
+func TestClient_CreditLimiter_DeadlineWhileWaiting(t *testing.T) {
+	limiter := NewCreditLimiter(10, LimiterWait)
+	cli := newTestClient(t, "http://127.0.0.1:1", profileConf, WithCreditLimiter(limiter))
+
+	if err := limiter.Reserve(context.Background(), 10); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, _, err := cli.GetProfileCtx(ctx, request.GetProfile{Symbol: "AAPL"}); !IsTimeoutError(err) {
+		t.Fatalf("expected TimeoutError, got %v", err)
+	}
+}
@@ -0,0 +1,194 @@
+package twelvedata
+
+import (
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+// metricsNamespace prefixes every metric name.
+const metricsNamespace = "twelvedata"
+
+// Metrics is a prometheus.Collector with REST client and WebSocket health metrics.
+// Register it once and pass it to WithMetrics and WithWSMetrics. A nil *Metrics records nothing.
+type Metrics struct {
+	requests    *prometheus.CounterVec
+	latency     *prometheus.HistogramVec
+	errors      *prometheus.CounterVec
+	creditsUsed *prometheus.GaugeVec
+	creditsLeft prometheus.Gauge
+
+	wsEvents     *prometheus.CounterVec
+	wsDropped    *prometheus.CounterVec
+	wsReconnects prometheus.Counter
+	wsConnected  prometheus.Gauge
+	wsEverUp     atomic.Bool
+}
+
+// NewMetrics creates the collector. Latency buckets default to prometheus.DefBuckets when buckets is empty.
+func NewMetrics(buckets ...float64) *Metrics {
+	if len(buckets) == 0 {
+		buckets = prometheus.DefBuckets
+	}
+
+	return &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "requests_total",
+			Help:      "Number of API requests by endpoint path and HTTP status code.",
+		}, []string{"endpoint", "code"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "request_duration_seconds",
+			Help:      "API call latency by endpoint path, including rate limiting and retries.",
+			Buckets:   buckets,
+		}, []string{"endpoint"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "errors_total",
+			Help:      "Number of failed API calls by endpoint path and error class.",
+		}, []string{"endpoint", "class"}),
+		creditsUsed: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "credits_used",
+			Help:      "Last Api-credits-used value reported for an endpoint path.",
+		}, []string{"endpoint"}),
+		creditsLeft: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "credits_left",
+			Help:      "Last Api-credits-left value reported by the API.",
+		}),
+		wsEvents: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "ws",
+			Name:      "events_total",
+			Help:      "Number of WebSocket events received by event type.",
+		}, []string{"type"}),
+		wsDropped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "ws",
+			Name:      "events_dropped_total",
+			Help:      "Number of WebSocket events dropped because the event channel was full or closed.",
+		}, []string{"type"}),
+		wsReconnects: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "ws",
+			Name:      "reconnects_total",
+			Help:      "Number of successful WebSocket connects after an earlier connection was established.",
+		}),
+		wsConnected: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "ws",
+			Name:      "connected",
+			Help:      "Number of open WebSocket connections.",
+		}),
+	}
+}
+
+// WithMetrics records request, error and credit metrics of every endpoint call in metrics.
+func WithMetrics(metrics *Metrics) HTTPCliOption {
+	return func(c *HTTPCli) {
+		c.metrics = metrics
+	}
+}
+
+// WithWSMetrics records WebSocket event and connection metrics in metrics.
+func WithWSMetrics(metrics *Metrics) WSOption {
+	return func(ws *WS) {
+		ws.metrics = metrics
+	}
+}
+
+func (m *Metrics) collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		m.requests, m.latency, m.errors, m.creditsUsed, m.creditsLeft,
+		m.wsEvents, m.wsDropped, m.wsReconnects, m.wsConnected,
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	for _, collector := range m.collectors() {
+		collector.Describe(ch)
+	}
+}
+
+// Collect implements prometheus.Collector.
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	for _, collector := range m.collectors() {
+		collector.Collect(ch)
+	}
+}
+
+// observeCall records one endpoint call. statusCode is zero when no response was received.
+func (m *Metrics) observeCall(endpoint string, statusCode int, duration time.Duration, creds response.Credits, creditsLeftKnown bool, err error) {
+	if m == nil {
+		return
+	}
+
+	code := "none"
+	if statusCode > 0 {
+		code = strconv.Itoa(statusCode)
+	}
+
+	m.requests.WithLabelValues(endpoint, code).Inc()
+	m.latency.WithLabelValues(endpoint).Observe(duration.Seconds())
+
+	if err != nil {
+		m.errors.WithLabelValues(endpoint, ErrorClass(err)).Inc()
+	}
+
+	if creds != nil && statusCode > 0 {
+		m.creditsUsed.WithLabelValues(endpoint).Set(float64(creds.GetCreditsUsed()))
+
+		if creditsLeftKnown {
+			m.creditsLeft.Set(float64(creds.GetCreditsLeft()))
+		}
+	}
+}
+
+// wsEvent records a received WebSocket event and whether it was delivered to its channel.
+func (m *Metrics) wsEvent(eventType response.WSEventType, delivered bool) {
+	if m == nil {
+		return
+	}
+
+	label := string(eventType)
+
+	switch eventType {
+	case response.WSEventPrice, response.WSEventSubscribeStatus, response.WSEventError:
+	default:
+		label = "unknown"
+	}
+
+	m.wsEvents.WithLabelValues(label).Inc()
+
+	if !delivered {
+		m.wsDropped.WithLabelValues(label).Inc()
+	}
+}
+
+// wsConnect records an established WebSocket connection.
+func (m *Metrics) wsConnect() {
+	if m == nil {
+		return
+	}
+
+	if m.wsEverUp.Swap(true) {
+		m.wsReconnects.Inc()
+	}
+
+	m.wsConnected.Inc()
+}
+
+// wsDisconnect records a closed WebSocket connection.
+func (m *Metrics) wsDisconnect() {
+	if m == nil {
+		return
+	}
+
+	m.wsConnected.Dec()
+}
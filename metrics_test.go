@@ -0,0 +1,182 @@
+package twelvedata
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fasthttp/websocket"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/rs/zerolog"
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+func TestMetrics_Register(t *testing.T) {
+	registry := prometheus.NewPedanticRegistry()
+
+	if err := registry.Register(NewMetrics()); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+}
+
+func TestMetrics_EndpointCalls(t *testing.T) {
+	okURL := mockServerWithURL(t, http.StatusOK, 95, 5, `{"symbol":"AAPL"}`, "/quote?symbol=AAPL")
+	notFoundURL := mockServerWithURL(t, http.StatusOK, 94, 1,
+		`{"code":404,"message":"**symbol** not found: NOPE. Please specify it correctly","status":"error"}`, "/quote?symbol=NOPE")
+
+	metrics := NewMetrics()
+
+	for _, serverURL := range []string{okURL, notFoundURL} {
+		cli := newTestClient(t, serverURL, quoteConf, WithMetrics(metrics))
+
+		symbol := "AAPL"
+		if serverURL == notFoundURL {
+			symbol = "NOPE"
+		}
+
+		_, _, _ = cli.GetQuote(request.GetQuote{Symbol: symbol})
+	}
+
+	if got := testutil.ToFloat64(metrics.requests.WithLabelValues("/quote", "200")); got != 2 {
+		t.Errorf("requests_total = %v, want 2", got)
+	}
+
+	if got := testutil.ToFloat64(metrics.errors.WithLabelValues("/quote", "SymbolNotFoundError")); got != 1 {
+		t.Errorf("errors_total = %v, want 1", got)
+	}
+
+	if got := testutil.ToFloat64(metrics.creditsLeft); got != 94 {
+		t.Errorf("credits_left = %v, want 94", got)
+	}
+
+	if got := testutil.ToFloat64(metrics.creditsUsed.WithLabelValues("/quote")); got != 1 {
+		t.Errorf("credits_used = %v, want 1", got)
+	}
+
+	if got := testutil.CollectAndCount(metrics.latency); got != 1 {
+		t.Errorf("expected one latency series, got %d", got)
+	}
+}
+
+func TestMetrics_TransportError(t *testing.T) {
+	metrics := NewMetrics()
+
+	cli := newTestClient(t, "http://127.0.0.1:1", quoteConf, WithMetrics(metrics))
+
+	if _, _, err := cli.GetQuote(request.GetQuote{Symbol: "AAPL"}); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if got := testutil.ToFloat64(metrics.requests.WithLabelValues("/quote", "none")); got != 1 {
+		t.Errorf("requests_total = %v, want 1", got)
+	}
+
+	if got := testutil.ToFloat64(metrics.errors.WithLabelValues("/quote", "NetworkError")); got != 1 {
+		t.Errorf("errors_total = %v, want 1", got)
+	}
+}
+
+func TestMetrics_DryRunIsNotRecorded(t *testing.T) {
+	metrics := NewMetrics()
+
+	cli := newTestClient(t, "http://127.0.0.1:1", quoteConf, WithMetrics(metrics))
+
+	ctx, _ := WithDryRun(context.Background())
+	if _, _, err := cli.GetQuoteCtx(ctx, request.GetQuote{Symbol: "AAPL"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := testutil.CollectAndCount(metrics.requests); got != 0 {
+		t.Errorf("expected no request series, got %d", got)
+	}
+}
+
+func TestMetrics_WSEvents(t *testing.T) {
+	metrics := NewMetrics()
+	logger := zerolog.Nop()
+
+	ws := NewWS(&Conf{BaseWSURL: "localhost"}, &logger, nil, WithWSMetrics(metrics))
+	ws.ctx = context.Background()
+	ws.shutdown = make(chan struct{})
+	ws.priceEvents = NewEventChannel[response.WSPriceEvent](1)
+
+	ws.routeMessage([]byte(`{"event":"price","symbol":"AAPL","price":150.25}`))
+	ws.routeMessage([]byte(`{"event":"price","symbol":"AAPL","price":150.30}`))
+	ws.routeMessage([]byte(`{"event":"subscribe-status","status":"ok"}`))
+	ws.routeMessage([]byte(`{"event":"mystery"}`))
+
+	if got := testutil.ToFloat64(metrics.wsEvents.WithLabelValues("price")); got != 2 {
+		t.Errorf("price events = %v, want 2", got)
+	}
+
+	if got := testutil.ToFloat64(metrics.wsDropped.WithLabelValues("price")); got != 1 {
+		t.Errorf("dropped price events = %v, want 1", got)
+	}
+
+	if got := testutil.ToFloat64(metrics.wsEvents.WithLabelValues("subscribe-status")); got != 1 {
+		t.Errorf("status events = %v, want 1", got)
+	}
+
+	if got := testutil.ToFloat64(metrics.wsEvents.WithLabelValues("unknown")); got != 1 {
+		t.Errorf("unknown events = %v, want 1", got)
+	}
+}
+
+func TestMetrics_WSConnectionState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{CheckOrigin: func(_ *http.Request) bool { return true }}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	metrics := NewMetrics()
+	logger := zerolog.Nop()
+	cfg := &Conf{
+		BaseWSURL: strings.TrimPrefix(server.URL, "http://"),
+		WebSocket: WebSocket{PriceURL: "/quotes/price"},
+	}
+
+	for i := range 2 {
+		ws := NewWS(cfg, &logger, nil, WithWSMetrics(metrics))
+		ws.url.Scheme = "ws"
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+
+		if err := ws.Connect(ctx); err != nil {
+			cancel()
+			t.Fatalf("connect %d: %v", i, err)
+		}
+
+		if got := testutil.ToFloat64(metrics.wsConnected); got != 1 {
+			t.Errorf("connected = %v, want 1", got)
+		}
+
+		_ = ws.Close()
+
+		cancel()
+
+		if got := testutil.ToFloat64(metrics.wsConnected); got != 0 {
+			t.Errorf("connected after close = %v, want 0", got)
+		}
+	}
+
+	if got := testutil.ToFloat64(metrics.wsReconnects); got != 1 {
+		t.Errorf("reconnects_total = %v, want 1", got)
+	}
+}
@@ -0,0 +1,193 @@
+package twelvedata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+var errNoSymbols = errors.New("no symbols")
+
+// symbolList trims symbols and drops empty and repeated ones, keeping their order.
+func symbolList(symbols []string) []string {
+	list := make([]string, 0, len(symbols))
+	seen := make(map[string]bool, len(symbols))
+
+	for _, symbol := range symbols {
+		symbol = strings.TrimSpace(symbol)
+		if symbol == "" || seen[symbol] {
+			continue
+		}
+
+		seen[symbol] = true
+
+		list = append(list, symbol)
+	}
+
+	return list
+}
+
+// callSymbols calls endpoint once for req, whose symbol parameter lists symbols, and splits the response
+// per symbol. The API keys the response by symbol unless a single symbol is requested. Errors of single
+// symbols, such as SymbolNotFoundError, are reported in the returned error map.
+func callSymbols[Req any, Resp any](
+	ctx context.Context,
+	endpoint *Endpoint[Req, Resp, response.Credits, error],
+	req Req,
+	symbols []string,
+) (map[string]Resp, map[string]error, response.Credits, error) {
+	raw := &Endpoint[Req, json.RawMessage, response.Credits, error]{
+		httpCli: endpoint.httpCli,
+		URL:     endpoint.URL,
+		path:    endpoint.path,
+		cost:    endpoint.cost,
+	}
+
+	body, creds, err := raw.CallCtx(ctx, req)
+
+	data, errs := map[string]Resp{}, map[string]error{}
+
+	if len(symbols) == 1 {
+		if IsSymbolNotFoundError(err) {
+			errs[symbols[0]] = err
+
+			return data, errs, creds, nil
+		}
+
+		if err != nil || body == nil { // body is nil on dry runs
+			return data, errs, creds, err
+		}
+
+		var resp Resp
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return data, errs, creds, NewError[error](fmt.Errorf("unmarshall json: %w", err), nil)
+		}
+
+		data[symbols[0]] = resp
+
+		return data, errs, creds, nil
+	}
+
+	if err != nil || body == nil {
+		return data, errs, creds, err
+	}
+
+	var keyed map[string]json.RawMessage
+	if err := json.Unmarshal(body, &keyed); err != nil {
+		return data, errs, creds, NewError[error](fmt.Errorf("unmarshall json: %w", err), nil)
+	}
+
+	for _, symbol := range symbols {
+		item, ok := keyed[symbol]
+		if !ok {
+			errs[symbol] = fmt.Errorf("no data for symbol %s", symbol)
+
+			continue
+		}
+
+		if isErrorBody(item) {
+			errs[symbol] = symbolError(item)
+
+			continue
+		}
+
+		var resp Resp
+		if err := json.Unmarshal(item, &resp); err != nil {
+			errs[symbol] = fmt.Errorf("unmarshall json: %w", err)
+
+			continue
+		}
+
+		data[symbol] = resp
+	}
+
+	return data, errs, creds, nil
+}
+
+// symbolError returns the domain error of one symbol of a keyed response, or the QuoteError itself.
+func symbolError(item json.RawMessage) error {
+	var quoteErr response.QuoteError
+	if err := json.Unmarshal(item, &quoteErr); err != nil {
+		return fmt.Errorf("unmarshall json: %w", err)
+	}
+
+	apiError := response.Error{Code: quoteErr.Code, Message: quoteErr.Message, Status: quoteErr.Status}
+	if domainErr := ParseDomainError(&apiError, int(apiError.Code.Int64), ""); domainErr != nil {
+		return domainErr
+	}
+
+	return quoteErr
+}
+
+func (cli client) GetQuotesCtx(ctx context.Context, symbols []string, req request.GetQuote) (response.Quotes, response.Credits, error) {
+	if symbols = symbolList(symbols); len(symbols) == 0 {
+		return response.Quotes{}, nil, errNoSymbols
+	}
+
+	req.Symbol = strings.Join(symbols, ",")
+
+	data, errs, creds, err := callSymbols(ctx, cli.getQuote, req, symbols)
+
+	return response.Quotes{Data: data, Errors: errs}, creds, err
+}
+
+func (cli client) GetPricesCtx(ctx context.Context, symbols []string, req request.GetPrice) (response.Prices, response.Credits, error) {
+	if symbols = symbolList(symbols); len(symbols) == 0 {
+		return response.Prices{}, nil, errNoSymbols
+	}
+
+	req.Symbol = strings.Join(symbols, ",")
+
+	data, errs, creds, err := callSymbols(ctx, cli.getPrice, req, symbols)
+
+	return response.Prices{Data: data, Errors: errs}, creds, err
+}
+
+func (cli client) GetEODsCtx(ctx context.Context, symbols []string, req request.GetEOD) (response.EODs, response.Credits, error) {
+	if symbols = symbolList(symbols); len(symbols) == 0 {
+		return response.EODs{}, nil, errNoSymbols
+	}
+
+	req.Symbol = strings.Join(symbols, ",")
+
+	data, errs, creds, err := callSymbols(ctx, cli.getEOD, req, symbols)
+
+	return response.EODs{Data: data, Errors: errs}, creds, err
+}
+
+func (cli client) GetTimeSeriesMultiCtx(
+	ctx context.Context,
+	symbols []string,
+	req request.GetTimeSeries,
+) (response.TimeSeriesMulti, response.Credits, error) {
+	if symbols = symbolList(symbols); len(symbols) == 0 {
+		return response.TimeSeriesMulti{}, nil, errNoSymbols
+	}
+
+	req.Symbol = strings.Join(symbols, ",")
+
+	data, errs, creds, err := callSymbols(ctx, cli.getTimeSeries, req, symbols)
+
+	return response.TimeSeriesMulti{Data: data, Errors: errs}, creds, err
+}
+
+func (cli client) GetQuotes(symbols []string, req request.GetQuote) (response.Quotes, response.Credits, error) {
+	return cli.GetQuotesCtx(context.Background(), symbols, req)
+}
+
+func (cli client) GetPrices(symbols []string, req request.GetPrice) (response.Prices, response.Credits, error) {
+	return cli.GetPricesCtx(context.Background(), symbols, req)
+}
+
+func (cli client) GetEODs(symbols []string, req request.GetEOD) (response.EODs, response.Credits, error) {
+	return cli.GetEODsCtx(context.Background(), symbols, req)
+}
+
+func (cli client) GetTimeSeriesMulti(symbols []string, req request.GetTimeSeries) (response.TimeSeriesMulti, response.Credits, error) {
+	return cli.GetTimeSeriesMultiCtx(context.Background(), symbols, req)
+}
@@ -0,0 +1,126 @@
+package twelvedata
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+var multiSymbolConf = Conf{
+	CoreData: CoreData{
+		QuotesURL:     "/quote",
+		PriceURL:      "/price",
+		EODURL:        "/eod",
+		TimeSeriesURL: "/time_series",
+	},
+}
+
+func TestGetQuotes(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusOK, 97, 3, `{
+		"AAPL": {"symbol": "AAPL", "close": "190.1"},
+		"XXX": {"code": 404, "message": "**symbol** not found: XXX. Please specify it correctly", "status": "error",
+			"meta": {"symbol": "XXX", "interval": "", "exchange": ""}},
+		"MSFT": {"symbol": "MSFT", "close": "410.5"}
+	}`, "/quote?dp=2&symbol=AAPL%2CXXX%2CMSFT")
+
+	cli := newTestClient(t, serverURL, multiSymbolConf)
+
+	quotes, creds, err := cli.GetQuotes([]string{"AAPL", " XXX", "MSFT", "AAPL", ""}, request.GetQuote{DecimalPlaces: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(quotes.Data) != 2 || quotes.Data["AAPL"].Close != "190.1" || quotes.Data["MSFT"].Close != "410.5" {
+		t.Errorf("unexpected quotes: %+v", quotes.Data)
+	}
+
+	if len(quotes.Errors) != 1 || !IsSymbolNotFoundError(quotes.Errors["XXX"]) {
+		t.Errorf("expected SymbolNotFoundError for XXX, got %+v", quotes.Errors)
+	}
+
+	if creds.GetCreditsUsed() != 3 || creds.GetCreditsLeft() != 97 {
+		t.Errorf("unexpected credits: %+v", creds)
+	}
+}
+
+func TestGetQuotes_OtherSymbolError(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusOK, 98, 2, `{
+		"AAPL": {"symbol": "AAPL"},
+		"BTC/USD": {"code": 400, "message": "interval is invalid", "status": "error"}
+	}`, "")
+
+	quotes, _, err := newTestClient(t, serverURL, multiSymbolConf).GetQuotes([]string{"AAPL", "BTC/USD", "ETH/USD"}, request.GetQuote{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var quoteErr response.QuoteError
+	if !errors.As(quotes.Errors["BTC/USD"], &quoteErr) || quoteErr.Message != "interval is invalid" {
+		t.Errorf("expected a QuoteError for BTC/USD, got %v", quotes.Errors["BTC/USD"])
+	}
+
+	if quotes.Errors["ETH/USD"] == nil {
+		t.Error("expected an error for the missing symbol ETH/USD")
+	}
+}
+
+func TestGetPrices_SingleSymbol(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusOK, 99, 1, `{"price": "190.10"}`, "/price?symbol=AAPL")
+
+	prices, _, err := newTestClient(t, serverURL, multiSymbolConf).GetPrices([]string{"AAPL"}, request.GetPrice{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(prices.Data) != 1 || prices.Data["AAPL"].Price != "190.10" {
+		t.Errorf("unexpected prices: %+v", prices)
+	}
+}
+
+func TestGetEODs_SingleSymbolNotFound(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusOK, 99, 1,
+		`{"code": 404, "message": "**symbol** not found: XXX. Please specify it correctly", "status": "error"}`, "/eod?symbol=XXX")
+
+	eods, _, err := newTestClient(t, serverURL, multiSymbolConf).GetEODs([]string{"XXX"}, request.GetEOD{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(eods.Data) != 0 || !IsSymbolNotFoundError(eods.Errors["XXX"]) {
+		t.Errorf("expected SymbolNotFoundError for XXX, got %+v", eods)
+	}
+}
+
+func TestGetTimeSeriesMulti(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusOK, 98, 2, `{
+		"AAPL": {"meta": {"symbol": "AAPL", "interval": "1day"}, "values": [{"datetime": "2024-01-02", "close": "185.6"}], "status": "ok"},
+		"EUR/USD": {"meta": {"symbol": "EUR/USD", "interval": "1day"}, "values": [], "status": "ok"}
+	}`, "/time_series?interval=1day&symbol=AAPL%2CEUR%2FUSD")
+
+	series, _, err := newTestClient(t, serverURL, multiSymbolConf).GetTimeSeriesMulti([]string{"AAPL", "EUR/USD"}, request.GetTimeSeries{Interval: "1day"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(series.Data["AAPL"].Values) != 1 || series.Data["EUR/USD"].Meta.Symbol != "EUR/USD" || len(series.Errors) != 0 {
+		t.Errorf("unexpected series: %+v", series)
+	}
+}
+
+func TestGetQuotes_CallError(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusUnauthorized, 0, 0,
+		`{"code": 401, "message": "**apikey** parameter is incorrect or not specified.", "status": "error"}`, "")
+
+	cli := newTestClient(t, serverURL, multiSymbolConf)
+
+	if _, _, err := cli.GetQuotes([]string{"AAPL", "MSFT"}, request.GetQuote{}); err == nil {
+		t.Error("expected error, got nil")
+	}
+
+	if _, _, err := cli.GetQuotes([]string{" "}, request.GetQuote{}); err == nil {
+		t.Error("expected an error without symbols")
+	}
+}
@@ -0,0 +1,213 @@
+package twelvedata
+
+import (
+	"context"
+	"iter"
+
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+// PageCursor is the position of a paginated iteration. Passing the same cursor to a new iteration with
+// WithPageCursor resumes after the last item yielded, e.g. after an error or a limit was reached.
+type PageCursor struct {
+	Page   int  // page to request next, pages start at 1
+	Offset int  // items of Page already yielded
+	Done   bool // the last page was reached
+}
+
+// PageOption configures a paginated iteration.
+type PageOption func(*pageConfig)
+
+type pageConfig struct {
+	maxItems   int
+	maxCredits int64
+	cursor     *PageCursor
+}
+
+// WithMaxItems stops the iteration after n items.
+func WithMaxItems(n int) PageOption {
+	return func(c *pageConfig) {
+		c.maxItems = n
+	}
+}
+
+// WithMaxCredits stops the iteration before requesting a page once n credits have been used.
+func WithMaxCredits(n int64) PageOption {
+	return func(c *pageConfig) {
+		c.maxCredits = n
+	}
+}
+
+// WithPageCursor starts the iteration at cursor and keeps it updated.
+func WithPageCursor(cursor *PageCursor) PageOption {
+	return func(c *pageConfig) {
+		c.cursor = cursor
+	}
+}
+
+// page is the part of a paginated response an iteration needs.
+type page[Item any] struct {
+	items   []Item
+	perPage int // page size reported by the API, zero when unknown
+}
+
+// paginate returns an iterator requesting the pages of req lazily, starting at firstPage.
+// The iteration stops on an error, an empty page or a page shorter than the page size. The page size is
+// the requested one, else the one reported by the API, else the length of the first page.
+func paginate[Req any, Resp any, Item any](
+	ctx context.Context,
+	fetch func(context.Context, Req) (Resp, response.Credits, error),
+	req Req,
+	firstPage, pageSize int,
+	withPage func(Req, int) Req,
+	pageOf func(Resp) page[Item],
+	opts []PageOption,
+) iter.Seq2[Item, error] {
+	return func(yield func(Item, error) bool) {
+		cfg := pageConfig{cursor: &PageCursor{}}
+		for _, opt := range opts {
+			opt(&cfg)
+		}
+
+		cursor := cfg.cursor
+		if cursor.Done {
+			return
+		}
+
+		if cursor.Page <= 0 {
+			cursor.Page, cursor.Offset = max(firstPage, 1), 0
+		}
+
+		var (
+			yielded int
+			used    int64
+			size    = pageSize
+		)
+
+		for {
+			if cfg.maxCredits > 0 && used >= cfg.maxCredits {
+				return
+			}
+
+			resp, creds, err := fetch(ctx, withPage(req, cursor.Page))
+			if creds != nil {
+				used += creds.GetCreditsUsed()
+			}
+
+			if err != nil {
+				var zero Item
+
+				yield(zero, err)
+
+				return
+			}
+
+			current := pageOf(resp)
+
+			if size <= 0 {
+				size = current.perPage
+			}
+
+			if size <= 0 {
+				size = len(current.items)
+			}
+
+			for _, item := range current.items[min(cursor.Offset, len(current.items)):] {
+				cursor.Offset++
+
+				if !yield(item, nil) {
+					return
+				}
+
+				if yielded++; cfg.maxItems > 0 && yielded >= cfg.maxItems {
+					return
+				}
+			}
+
+			if len(current.items) == 0 || len(current.items) < size {
+				cursor.Done = true
+
+				return
+			}
+
+			cursor.Page, cursor.Offset = cursor.Page+1, 0
+		}
+	}
+}
+
+// AllLastChanges iterates over the changes of every page of req, Page and OutputSize of req set the first page
+// and the page size.
+func (cli client) AllLastChanges(
+	ctx context.Context,
+	req request.GetLastChange,
+	opts ...PageOption,
+) iter.Seq2[response.LastChangeData, error] {
+	return paginate(ctx, cli.GetLastChangeCtx, req, req.Page, req.OutputSize,
+		func(req request.GetLastChange, n int) request.GetLastChange {
+			req.Page = n
+
+			return req
+		},
+		func(resp response.LastChange) page[response.LastChangeData] {
+			return page[response.LastChangeData]{items: resp.Data, perPage: int(resp.Pagination.PerPage.Int64)}
+		},
+		opts)
+}
+
+// AllEDGARFilings iterates over the filings of every page of req, Page and PageSize of req set the first page
+// and the page size.
+func (cli client) AllEDGARFilings(
+	ctx context.Context,
+	req request.GetEDGARFilings,
+	opts ...PageOption,
+) iter.Seq2[response.EDGARFiling, error] {
+	return paginate(ctx, cli.GetEDGARFilingsCtx, req, req.Page, req.PageSize,
+		func(req request.GetEDGARFilings, n int) request.GetEDGARFilings {
+			req.Page = n
+
+			return req
+		},
+		func(resp response.EDGARFilings) page[response.EDGARFiling] {
+			return page[response.EDGARFiling]{items: resp.Values}
+		},
+		opts)
+}
+
+// AllETFsDirectory iterates over the ETFs of every page of req, Page and OutputSize of req set the first page
+// and the page size.
+func (cli client) AllETFsDirectory(
+	ctx context.Context,
+	req request.GetETFsDirectory,
+	opts ...PageOption,
+) iter.Seq2[response.ETFsDirectoryETF, error] {
+	return paginate(ctx, cli.GetETFsDirectoryCtx, req, req.Page, req.OutputSize,
+		func(req request.GetETFsDirectory, n int) request.GetETFsDirectory {
+			req.Page = n
+
+			return req
+		},
+		func(resp response.ETFsDirectory) page[response.ETFsDirectoryETF] {
+			return page[response.ETFsDirectoryETF]{items: resp.Result.List}
+		},
+		opts)
+}
+
+// AllMutualFundsDirectory iterates over the funds of every page of req, Page and OutputSize of req set the
+// first page and the page size.
+func (cli client) AllMutualFundsDirectory(
+	ctx context.Context,
+	req request.GetMutualFundsDirectory,
+	opts ...PageOption,
+) iter.Seq2[response.MutualFundsDirectoryFund, error] {
+	return paginate(ctx, cli.GetMutualFundsDirectoryCtx, req, req.Page, req.OutputSize,
+		func(req request.GetMutualFundsDirectory, n int) request.GetMutualFundsDirectory {
+			req.Page = n
+
+			return req
+		},
+		func(resp response.MutualFundsDirectory) page[response.MutualFundsDirectoryFund] {
+			return page[response.MutualFundsDirectoryFund]{items: resp.Result.List}
+		},
+		opts)
+}
@@ -0,0 +1,254 @@
+package twelvedata
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+// newPagedServer serves total items in pages of perPage through render, counting the requests.
+// A request for failPage gets a bad request error.
+func newPagedServer(t *testing.T, total, perPage, failPage int, requests *atomic.Int32,
+	render func(page []int) any,
+) string {
+	t.Helper()
+
+	return newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		pageNum, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil {
+			t.Errorf("invalid page in %s", r.URL)
+		}
+
+		w.Header().Set("Api-credits-left", "100")
+		w.Header().Set("Api-credits-used", "1")
+
+		if pageNum == failPage {
+			w.WriteHeader(http.StatusBadRequest)
+
+			if _, err := w.Write([]byte(`{"code":400,"message":"bad page","status":"error"}`)); err != nil {
+				t.Error(err)
+			}
+
+			return
+		}
+
+		var items []int
+		for i := (pageNum - 1) * perPage; i < min(pageNum*perPage, total); i++ {
+			items = append(items, i)
+		}
+
+		if err := json.NewEncoder(w).Encode(render(items)); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func renderFilings(items []int) any {
+	values := make([]map[string]any, 0, len(items))
+	for _, i := range items {
+		values = append(values, map[string]any{"cik": i})
+	}
+
+	return map[string]any{"values": values}
+}
+
+var pagedConf = Conf{
+	Fundamentals: Fundamentals{LastChangeURL: "/last_change/{endpoint}"},
+	ETFs:         ETFs{ETFsDirectoryURL: "/etfs/list"},
+	MutualFunds:  MutualFunds{MutualFundsDirectoryURL: "/mutual_funds/list"},
+	Regulatory:   Regulatory{EDGARFilingsURL: "/edgar_filings/archive"},
+}
+
+func collectFilings(t *testing.T, seq iter.Seq2[response.EDGARFiling, error]) ([]int64, error) {
+	t.Helper()
+
+	var ciks []int64
+
+	for filing, err := range seq {
+		if err != nil {
+			return ciks, err
+		}
+
+		ciks = append(ciks, filing.Cik.Int64)
+	}
+
+	return ciks, nil
+}
+
+func TestAllEDGARFilings(t *testing.T) {
+	var requests atomic.Int32
+
+	cli := newTestClient(t, newPagedServer(t, 5, 2, 0, &requests, renderFilings), pagedConf)
+
+	ciks, err := collectFilings(t, cli.AllEDGARFilings(context.Background(), request.GetEDGARFilings{PageSize: 2}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(ciks) != 5 || ciks[4] != 4 || requests.Load() != 3 {
+		t.Errorf("expected 5 filings in 3 requests, got %v in %d", ciks, requests.Load())
+	}
+}
+
+func TestAllEDGARFilings_StopsOnEmptyPage(t *testing.T) {
+	var requests atomic.Int32
+
+	cli := newTestClient(t, newPagedServer(t, 4, 2, 0, &requests, renderFilings), pagedConf)
+
+	ciks, err := collectFilings(t, cli.AllEDGARFilings(context.Background(), request.GetEDGARFilings{PageSize: 2}))
+	if err != nil || len(ciks) != 4 || requests.Load() != 3 {
+		t.Errorf("expected 4 filings in 3 requests, got %v in %d, %v", ciks, requests.Load(), err)
+	}
+}
+
+func TestAllEDGARFilings_ResumeFromCursor(t *testing.T) {
+	var requests atomic.Int32
+
+	cli := newTestClient(t, newPagedServer(t, 5, 2, 0, &requests, renderFilings), pagedConf)
+
+	var cursor PageCursor
+
+	req := request.GetEDGARFilings{PageSize: 2}
+
+	first, err := collectFilings(t, cli.AllEDGARFilings(context.Background(), req, WithMaxItems(3), WithPageCursor(&cursor)))
+	if err != nil || len(first) != 3 {
+		t.Fatalf("expected 3 filings, got %v, %v", first, err)
+	}
+
+	if cursor != (PageCursor{Page: 2, Offset: 1}) {
+		t.Fatalf("unexpected cursor after the first run: %+v", cursor)
+	}
+
+	rest, err := collectFilings(t, cli.AllEDGARFilings(context.Background(), req, WithPageCursor(&cursor)))
+	if err != nil || len(rest) != 2 || rest[0] != 3 || rest[1] != 4 {
+		t.Fatalf("expected filings 3 and 4, got %v, %v", rest, err)
+	}
+
+	if !cursor.Done || requests.Load() != 4 {
+		t.Errorf("expected a done cursor after 4 requests, got %+v after %d", cursor, requests.Load())
+	}
+
+	if again, _ := collectFilings(t, cli.AllEDGARFilings(context.Background(), req, WithPageCursor(&cursor))); len(again) != 0 {
+		t.Errorf("expected no filings from a done cursor, got %v", again)
+	}
+}
+
+func TestAllEDGARFilings_MaxCredits(t *testing.T) {
+	var requests atomic.Int32
+
+	cli := newTestClient(t, newPagedServer(t, 10, 2, 0, &requests, renderFilings), pagedConf)
+
+	ciks, err := collectFilings(t, cli.AllEDGARFilings(context.Background(), request.GetEDGARFilings{PageSize: 2}, WithMaxCredits(2)))
+	if err != nil || len(ciks) != 4 || requests.Load() != 2 {
+		t.Errorf("expected 4 filings in 2 requests, got %v in %d, %v", ciks, requests.Load(), err)
+	}
+}
+
+func TestAllEDGARFilings_Error(t *testing.T) {
+	var requests atomic.Int32
+
+	cli := newTestClient(t, newPagedServer(t, 10, 2, 2, &requests, renderFilings), pagedConf)
+
+	var cursor PageCursor
+
+	ciks, err := collectFilings(t, cli.AllEDGARFilings(context.Background(), request.GetEDGARFilings{PageSize: 2}, WithPageCursor(&cursor)))
+	if !IsBadRequestError(err) || len(ciks) != 2 {
+		t.Fatalf("expected 2 filings and a BadRequestError, got %v, %v", ciks, err)
+	}
+
+	if cursor != (PageCursor{Page: 2}) {
+		t.Errorf("expected the cursor to retry page 2, got %+v", cursor)
+	}
+}
+
+func TestAllLastChanges_PerPageFromResponse(t *testing.T) {
+	var requests atomic.Int32
+
+	serverURL := newPagedServer(t, 5, 3, 0, &requests, func(items []int) any {
+		data := make([]map[string]any, 0, len(items))
+		for _, i := range items {
+			data = append(data, map[string]any{"symbol": strconv.Itoa(i)})
+		}
+
+		return map[string]any{"pagination": map[string]any{"current_page": 1, "per_page": 3}, "data": data}
+	})
+
+	var symbols []string
+
+	cli := newTestClient(t, serverURL, pagedConf)
+
+	for change, err := range cli.AllLastChanges(context.Background(), request.GetLastChange{Endpoint: "profile"}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		symbols = append(symbols, change.Symbol)
+	}
+
+	if len(symbols) != 5 || requests.Load() != 2 {
+		t.Errorf("expected 5 changes in 2 requests, got %v in %d", symbols, requests.Load())
+	}
+}
+
+func TestAllETFsDirectory_PageSizeFromFirstPage(t *testing.T) {
+	var requests atomic.Int32
+
+	serverURL := newPagedServer(t, 7, 3, 0, &requests, func(items []int) any {
+		list := make([]map[string]any, 0, len(items))
+		for _, i := range items {
+			list = append(list, map[string]any{"symbol": strconv.Itoa(i)})
+		}
+
+		return map[string]any{"result": map[string]any{"count": len(items), "list": list}, "status": "ok"}
+	})
+
+	var count int
+
+	cli := newTestClient(t, serverURL, pagedConf)
+
+	for _, err := range cli.AllETFsDirectory(context.Background(), request.GetETFsDirectory{}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		count++
+	}
+
+	if count != 7 || requests.Load() != 3 {
+		t.Errorf("expected 7 ETFs in 3 requests, got %d in %d", count, requests.Load())
+	}
+}
+
+func TestAllMutualFundsDirectory_BreakStopsRequests(t *testing.T) {
+	var requests atomic.Int32
+
+	serverURL := newPagedServer(t, 100, 2, 0, &requests, func(items []int) any {
+		list := make([]map[string]any, 0, len(items))
+		for _, i := range items {
+			list = append(list, map[string]any{"symbol": strconv.Itoa(i)})
+		}
+
+		return map[string]any{"result": map[string]any{"list": list}, "status": "ok"}
+	})
+
+	cli := newTestClient(t, serverURL, pagedConf)
+
+	for fund, err := range cli.AllMutualFundsDirectory(context.Background(), request.GetMutualFundsDirectory{OutputSize: 2}) {
+		if err != nil || fund.Symbol == "2" {
+			break
+		}
+	}
+
+	if requests.Load() != 2 {
+		t.Errorf("expected 2 requests, got %d", requests.Load())
+	}
+}
@@ -0,0 +1,118 @@
+package twelvedata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/soulgarden/twelvedata/dictionary"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+// rawRequest is a request of Client.Do, sent as given without a request type.
+type rawRequest struct {
+	method string
+	params url.Values
+	body   any
+}
+
+func (req rawRequest) Method() string {
+	return req.method
+}
+
+// Query returns a copy of params, the API key may be moved from the query to a header.
+func (req rawRequest) Query() (url.Values, error) {
+	values := make(url.Values, len(req.params))
+	for key, value := range req.params {
+		values[key] = append([]string(nil), value...)
+	}
+
+	return values, nil
+}
+
+// RawBody sends []byte, json.RawMessage and string bodies as they are and encodes any other body as JSON.
+func (req rawRequest) RawBody() ([]byte, string, error) {
+	switch body := req.body.(type) {
+	case nil:
+		return nil, "", nil
+	case []byte:
+		return body, "application/json", nil
+	case json.RawMessage:
+		return body, "application/json", nil
+	case string:
+		return []byte(body), "application/json", nil
+	default:
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return encoded, "application/json", nil
+	}
+}
+
+// Do calls an API path relative to Conf.BaseURL, such as "/time_series", that has no typed method, and
+// returns the response body. params are sent as the query, body as a JSON payload. An empty method defaults
+// to GET, or POST when there is a body.
+//
+// The call goes through the same retries, limiter, ledger, cache, interceptors, tracing and metrics as
+// typed calls, and its errors are classified the same way, for example a SymbolNotFoundError or a
+// TooManyRequestsError. It is charged the credit cost registered for path by a typed endpoint, or
+// dictionary.Raw for an unknown path. A CSV format returns the CSV body as it is.
+func (cli client) Do(
+	ctx context.Context,
+	method, path string,
+	params url.Values,
+	body any,
+) (json.RawMessage, response.Credits, error) {
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	cost, ok := cli.httpCli.costs.lookup(path)
+	if !ok {
+		cost = dictionary.Raw
+	}
+
+	endpoint := &Endpoint[rawRequest, json.RawMessage, response.Credits, error]{
+		httpCli: cli.httpCli,
+		URL:     cli.baseURL + path,
+		path:    path,
+		cost:    cost,
+	}
+
+	return endpoint.CallCtx(ctx, rawRequest{method: method, params: params, body: body})
+}
+
+// DoInto calls path with Client.Do and decodes the response into T, from JSON or from CSV when
+// params ask for the CSV format.
+func DoInto[T any](
+	ctx context.Context,
+	cli Client,
+	method, path string,
+	params url.Values,
+	body any,
+) (T, response.Credits, error) {
+	var resp T
+
+	raw, creds, err := cli.Do(ctx, method, path, params, body)
+	if err != nil || raw == nil { // raw is nil on dry runs and inside batches
+		return resp, creds, err
+	}
+
+	if delimiter, ok := csvDelimiter(params); ok && !isJSONBody(raw) {
+		if err := decodeCSV(raw, delimiter, &resp); err != nil {
+			return resp, creds, NewError[error](fmt.Errorf("decode csv: %w", err), nil)
+		}
+
+		return resp, creds, nil
+	}
+
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return resp, creds, NewError[error](fmt.Errorf("unmarshall json: %w", err), nil)
+	}
+
+	return resp, creds, nil
+}
@@ -0,0 +1,143 @@
+package twelvedata
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/soulgarden/twelvedata/response"
+)
+
+func TestClient_Do(t *testing.T) {
+	serverURL := mockServerWithRequest(t, http.StatusOK, 99, 1, `{"symbol":"AAPL","close":"200.5"}`, expectedRequest{
+		Method: http.MethodGet,
+		URL:    "/new_endpoint?apikey=demo&symbol=AAPL",
+	})
+
+	body, creds, err := newTestClient(t, serverURL, quoteConf).Do(context.Background(), "",
+		"new_endpoint", url.Values{"symbol": {"AAPL"}, "apikey": {"demo"}}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(body) != `{"symbol":"AAPL","close":"200.5"}` {
+		t.Errorf("body = %s", body)
+	}
+
+	if creds.GetCreditsLeft() != 99 || creds.GetCreditsUsed() != 1 {
+		t.Errorf("credits = %d left, %d used", creds.GetCreditsLeft(), creds.GetCreditsUsed())
+	}
+}
+
+func TestClient_DoPostBody(t *testing.T) {
+	payload := map[string]any{"symbols": []string{"AAPL"}, "intervals": []string{"1day"}}
+
+	serverURL := mockServerWithRequest(t, http.StatusOK, 99, 1, `{"status":"ok"}`, expectedRequest{
+		Method:  http.MethodPost,
+		URL:     "/complex_data?apikey=demo",
+		Headers: map[string]string{"Content-Type": "application/json"},
+		Body:    payload,
+	})
+
+	if _, _, err := newTestClient(t, serverURL, quoteConf).Do(context.Background(), "",
+		"/complex_data", url.Values{"apikey": {"demo"}}, payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestClient_DoErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		check      func(error) bool
+	}{
+		{
+			name:       "symbol not found",
+			statusCode: http.StatusOK,
+			body:       `{"code":404,"message":"**symbol** not found: XXX. Please specify it correctly according to API Documentation.","status":"error"}`,
+			check:      IsSymbolNotFoundError,
+		},
+		{
+			name:       "too many requests",
+			statusCode: http.StatusTooManyRequests,
+			body:       `{"code":429,"message":"Too many requests","status":"error"}`,
+			check:      IsRateLimitError,
+		},
+		{name: "internal server error", statusCode: http.StatusInternalServerError, body: "oops", check: IsHTTPError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverURL := mockServerWithURL(t, tt.statusCode, 99, 1, tt.body, "")
+
+			cli := newTestClient(t, serverURL, quoteConf)
+
+			_, _, err := cli.Do(context.Background(), http.MethodGet, "/quote", url.Values{"symbol": {"XXX"}}, nil)
+			if !tt.check(err) {
+				t.Errorf("unexpected error %T: %v", err, err)
+			}
+		})
+	}
+}
+
+func TestClient_DoCost(t *testing.T) {
+	cli := newTestClient(t, "http://localhost", quoteConf)
+
+	ctx, dryRun := WithDryRun(context.Background())
+
+	if _, _, err := cli.Do(ctx, "", "/quote", url.Values{"symbol": {"AAPL,MSFT"}}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, _, err := cli.Do(ctx, "", "/unknown", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	requests := dryRun.Requests()
+	if len(requests) != 2 || requests[0].EstimatedCost != 2 || requests[1].EstimatedCost != 1 {
+		t.Fatalf("expected the registered quote cost per symbol and the raw cost, got %+v", requests)
+	}
+
+	if _, _, err := cli.Do(context.Background(), http.MethodGet, "/quote", nil, []byte(`{}`)); err == nil {
+		t.Error("expected an error for a GET request with a body")
+	}
+}
+
+func TestDoInto(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusOK, 99, 1, `{"symbol":"AAPL","name":"Apple Inc","close":"200.5"}`,
+		"/quote?symbol=AAPL")
+
+	quote, creds, err := DoInto[response.Quote](context.Background(), newTestClient(t, serverURL, quoteConf),
+		"", "/quote", url.Values{"symbol": {"AAPL"}}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if quote.Symbol != "AAPL" || quote.Name != "Apple Inc" || creds.GetCreditsUsed() != 1 {
+		t.Errorf("unexpected quote %+v, credits %+v", quote, creds)
+	}
+}
+
+func TestDoInto_CSV(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusOK, 99, 1, "datetime;close\n2024-01-02;185.64\n2024-01-01;184.25\n", "")
+
+	params := url.Values{"symbol": {"AAPL"}, "interval": {"1day"}, "format": {"CSV"}}
+
+	cli := newTestClient(t, serverURL, quoteConf)
+
+	raw, _, err := cli.Do(context.Background(), "", "/time_series", params, nil)
+	if err != nil || string(raw) != "datetime;close\n2024-01-02;185.64\n2024-01-01;184.25\n" {
+		t.Fatalf("expected the CSV body as it is, got %q, %v", raw, err)
+	}
+
+	series, _, err := DoInto[response.TimeSeries](context.Background(), cli, "", "/time_series", params, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(series.Values) != 2 || series.Values[1].Datetime != "2024-01-01" || series.Values[1].Close != "184.25" {
+		t.Errorf("unexpected values %+v", series.Values)
+	}
+}
@@ -0,0 +1,78 @@
+package twelvedata
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/soulgarden/twelvedata/dictionary"
+)
+
+const redacted = "REDACTED"
+
+var (
+	apiKeyQueryPattern  = regexp.MustCompile(`(?i)(apikey=)[^&"\s]+`)
+	apiKeyHeaderPattern = regexp.MustCompile(`(?i)^(apikey\s+).+$`)
+	authorizationLine   = regexp.MustCompile(`(?im)^(authorization:\s*apikey\s+)[^\r\n]+`)
+)
+
+// redactSecrets replaces API keys in query strings, Authorization header values and raw header dumps.
+func redactSecrets(value string) string {
+	value = apiKeyQueryPattern.ReplaceAllString(value, "${1}"+redacted)
+	value = authorizationLine.ReplaceAllString(value, "${1}"+redacted)
+
+	return apiKeyHeaderPattern.ReplaceAllString(value, "${1}"+redacted)
+}
+
+// redactURLError scrubs the API key from the URL net/http puts into transport errors.
+func redactURLError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		urlErr.URL = redactSecrets(urlErr.URL)
+	}
+
+	return err
+}
+
+// apiKeyHash returns a short hash of the API key of a request, read from its query or its Authorization
+// header, or an empty string without a key. It tells the calls of different keys apart without keeping the key.
+func apiKeyHash(values url.Values, headers map[string]string) string {
+	apiKey := values.Get("apikey")
+	if apiKey == "" {
+		apiKey = strings.TrimPrefix(headers[dictionary.Authorization], "apikey ")
+	}
+
+	if apiKey == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(apiKey))
+
+	return hex.EncodeToString(sum[:8])
+}
+
+// moveAPIKeyToHeader removes apikey from values and returns headers authenticating with it, or with fallback
+// when the request has no key. A request setting its own Authorization header keeps it.
+func moveAPIKeyToHeader(values url.Values, headers map[string]string, fallback string) map[string]string {
+	key := values.Get("apikey")
+	values.Del("apikey")
+
+	if key == "" {
+		key = fallback
+	}
+
+	if _, ok := headers[dictionary.Authorization]; ok || key == "" {
+		return headers
+	}
+
+	if headers == nil {
+		headers = map[string]string{}
+	}
+
+	headers[dictionary.Authorization] = "apikey " + key
+
+	return headers
+}
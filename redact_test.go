@@ -0,0 +1,150 @@
+package twelvedata
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+)
+
+func TestRedactSecrets(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "https://api.twelvedata.com/quote?apikey=abc&symbol=AAPL", want: "https://api.twelvedata.com/quote?apikey=REDACTED&symbol=AAPL"},
+		{value: "/quote?symbol=AAPL&apikey=abc", want: "/quote?symbol=AAPL&apikey=REDACTED"},
+		{value: `{"a":{"url":"/quote?apikey=abc"}}`, want: `{"a":{"url":"/quote?apikey=REDACTED"}}`},
+		{value: "apikey abc", want: "apikey REDACTED"},
+		{
+			value: "GET /quote HTTP/1.1\r\nAuthorization: apikey abc\r\nHost: localhost\r\n",
+			want:  "GET /quote HTTP/1.1\r\nAuthorization: apikey REDACTED\r\nHost: localhost\r\n",
+		},
+		{value: "application/json", want: "application/json"},
+	}
+
+	for _, tt := range tests {
+		if got := redactSecrets(tt.value); got != tt.want {
+			t.Errorf("redactSecrets(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestAPIKeyInHeader(t *testing.T) {
+	tests := []struct {
+		name    string
+		confKey string
+		reqKey  string
+		want    string
+	}{
+		{name: "request key", confKey: "conf-key", reqKey: "req-key", want: "apikey req-key"},
+		{name: "conf key", confKey: "conf-key", want: "apikey conf-key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverURL := mockServerWithRequest(t, http.StatusOK, 100, 1, `{"symbol":"AAPL"}`, expectedRequest{
+				Method:  http.MethodGet,
+				URL:     "/quote?symbol=AAPL",
+				Headers: map[string]string{"Authorization": tt.want},
+			})
+
+			conf := quoteConf
+			conf.APIKey, conf.APIKeyInHeader = tt.confKey, true
+
+			cli := newTestClient(t, serverURL, conf)
+
+			if _, _, err := cli.GetQuote(request.GetQuote{APIKey: request.APIKey{APIKey: tt.reqKey}, Symbol: "AAPL"}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestAPIKeyInHeader_BatchesKeepOwnHeader(t *testing.T) {
+	serverURL := mockServerWithRequest(t, http.StatusOK, 100, 1, `{"code":200,"status":"success","data":{}}`, expectedRequest{
+		Method:  http.MethodPost,
+		URL:     "/batch",
+		Headers: map[string]string{"Authorization": "apikey batch-key"},
+	})
+
+	cli := newTestClient(t, serverURL, Conf{
+		Advanced:       Advanced{BatchesURL: "/batch"},
+		APIKey:         "conf-key",
+		APIKeyInHeader: true,
+	})
+
+	_, _, err := cli.GetBatches(request.GetBatches{
+		APIKey:   request.APIKey{APIKey: "batch-key"},
+		Requests: map[string]request.BatchRequest{"req_1": {URL: "/quote?symbol=AAPL"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestRedaction_ErrorsAndLogs(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusBadRequest, 100, 0,
+		`{"code":400,"message":"bad request","status":"error"}`, "/?apikey=secret-key")
+
+	var logs bytes.Buffer
+
+	logger := zerolog.New(&logs).Level(zerolog.DebugLevel)
+
+	httpCli := newTestHTTPCli(serverURL)
+	httpCli.logger = &logger
+
+	endpoint := NewEndpoint[request.APIKey, testResponse, response.Credits, error](httpCli, serverURL+"/")
+
+	_, _, err := endpoint.Call(request.APIKey{APIKey: "secret-key"})
+	if !IsBadRequestError(err) {
+		t.Fatalf("expected BadRequestError, got %v", err)
+	}
+
+	if strings.Contains(err.Error(), "secret-key") || !strings.Contains(err.Error(), "apikey=REDACTED") {
+		t.Errorf("API key not redacted from error: %v", err)
+	}
+
+	if logs.Len() == 0 || strings.Contains(logs.String(), "secret-key") {
+		t.Errorf("API key not redacted from logs: %s", logs.String())
+	}
+}
+
+func TestRedaction_NetHTTPTransportError(t *testing.T) {
+	httpCli := newTestHTTPCli("http://127.0.0.1:1")
+	WithTransport(NewNetHTTPDoer(&http.Client{}))(httpCli)
+
+	endpoint := NewEndpoint[request.APIKey, testResponse, response.Credits, error](httpCli, "http://127.0.0.1:1/")
+
+	_, _, err := endpoint.Call(request.APIKey{APIKey: "secret-key"})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if strings.Contains(err.Error(), "secret-key") {
+		t.Errorf("API key not redacted from error: %v", err)
+	}
+}
+
+func TestRedaction_WSConnectError(t *testing.T) {
+	var logs bytes.Buffer
+
+	logger := zerolog.New(&logs)
+	cfg := &Conf{BaseWSURL: "127.0.0.1:1", APIKey: "secret-key", WebSocket: WebSocket{PriceURL: "/quotes/price"}}
+
+	ws := NewWS(cfg, &logger, nil)
+	ws.url.Scheme = "ws"
+
+	err := ws.Connect(t.Context())
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if strings.Contains(err.Error(), "secret-key") || strings.Contains(logs.String(), "secret-key") {
+		t.Errorf("API key not redacted: %v, logs: %s", err, logs.String())
+	}
+}
@@ -41,6 +41,17 @@ func (b GetBatches) Body() (any, string, error) {
 	return b.Requests, "application/json", nil
 }
 
+// Cost returns the credit cost of all bundled requests.
+func (b GetBatches) Cost(costOf func(uri string) int64) int64 {
+	var total int64
+
+	for _, req := range b.Requests {
+		total += costOf(req.URL)
+	}
+
+	return total
+}
+
 // Query returns an empty query set because batch requests use JSON body.
 func (b GetBatches) Query() (url.Values, error) {
 	return url.Values{}, nil
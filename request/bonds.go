@@ -6,7 +6,7 @@ type GetBonds struct {
 	Symbol     string `schema:"symbol,omitempty"`
 	Exchange   string `schema:"exchange,omitempty"`
 	Country    string `schema:"country,omitempty"`
-	Format     string `schema:"format,omitempty"`
+	Format     Format `schema:"format,omitempty"`
 	Delimiter  string `schema:"delimiter,omitempty"`
 	ShowPlan   bool   `schema:"show_plan,omitempty"`
 	Page       int    `schema:"page,omitempty"`
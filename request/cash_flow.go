@@ -10,7 +10,7 @@ type GetCashFlow struct {
 	Exchange   string `schema:"exchange,omitempty"`
 	MicCode    string `schema:"mic_code,omitempty"`
 	Country    string `schema:"country,omitempty"`
-	Period     string `schema:"period,omitempty"`
+	Period     Period `schema:"period,omitempty"`
 	StartDate  string `schema:"start_date,omitempty"`
 	EndDate    string `schema:"end_date,omitempty"`
 	OutputSize int    `schema:"outputsize,omitempty"`
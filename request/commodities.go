@@ -5,6 +5,6 @@ type GetCommodities struct {
 	APIKey
 	Symbol    string `schema:"symbol,omitempty"`
 	Category  string `schema:"category,omitempty"`
-	Format    string `schema:"format,omitempty"`
+	Format    Format `schema:"format,omitempty"`
 	Delimiter string `schema:"delimiter,omitempty"`
 }
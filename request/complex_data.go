@@ -0,0 +1,154 @@
+package request
+
+import (
+	"encoding/json"
+	"maps"
+	"net/url"
+
+	"github.com/soulgarden/twelvedata/dictionary"
+)
+
+// ComplexDataTimeSeries is the name of the time series method of a complex data request.
+const ComplexDataTimeSeries = "time_series"
+
+// GetComplexData represents request parameters for the complex data endpoint, which returns the time series
+// and indicators of every symbol at every interval in one call. The parameters are sent as a JSON body, only
+// the API key goes in the query.
+type GetComplexData struct {
+	APIKey
+	Symbols       []string            `schema:"symbols"`
+	Intervals     []Interval          `schema:"intervals"`
+	Methods       []ComplexDataMethod `schema:"methods"`
+	OutputSize    int                 `schema:"outputsize,omitempty"`
+	DecimalPlaces int                 `schema:"dp,omitempty"`
+	Order         Order               `schema:"order,omitempty"`
+	TimeZone      string              `schema:"timezone,omitempty"`
+	StartDate     string              `schema:"start_date,omitempty"`
+	EndDate       string              `schema:"end_date,omitempty"`
+	PrePost       bool                `schema:"prepost,omitempty"`
+}
+
+// ComplexDataMethod is a method of a complex data request: the time series or an indicator with its parameters.
+type ComplexDataMethod struct {
+	Name   string // ComplexDataTimeSeries or the name of an indicator, such as "rsi"
+	Params map[string]any
+}
+
+// ComplexTimeSeries returns the time series method.
+func ComplexTimeSeries() ComplexDataMethod {
+	return ComplexDataMethod{Name: ComplexDataTimeSeries}
+}
+
+// ComplexIndicator returns the method of the indicator of params, such as RSIParams{TimePeriod: 14}.
+func ComplexIndicator(params IndicatorParams) ComplexDataMethod {
+	return ComplexDataMethod{Name: params.Indicator(), Params: params.Params()}
+}
+
+// MarshalJSON writes a method without parameters as its name and the others as an object of the name and
+// the parameters.
+func (m ComplexDataMethod) MarshalJSON() ([]byte, error) {
+	if len(m.Params) == 0 {
+		return json.Marshal(m.Name)
+	}
+
+	fields := maps.Clone(m.Params)
+	fields["name"] = m.Name
+
+	return json.Marshal(fields)
+}
+
+// Method returns the HTTP method for complex data requests.
+func (req GetComplexData) Method() string {
+	return "POST"
+}
+
+// Query returns the API key, the other parameters go in the body.
+func (req GetComplexData) Query() (url.Values, error) {
+	values := url.Values{}
+	if req.APIKey.APIKey != "" {
+		values.Set("apikey", req.APIKey.APIKey)
+	}
+
+	return values, nil
+}
+
+// Body returns the JSON body of the request.
+func (req GetComplexData) Body() (any, string, error) {
+	body := map[string]any{
+		"symbols":   req.Symbols,
+		"intervals": req.Intervals,
+		"methods":   req.Methods,
+	}
+
+	for param, value := range map[string]string{
+		"order":      string(req.Order),
+		"timezone":   req.TimeZone,
+		"start_date": req.StartDate,
+		"end_date":   req.EndDate,
+	} {
+		if value != "" {
+			body[param] = value
+		}
+	}
+
+	if req.OutputSize != 0 {
+		body["outputsize"] = req.OutputSize
+	}
+
+	if req.DecimalPlaces != 0 {
+		body["dp"] = req.DecimalPlaces
+	}
+
+	if req.PrePost {
+		body["prepost"] = true
+	}
+
+	return body, "application/json", nil
+}
+
+// Cost returns the cost of every method for every symbol and interval, methods being charged at the cost of
+// their own endpoint. Indicators without an endpoint are charged as individual indicators.
+func (req GetComplexData) Cost(costOf func(uri string) int64) int64 {
+	var perSeries int64
+
+	for _, method := range req.Methods {
+		cost := costOf("/" + method.Name)
+		if cost == 0 && method.Name != ComplexDataTimeSeries {
+			cost = dictionary.IndividualIndicators
+		}
+
+		perSeries += cost
+	}
+
+	return perSeries * int64(len(req.Symbols)*len(req.Intervals))
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetComplexData) Validate() error {
+	v := validateFields(req)
+
+	for _, list := range []struct {
+		name, param string
+		count       int
+	}{
+		{"Symbols", "symbols", len(req.Symbols)},
+		{"Intervals", "intervals", len(req.Intervals)},
+		{"Methods", "methods", len(req.Methods)},
+	} {
+		if list.count == 0 {
+			v.add(list.name, list.param, "", "is required")
+		}
+	}
+
+	for _, interval := range req.Intervals {
+		v.enum("Intervals", "intervals", interval)
+	}
+
+	for _, method := range req.Methods {
+		if method.Name == "" {
+			v.add("Methods", "methods", method, "name is required")
+		}
+	}
+
+	return v.err()
+}
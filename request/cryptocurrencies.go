@@ -7,6 +7,6 @@ type GetCryptocurrencies struct {
 	Exchange      string `schema:"exchange,omitempty"`
 	CurrencyBase  string `schema:"currency_base,omitempty"`
 	CurrencyQuote string `schema:"currency_quote,omitempty"`
-	Format        string `schema:"format,omitempty"`
+	Format        Format `schema:"format,omitempty"`
 	Delimiter     string `schema:"delimiter,omitempty"`
 }
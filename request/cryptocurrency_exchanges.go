@@ -3,6 +3,6 @@ package request
 // GetCryptocurrencyExchanges represents request parameters for cryptocurrency exchanges data.
 type GetCryptocurrencyExchanges struct {
 	APIKey
-	Format    string `schema:"format,omitempty"`
+	Format    Format `schema:"format,omitempty"`
 	Delimiter string `schema:"delimiter,omitempty"`
 }
@@ -6,7 +6,7 @@ type GetCurrencyConversion struct {
 	Symbol        string `schema:"symbol,omitempty"`
 	Amount        string `schema:"amount,omitempty"`
 	Date          string `schema:"date,omitempty"`
-	Format        string `schema:"format,omitempty"`
+	Format        Format `schema:"format,omitempty"`
 	Delimiter     string `schema:"delimiter,omitempty"`
 	DecimalPlaces int    `schema:"dp,omitempty"`
 	TimeZone      string `schema:"timezone,omitempty"`
@@ -10,7 +10,7 @@ type GetDividends struct {
 	Exchange  string `schema:"exchange,omitempty"`
 	MicCode   string `schema:"mic_code,omitempty"`
 	Country   string `schema:"country,omitempty"`
-	Range     string `schema:"range,omitempty"`
+	Range     Range  `schema:"range,omitempty"`
 	StartDate string `schema:"start_date,omitempty"`
 	EndDate   string `schema:"end_date,omitempty"`
 	Adjust    bool   `schema:"adjust,omitempty"`
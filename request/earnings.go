@@ -13,7 +13,7 @@ type GetEarnings struct {
 	Type          string `schema:"type,omitempty"`
 	Period        string `schema:"period,omitempty"`
 	OutputSize    int    `schema:"outputsize,omitempty"`
-	Format        string `schema:"format,omitempty"`
+	Format        Format `schema:"format,omitempty"`
 	Delimiter     string `schema:"delimiter,omitempty"`
 	DecimalPlaces int    `schema:"dp,omitempty"`
 	StartDate     string `schema:"start_date,omitempty"`
@@ -6,7 +6,7 @@ type GetEarningsCalendar struct {
 	Exchange      string `schema:"exchange,omitempty"`
 	MicCode       string `schema:"mic_code,omitempty"`
 	Country       string `schema:"country,omitempty"`
-	Format        string `schema:"format,omitempty"`
+	Format        Format `schema:"format,omitempty"`
 	Delimiter     string `schema:"delimiter,omitempty"`
 	DecimalPlaces int    `schema:"dp,omitempty"`
 	StartDate     string `schema:"start_date,omitempty"`
@@ -0,0 +1,548 @@
+// Code generated by genendpoints from endpoints.json. DO NOT EDIT.
+
+package request
+
+// Validate checks the parameters of req before it is sent.
+func (req GetTimeSeries) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetTimeSeriesCross) Validate() error {
+	return validateFields(req, "base", "quote", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetQuote) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetPrice) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetEOD) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetMarketMovers) Validate() error {
+	v := validateFields(req)
+	v.pathParam("Market", "market", req.Market)
+
+	return v.err()
+}
+
+// PathParams returns URL path parameters for the market movers endpoint.
+func (req GetMarketMovers) PathParams() map[string]string {
+	return map[string]string{
+		"market": string(req.Market),
+	}
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetStock) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetForexPairs) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetCryptocurrencies) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetETFs) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetFunds) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetCommodities) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetBonds) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetSymbolSearch) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetCrossListings) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetEarliestTimestamp) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetExchanges) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetExchangeSchedule) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetCryptocurrencyExchanges) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetMarketState) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetCountries) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetInstrumentType) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetTechnicalIndicators) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetLogo) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetProfile) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetDividends) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetDividendsCalendar) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetEarnings) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetEarningsCalendar) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetIPOCalendar) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetSplits) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetSplitsCalendar) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetStatistics) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetPressReleases) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetIncomeStatement) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetBalanceSheet) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetCashFlow) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetKeyExecutives) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetMarketCap) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetLastChange) Validate() error {
+	v := validateFields(req)
+	v.pathParam("Endpoint", "endpoint", req.Endpoint)
+
+	return v.err()
+}
+
+// PathParams returns URL path parameters for the last changes endpoint.
+func (req GetLastChange) PathParams() map[string]string {
+	return map[string]string{
+		"endpoint": string(req.Endpoint),
+	}
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetExchangeRate) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetCurrencyConversion) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetETFsDirectory) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetETFFullData) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetETFSummary) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetETFPerformance) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetETFRisk) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetETFComposition) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetETFFamilies) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetETFTypes) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetMutualFundsDirectory) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetMutualFundFullData) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetMutualFundSummary) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetMutualFundPerformance) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetMutualFundRisk) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetMutualFundRatings) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetMutualFundComposition) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetMutualFundPurchaseInfo) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetMutualFundSustainability) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetMutualFundFamilies) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetMutualFundTypes) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetBBands) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetSMA) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetEMA) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetMA) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetWMA) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetVWAP) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetDEMA) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetTEMA) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetTRMA) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetKAMA) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetSAR) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetADX) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetMACD) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetRSI) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetStoch) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetPercentB) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetCCI) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetWillR) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetROC) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetMOM) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetOBV) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetAD) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetATR) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetNATR) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetTR) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetEarningsEstimate) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetRevenueEstimate) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetEPSTrend) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetEPSRevisions) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetGrowthEstimates) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetRecommendations) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetPriceTarget) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetAnalystRatingsSnapshot) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetAnalystRatingsUSEquities) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetEDGARFilings) Validate() error {
+	return validateFields(req).err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetInsiderTransactions) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetInstitutionalHolders) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetFundHolders) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetDirectHolders) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetTaxInformation) Validate() error {
+	return validateInstrument(req, "symbol").err()
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetSanctionedEntities) Validate() error {
+	v := validateFields(req)
+	v.pathParam("Source", "source", req.Source)
+
+	return v.err()
+}
+
+// PathParams returns URL path parameters for the sanctioned entities endpoint.
+func (req GetSanctionedEntities) PathParams() map[string]string {
+	return map[string]string{
+		"source": string(req.Source),
+	}
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetUsage) Validate() error {
+	return validateFields(req).err()
+}
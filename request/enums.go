@@ -0,0 +1,415 @@
+package request
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Interval is the time between two consecutive bars of a series.
+type Interval string
+
+// Intervals supported by the API.
+const (
+	Interval1Min   Interval = "1min"
+	Interval5Min   Interval = "5min"
+	Interval15Min  Interval = "15min"
+	Interval30Min  Interval = "30min"
+	Interval45Min  Interval = "45min"
+	Interval1H     Interval = "1h"
+	Interval2H     Interval = "2h"
+	Interval4H     Interval = "4h"
+	Interval8H     Interval = "8h"
+	Interval1Day   Interval = "1day"
+	Interval1Week  Interval = "1week"
+	Interval1Month Interval = "1month"
+)
+
+var intervals = []Interval{
+	Interval1Min, Interval5Min, Interval15Min, Interval30Min, Interval45Min, Interval1H, Interval2H, Interval4H,
+	Interval8H, Interval1Day, Interval1Week, Interval1Month,
+}
+
+// ParseInterval returns the Interval named s, ignoring case.
+func ParseInterval(s string) (Interval, error) {
+	return parseEnum("interval", s, intervals)
+}
+
+// IsValid reports whether i is a supported interval, ignoring case.
+func (i Interval) IsValid() bool {
+	return isEnum(i, intervals)
+}
+
+func (i Interval) allowed() []string {
+	return enumStrings(intervals)
+}
+
+// Order is the sort order of a series by datetime.
+type Order string
+
+// Orders supported by the API.
+const (
+	OrderAsc  Order = "asc"
+	OrderDesc Order = "desc"
+)
+
+var orders = []Order{OrderAsc, OrderDesc}
+
+// ParseOrder returns the Order named s, ignoring case.
+func ParseOrder(s string) (Order, error) {
+	return parseEnum("order", s, orders)
+}
+
+// IsValid reports whether o is a supported order, ignoring case.
+func (o Order) IsValid() bool {
+	return isEnum(o, orders)
+}
+
+func (o Order) allowed() []string {
+	return enumStrings(orders)
+}
+
+// Format is the format of a response.
+type Format string
+
+// Formats supported by the API.
+const (
+	FormatJSON Format = "JSON"
+	FormatCSV  Format = "CSV"
+)
+
+var formats = []Format{FormatJSON, FormatCSV}
+
+// ParseFormat returns the Format named s, ignoring case.
+func ParseFormat(s string) (Format, error) {
+	return parseEnum("format", s, formats)
+}
+
+// IsValid reports whether f is a supported format, ignoring case.
+func (f Format) IsValid() bool {
+	return isEnum(f, formats)
+}
+
+func (f Format) allowed() []string {
+	return enumStrings(formats)
+}
+
+// Adjust selects the corporate actions prices are adjusted for.
+type Adjust string
+
+// Adjustments supported by the API.
+const (
+	AdjustAll       Adjust = "all"
+	AdjustSplits    Adjust = "splits"
+	AdjustDividends Adjust = "dividends"
+	AdjustNone      Adjust = "none"
+)
+
+var adjustments = []Adjust{AdjustAll, AdjustSplits, AdjustDividends, AdjustNone}
+
+// ParseAdjust returns the Adjust named s, ignoring case.
+func ParseAdjust(s string) (Adjust, error) {
+	return parseEnum("adjust", s, adjustments)
+}
+
+// IsValid reports whether a is a supported adjustment, ignoring case.
+func (a Adjust) IsValid() bool {
+	return isEnum(a, adjustments)
+}
+
+func (a Adjust) allowed() []string {
+	return enumStrings(adjustments)
+}
+
+// SeriesType is the price a technical indicator is calculated on.
+type SeriesType string
+
+// Series types supported by the API.
+const (
+	SeriesTypeOpen  SeriesType = "open"
+	SeriesTypeHigh  SeriesType = "high"
+	SeriesTypeLow   SeriesType = "low"
+	SeriesTypeClose SeriesType = "close"
+)
+
+var seriesTypes = []SeriesType{SeriesTypeOpen, SeriesTypeHigh, SeriesTypeLow, SeriesTypeClose}
+
+// ParseSeriesType returns the SeriesType named s, ignoring case.
+func ParseSeriesType(s string) (SeriesType, error) {
+	return parseEnum("series type", s, seriesTypes)
+}
+
+// IsValid reports whether t is a supported series type, ignoring case.
+func (t SeriesType) IsValid() bool {
+	return isEnum(t, seriesTypes)
+}
+
+func (t SeriesType) allowed() []string {
+	return enumStrings(seriesTypes)
+}
+
+// MAType is the kind of moving average used by a technical indicator.
+type MAType string
+
+// Moving average types supported by the API.
+const (
+	MATypeSMA   MAType = "SMA"
+	MATypeEMA   MAType = "EMA"
+	MATypeWMA   MAType = "WMA"
+	MATypeDEMA  MAType = "DEMA"
+	MATypeTEMA  MAType = "TEMA"
+	MATypeTRIMA MAType = "TRIMA"
+	MATypeKAMA  MAType = "KAMA"
+	MATypeMAMA  MAType = "MAMA"
+	MATypeT3MA  MAType = "T3MA"
+)
+
+var maTypes = []MAType{
+	MATypeSMA, MATypeEMA, MATypeWMA, MATypeDEMA, MATypeTEMA, MATypeTRIMA, MATypeKAMA, MATypeMAMA, MATypeT3MA,
+}
+
+// ParseMAType returns the MAType named s, ignoring case.
+func ParseMAType(s string) (MAType, error) {
+	return parseEnum("moving average type", s, maTypes)
+}
+
+// IsValid reports whether t is a supported moving average type, ignoring case.
+func (t MAType) IsValid() bool {
+	return isEnum(t, maTypes)
+}
+
+func (t MAType) allowed() []string {
+	return enumStrings(maTypes)
+}
+
+// Period is the period covered by a financial statement.
+type Period string
+
+// Statement periods supported by the API.
+const (
+	PeriodAnnual    Period = "annual"
+	PeriodQuarterly Period = "quarterly"
+)
+
+var periods = []Period{PeriodAnnual, PeriodQuarterly}
+
+// ParsePeriod returns the Period named s, ignoring case.
+func ParsePeriod(s string) (Period, error) {
+	return parseEnum("period", s, periods)
+}
+
+// IsValid reports whether p is a supported statement period, ignoring case.
+func (p Period) IsValid() bool {
+	return isEnum(p, periods)
+}
+
+func (p Period) allowed() []string {
+	return enumStrings(periods)
+}
+
+// Range is the time range of dividends and splits.
+type Range string
+
+// Ranges supported by the API.
+const (
+	RangeLast Range = "last"
+	RangeNext Range = "next"
+	Range1M   Range = "1m"
+	Range3M   Range = "3m"
+	Range6M   Range = "6m"
+	RangeYTD  Range = "ytd"
+	Range1Y   Range = "1y"
+	Range2Y   Range = "2y"
+	Range5Y   Range = "5y"
+	RangeFull Range = "full"
+)
+
+var ranges = []Range{RangeLast, RangeNext, Range1M, Range3M, Range6M, RangeYTD, Range1Y, Range2Y, Range5Y, RangeFull}
+
+// ParseRange returns the Range named s, ignoring case.
+func ParseRange(s string) (Range, error) {
+	return parseEnum("range", s, ranges)
+}
+
+// IsValid reports whether r is a supported range, ignoring case.
+func (r Range) IsValid() bool {
+	return isEnum(r, ranges)
+}
+
+func (r Range) allowed() []string {
+	return enumStrings(ranges)
+}
+
+// Market is the market of market movers.
+type Market string
+
+// Markets supported by the market movers endpoint.
+const (
+	MarketStocks      Market = "stocks"
+	MarketETF         Market = "etf"
+	MarketMutualFunds Market = "mutual_funds"
+	MarketForex       Market = "forex"
+	MarketCrypto      Market = "crypto"
+)
+
+var markets = []Market{MarketStocks, MarketETF, MarketMutualFunds, MarketForex, MarketCrypto}
+
+// ParseMarket returns the Market named s, ignoring case.
+func ParseMarket(s string) (Market, error) {
+	return parseEnum("market", s, markets)
+}
+
+// IsValid reports whether m is a supported market, ignoring case.
+func (m Market) IsValid() bool {
+	return isEnum(m, markets)
+}
+
+func (m Market) allowed() []string {
+	return enumStrings(markets)
+}
+
+// Direction selects the top gainers or the top losers of market movers.
+type Direction string
+
+// Directions supported by the market movers endpoint.
+const (
+	DirectionGainers Direction = "gainers"
+	DirectionLosers  Direction = "losers"
+)
+
+var directions = []Direction{DirectionGainers, DirectionLosers}
+
+// ParseDirection returns the Direction named s, ignoring case.
+func ParseDirection(s string) (Direction, error) {
+	return parseEnum("direction", s, directions)
+}
+
+// IsValid reports whether d is a supported direction, ignoring case.
+func (d Direction) IsValid() bool {
+	return isEnum(d, directions)
+}
+
+func (d Direction) allowed() []string {
+	return enumStrings(directions)
+}
+
+// SanctionsSource is the sanctions list of sanctioned entities.
+type SanctionsSource string
+
+// Sanctions lists supported by the sanctioned entities endpoint.
+const (
+	SanctionsSourceOFAC SanctionsSource = "ofac"
+	SanctionsSourceUK   SanctionsSource = "uk"
+	SanctionsSourceEU   SanctionsSource = "eu"
+	SanctionsSourceAU   SanctionsSource = "au"
+)
+
+var sanctionsSources = []SanctionsSource{SanctionsSourceOFAC, SanctionsSourceUK, SanctionsSourceEU, SanctionsSourceAU}
+
+// ParseSanctionsSource returns the SanctionsSource named s, ignoring case.
+func ParseSanctionsSource(s string) (SanctionsSource, error) {
+	return parseEnum("sanctions source", s, sanctionsSources)
+}
+
+// IsValid reports whether s is a supported sanctions list, ignoring case.
+func (s SanctionsSource) IsValid() bool {
+	return isEnum(s, sanctionsSources)
+}
+
+func (s SanctionsSource) allowed() []string {
+	return enumStrings(sanctionsSources)
+}
+
+// LastChangeEndpoint is an endpoint whose last changes can be tracked.
+type LastChangeEndpoint string
+
+// Endpoints supported by the last change endpoint.
+const (
+	LastChangePriceTarget                    LastChangeEndpoint = "price_target"
+	LastChangeRecommendations                LastChangeEndpoint = "recommendations"
+	LastChangeStatistics                     LastChangeEndpoint = "statistics"
+	LastChangeInsiderTransactions            LastChangeEndpoint = "insider_transactions"
+	LastChangeProfile                        LastChangeEndpoint = "profile"
+	LastChangeInstitutionalHolders           LastChangeEndpoint = "institutional_holders"
+	LastChangeAnalystRating                  LastChangeEndpoint = "analyst_rating"
+	LastChangeIncomeStatement                LastChangeEndpoint = "income_statement"
+	LastChangeIncomeStatementQuarterly       LastChangeEndpoint = "income_statement_quarterly"
+	LastChangeCashFlow                       LastChangeEndpoint = "cash_flow"
+	LastChangeCashFlowQuarterly              LastChangeEndpoint = "cash_flow_quarterly"
+	LastChangeBalanceSheet                   LastChangeEndpoint = "balance_sheet"
+	LastChangeBalanceSheetQuarterly          LastChangeEndpoint = "balance_sheet_quarterly"
+	LastChangeEarningsEstimate               LastChangeEndpoint = "earnings_estimate"
+	LastChangeRevenueEstimate                LastChangeEndpoint = "revenue_estimate"
+	LastChangeEPSTrend                       LastChangeEndpoint = "eps_trend"
+	LastChangeEPSRevisions                   LastChangeEndpoint = "eps_revisions"
+	LastChangeGrowthEstimates                LastChangeEndpoint = "growth_estimates"
+	LastChangeMutualFundsList                LastChangeEndpoint = "mutual_funds_list"
+	LastChangeMutualFundsWorld               LastChangeEndpoint = "mutual_funds_world"
+	LastChangeMutualFundsWorldSummary        LastChangeEndpoint = "mutual_funds_world_summary"
+	LastChangeMutualFundsWorldPerformance    LastChangeEndpoint = "mutual_funds_world_performance"
+	LastChangeMutualFundsWorldRisk           LastChangeEndpoint = "mutual_funds_world_risk"
+	LastChangeMutualFundsWorldRatings        LastChangeEndpoint = "mutual_funds_world_ratings"
+	LastChangeMutualFundsWorldComposition    LastChangeEndpoint = "mutual_funds_world_composition"
+	LastChangeMutualFundsWorldPurchaseInfo   LastChangeEndpoint = "mutual_funds_world_purchase_info"
+	LastChangeMutualFundsWorldSustainability LastChangeEndpoint = "mutual_funds_world_sustainability"
+)
+
+var lastChangeEndpoints = []LastChangeEndpoint{
+	LastChangePriceTarget, LastChangeRecommendations, LastChangeStatistics, LastChangeInsiderTransactions,
+	LastChangeProfile, LastChangeInstitutionalHolders, LastChangeAnalystRating, LastChangeIncomeStatement,
+	LastChangeIncomeStatementQuarterly, LastChangeCashFlow, LastChangeCashFlowQuarterly, LastChangeBalanceSheet,
+	LastChangeBalanceSheetQuarterly, LastChangeEarningsEstimate, LastChangeRevenueEstimate, LastChangeEPSTrend,
+	LastChangeEPSRevisions, LastChangeGrowthEstimates, LastChangeMutualFundsList, LastChangeMutualFundsWorld,
+	LastChangeMutualFundsWorldSummary, LastChangeMutualFundsWorldPerformance, LastChangeMutualFundsWorldRisk,
+	LastChangeMutualFundsWorldRatings, LastChangeMutualFundsWorldComposition, LastChangeMutualFundsWorldPurchaseInfo,
+	LastChangeMutualFundsWorldSustainability,
+}
+
+// ParseLastChangeEndpoint returns the LastChangeEndpoint named s, ignoring case.
+func ParseLastChangeEndpoint(s string) (LastChangeEndpoint, error) {
+	return parseEnum("last change endpoint", s, lastChangeEndpoints)
+}
+
+// IsValid reports whether e is an endpoint whose changes can be tracked, ignoring case.
+func (e LastChangeEndpoint) IsValid() bool {
+	return isEnum(e, lastChangeEndpoints)
+}
+
+func (e LastChangeEndpoint) allowed() []string {
+	return enumStrings(lastChangeEndpoints)
+}
+
+// enum is implemented by the enumeration types, so validateFields can check them.
+type enum interface {
+	IsValid() bool
+	allowed() []string
+}
+
+// parseEnum returns the value of values equal to s ignoring case, which returns the canonical spelling.
+func parseEnum[T ~string](name, s string, values []T) (T, error) {
+	for _, value := range values {
+		if strings.EqualFold(string(value), s) {
+			return value, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown %s %q, expected one of %s", name, s, strings.Join(enumStrings(values), ", "))
+}
+
+func isEnum[T ~string](value T, values []T) bool {
+	for _, candidate := range values {
+		if strings.EqualFold(string(candidate), string(value)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func enumStrings[T ~string](values []T) []string {
+	strs := make([]string, 0, len(values))
+	for _, value := range values {
+		strs = append(strs, string(value))
+	}
+
+	return strs
+}
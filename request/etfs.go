@@ -11,7 +11,7 @@ type GetETFs struct {
 	Exchange        string `schema:"exchange,omitempty"`
 	MicCode         string `schema:"mic_code,omitempty"`
 	Country         string `schema:"country,omitempty"`
-	Format          string `schema:"format,omitempty"`
+	Format          Format `schema:"format,omitempty"`
 	Delimiter       string `schema:"delimiter,omitempty"`
 	ShowPlan        bool   `schema:"show_plan,omitempty"`
 	IncludeDelisted bool   `schema:"include_delisted,omitempty"`
@@ -7,7 +7,7 @@ type GetExchanges struct {
 	Name           string `schema:"name,omitempty"`
 	Code           string `schema:"code,omitempty"`
 	Country        string `schema:"country,omitempty"`
-	Format         string `schema:"format,omitempty"`
+	Format         Format `schema:"format,omitempty"`
 	Delimiter      string `schema:"delimiter,omitempty"`
 	ShowPlan       bool   `schema:"show_plan,omitempty"`
 }
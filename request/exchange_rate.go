@@ -5,7 +5,7 @@ type GetExchangeRate struct {
 	APIKey
 	Symbol        string `schema:"symbol,omitempty"`
 	Date          string `schema:"date,omitempty"`
-	Format        string `schema:"format,omitempty"`
+	Format        Format `schema:"format,omitempty"`
 	Delimiter     string `schema:"delimiter,omitempty"`
 	DecimalPlaces int    `schema:"dp,omitempty"`
 	TimeZone      string `schema:"timezone,omitempty"`
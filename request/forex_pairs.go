@@ -6,6 +6,6 @@ type GetForexPairs struct {
 	Symbol        string `schema:"symbol,omitempty"`
 	CurrencyBase  string `schema:"currency_base,omitempty"`
 	CurrencyQuote string `schema:"currency_quote,omitempty"`
-	Format        string `schema:"format,omitempty"`
+	Format        Format `schema:"format,omitempty"`
 	Delimiter     string `schema:"delimiter,omitempty"`
 }
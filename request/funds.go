@@ -10,7 +10,7 @@ type GetFunds struct {
 	Cik        string `schema:"cik,omitempty"`
 	Exchange   string `schema:"exchange,omitempty"`
 	Country    string `schema:"country,omitempty"`
-	Format     string `schema:"format,omitempty"`
+	Format     Format `schema:"format,omitempty"`
 	Delimiter  string `schema:"delimiter,omitempty"`
 	ShowPlan   bool   `schema:"show_plan,omitempty"`
 	Page       int    `schema:"page,omitempty"`
@@ -0,0 +1,43 @@
+package request
+
+// GetIndicator represents the request parameters shared by every technical indicator endpoint.
+// Params holds the parameters specific to the indicator, such as "time_period" or "series_type",
+// as strings, numbers, booleans or typed values like SeriesType and MAType.
+type GetIndicator struct {
+	APIKey
+	Symbol        string         `schema:"symbol,omitempty"`
+	FIGI          string         `schema:"figi,omitempty"`
+	ISIN          string         `schema:"isin,omitempty"`
+	CUSIP         string         `schema:"cusip,omitempty"`
+	Interval      Interval       `schema:"interval,omitempty"`
+	Exchange      string         `schema:"exchange,omitempty"`
+	MICCode       string         `schema:"mic_code,omitempty"`
+	Country       string         `schema:"country,omitempty"`
+	Type          string         `schema:"type,omitempty"`
+	OutputSize    int            `schema:"outputsize,omitempty"`
+	Prepost       bool           `schema:"prepost,omitempty"`
+	DP            int            `schema:"dp,omitempty"`
+	Order         Order          `schema:"order,omitempty"`
+	IncludeOHLC   bool           `schema:"include_ohlc,omitempty"`
+	Timezone      string         `schema:"timezone,omitempty"`
+	Date          string         `schema:"date,omitempty"`
+	StartDate     string         `schema:"start_date,omitempty"`
+	EndDate       string         `schema:"end_date,omitempty"`
+	PreviousClose bool           `schema:"previous_close,omitempty"`
+	Adjust        Adjust         `schema:"adjust,omitempty"`
+	Params        map[string]any `schema:"-"`
+}
+
+// Validate checks the parameters of req before it is sent.
+func (req GetIndicator) Validate() error {
+	return validateInstrument(req, "symbol", "interval").err()
+}
+
+// IndicatorParams are the typed parameters of one indicator, generated for every indicator listed by
+// the technical indicators endpoint.
+type IndicatorParams interface {
+	// Indicator returns the name of the indicator, e.g. "aroon".
+	Indicator() string
+	// Params returns the set parameters keyed by their API name.
+	Params() map[string]any
+}
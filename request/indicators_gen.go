@@ -0,0 +1,2673 @@
+// Code generated by genindicators from technical_indicators.json. DO NOT EDIT.
+
+package request
+
+// ADParams are the parameters of the Chaikin A/D Line indicator, ad.
+type ADParams struct{}
+
+// Indicator returns "ad".
+func (ADParams) Indicator() string {
+	return "ad"
+}
+
+// Params returns the set parameters.
+func (ADParams) Params() map[string]any {
+	return map[string]any{}
+}
+
+// AddParams are the parameters of the Addition indicator, add.
+type AddParams struct {
+	SeriesType1 SeriesType // series_type_1, open by default
+	SeriesType2 SeriesType // series_type_2, close by default
+}
+
+// Indicator returns "add".
+func (AddParams) Indicator() string {
+	return "add"
+}
+
+// Params returns the set parameters.
+func (p AddParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType1 != "" {
+		params["series_type_1"] = p.SeriesType1
+	}
+
+	if p.SeriesType2 != "" {
+		params["series_type_2"] = p.SeriesType2
+	}
+
+	return params
+}
+
+// ADOSCParams are the parameters of the Chaikin A/D Oscillator indicator, adosc.
+type ADOSCParams struct {
+	FastPeriod int // fast_period, 12 by default
+	SlowPeriod int // slow_period, 26 by default
+}
+
+// Indicator returns "adosc".
+func (ADOSCParams) Indicator() string {
+	return "adosc"
+}
+
+// Params returns the set parameters.
+func (p ADOSCParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.FastPeriod != 0 {
+		params["fast_period"] = p.FastPeriod
+	}
+
+	if p.SlowPeriod != 0 {
+		params["slow_period"] = p.SlowPeriod
+	}
+
+	return params
+}
+
+// ADXParams are the parameters of the Average Directional Index indicator, adx.
+type ADXParams struct {
+	TimePeriod int // time_period, 14 by default
+}
+
+// Indicator returns "adx".
+func (ADXParams) Indicator() string {
+	return "adx"
+}
+
+// Params returns the set parameters.
+func (p ADXParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// ADXRParams are the parameters of the Average Directional Movement Index Rating indicator, adxr.
+type ADXRParams struct {
+	TimePeriod int // time_period, 14 by default
+}
+
+// Indicator returns "adxr".
+func (ADXRParams) Indicator() string {
+	return "adxr"
+}
+
+// Params returns the set parameters.
+func (p ADXRParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// APOParams are the parameters of the Absolute Price Oscillator indicator, apo.
+type APOParams struct {
+	FastPeriod int        // fast_period, 12 by default
+	MAType     MAType     // ma_type, SMA by default
+	SeriesType SeriesType // series_type, close by default
+	SlowPeriod int        // slow_period, 26 by default
+}
+
+// Indicator returns "apo".
+func (APOParams) Indicator() string {
+	return "apo"
+}
+
+// Params returns the set parameters.
+func (p APOParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.FastPeriod != 0 {
+		params["fast_period"] = p.FastPeriod
+	}
+
+	if p.MAType != "" {
+		params["ma_type"] = p.MAType
+	}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.SlowPeriod != 0 {
+		params["slow_period"] = p.SlowPeriod
+	}
+
+	return params
+}
+
+// AroonParams are the parameters of the Aroon Indicator indicator, aroon.
+type AroonParams struct {
+	TimePeriod int // time_period, 14 by default
+}
+
+// Indicator returns "aroon".
+func (AroonParams) Indicator() string {
+	return "aroon"
+}
+
+// Params returns the set parameters.
+func (p AroonParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// AroonOscParams are the parameters of the Aroon Oscillator indicator, aroonosc.
+type AroonOscParams struct {
+	TimePeriod int // time_period, 14 by default
+}
+
+// Indicator returns "aroonosc".
+func (AroonOscParams) Indicator() string {
+	return "aroonosc"
+}
+
+// Params returns the set parameters.
+func (p AroonOscParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// ATRParams are the parameters of the Average True Range indicator, atr.
+type ATRParams struct {
+	TimePeriod int // time_period, 14 by default
+}
+
+// Indicator returns "atr".
+func (ATRParams) Indicator() string {
+	return "atr"
+}
+
+// Params returns the set parameters.
+func (p ATRParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// AvgParams are the parameters of the Average indicator, avg.
+type AvgParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "avg".
+func (AvgParams) Indicator() string {
+	return "avg"
+}
+
+// Params returns the set parameters.
+func (p AvgParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// AvgPriceParams are the parameters of the Average Price indicator, avgprice.
+type AvgPriceParams struct{}
+
+// Indicator returns "avgprice".
+func (AvgPriceParams) Indicator() string {
+	return "avgprice"
+}
+
+// Params returns the set parameters.
+func (AvgPriceParams) Params() map[string]any {
+	return map[string]any{}
+}
+
+// BBandsParams are the parameters of the Bollinger Bands indicator, bbands.
+type BBandsParams struct {
+	MAType     MAType     // ma_type, SMA by default
+	SD         float64    // sd, 2 by default
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 20 by default
+}
+
+// Indicator returns "bbands".
+func (BBandsParams) Indicator() string {
+	return "bbands"
+}
+
+// Params returns the set parameters.
+func (p BBandsParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.MAType != "" {
+		params["ma_type"] = p.MAType
+	}
+
+	if p.SD != 0 {
+		params["sd"] = p.SD
+	}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// BetaParams are the parameters of the Beta indicator, beta.
+type BetaParams struct {
+	SeriesType1 SeriesType // series_type_1, open by default
+	SeriesType2 SeriesType // series_type_2, close by default
+	TimePeriod  int        // time_period, 9 by default
+}
+
+// Indicator returns "beta".
+func (BetaParams) Indicator() string {
+	return "beta"
+}
+
+// Params returns the set parameters.
+func (p BetaParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType1 != "" {
+		params["series_type_1"] = p.SeriesType1
+	}
+
+	if p.SeriesType2 != "" {
+		params["series_type_2"] = p.SeriesType2
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// BOPParams are the parameters of the Balance Of Power indicator, bop.
+type BOPParams struct{}
+
+// Indicator returns "bop".
+func (BOPParams) Indicator() string {
+	return "bop"
+}
+
+// Params returns the set parameters.
+func (BOPParams) Params() map[string]any {
+	return map[string]any{}
+}
+
+// CCIParams are the parameters of the Commodity Channel Index indicator, cci.
+type CCIParams struct {
+	TimePeriod int // time_period, 20 by default
+}
+
+// Indicator returns "cci".
+func (CCIParams) Indicator() string {
+	return "cci"
+}
+
+// Params returns the set parameters.
+func (p CCIParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// CeilParams are the parameters of the Vector Ceil indicator, ceil.
+type CeilParams struct {
+	SeriesType SeriesType // series_type, close by default
+}
+
+// Indicator returns "ceil".
+func (CeilParams) Indicator() string {
+	return "ceil"
+}
+
+// Params returns the set parameters.
+func (p CeilParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	return params
+}
+
+// CMOParams are the parameters of the Chande Momentum Oscillator indicator, cmo.
+type CMOParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "cmo".
+func (CMOParams) Indicator() string {
+	return "cmo"
+}
+
+// Params returns the set parameters.
+func (p CMOParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// CoppockParams are the parameters of the Coppock Curve indicator, coppock.
+type CoppockParams struct {
+	LongROCPeriod  int        // long_roc_period, 14 by default
+	SeriesType     SeriesType // series_type, close by default
+	ShortROCPeriod int        // short_roc_period, 11 by default
+	WMAPeriod      int        // wma_period, 10 by default
+}
+
+// Indicator returns "coppock".
+func (CoppockParams) Indicator() string {
+	return "coppock"
+}
+
+// Params returns the set parameters.
+func (p CoppockParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.LongROCPeriod != 0 {
+		params["long_roc_period"] = p.LongROCPeriod
+	}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.ShortROCPeriod != 0 {
+		params["short_roc_period"] = p.ShortROCPeriod
+	}
+
+	if p.WMAPeriod != 0 {
+		params["wma_period"] = p.WMAPeriod
+	}
+
+	return params
+}
+
+// CorrelParams are the parameters of the Pearson's Correlation Coefficient indicator, correl.
+type CorrelParams struct {
+	SeriesType1 SeriesType // series_type_1, open by default
+	SeriesType2 SeriesType // series_type_2, close by default
+	TimePeriod  int        // time_period, 9 by default
+}
+
+// Indicator returns "correl".
+func (CorrelParams) Indicator() string {
+	return "correl"
+}
+
+// Params returns the set parameters.
+func (p CorrelParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType1 != "" {
+		params["series_type_1"] = p.SeriesType1
+	}
+
+	if p.SeriesType2 != "" {
+		params["series_type_2"] = p.SeriesType2
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// CRSIParams are the parameters of the ConnorsRSI indicator, crsi.
+type CRSIParams struct {
+	PercentRankPeriod int        // percent_rank_period, 100 by default
+	RSIPeriod         int        // rsi_period, 3 by default
+	SeriesType        SeriesType // series_type, close by default
+	UpDownLength      int        // up_down_length, 2 by default
+}
+
+// Indicator returns "crsi".
+func (CRSIParams) Indicator() string {
+	return "crsi"
+}
+
+// Params returns the set parameters.
+func (p CRSIParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.PercentRankPeriod != 0 {
+		params["percent_rank_period"] = p.PercentRankPeriod
+	}
+
+	if p.RSIPeriod != 0 {
+		params["rsi_period"] = p.RSIPeriod
+	}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.UpDownLength != 0 {
+		params["up_down_length"] = p.UpDownLength
+	}
+
+	return params
+}
+
+// DEMAParams are the parameters of the Double Exponential Moving Average indicator, dema.
+type DEMAParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "dema".
+func (DEMAParams) Indicator() string {
+	return "dema"
+}
+
+// Params returns the set parameters.
+func (p DEMAParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// DivParams are the parameters of the Division indicator, div.
+type DivParams struct {
+	SeriesType1 SeriesType // series_type_1, open by default
+	SeriesType2 SeriesType // series_type_2, close by default
+}
+
+// Indicator returns "div".
+func (DivParams) Indicator() string {
+	return "div"
+}
+
+// Params returns the set parameters.
+func (p DivParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType1 != "" {
+		params["series_type_1"] = p.SeriesType1
+	}
+
+	if p.SeriesType2 != "" {
+		params["series_type_2"] = p.SeriesType2
+	}
+
+	return params
+}
+
+// DPOParams are the parameters of the Detrended Price Oscillator indicator, dpo.
+type DPOParams struct {
+	Centered   bool       // centered, false by default
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 21 by default
+}
+
+// Indicator returns "dpo".
+func (DPOParams) Indicator() string {
+	return "dpo"
+}
+
+// Params returns the set parameters.
+func (p DPOParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.Centered {
+		params["centered"] = p.Centered
+	}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// DXParams are the parameters of the Directional Movement Index indicator, dx.
+type DXParams struct {
+	TimePeriod int // time_period, 14 by default
+}
+
+// Indicator returns "dx".
+func (DXParams) Indicator() string {
+	return "dx"
+}
+
+// Params returns the set parameters.
+func (p DXParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// EMAParams are the parameters of the Exponential Moving Average indicator, ema.
+type EMAParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "ema".
+func (EMAParams) Indicator() string {
+	return "ema"
+}
+
+// Params returns the set parameters.
+func (p EMAParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// ExpParams are the parameters of the Exponential indicator, exp.
+type ExpParams struct {
+	SeriesType SeriesType // series_type, close by default
+}
+
+// Indicator returns "exp".
+func (ExpParams) Indicator() string {
+	return "exp"
+}
+
+// Params returns the set parameters.
+func (p ExpParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	return params
+}
+
+// FloorParams are the parameters of the Vector Floor indicator, floor.
+type FloorParams struct {
+	SeriesType SeriesType // series_type, close by default
+}
+
+// Indicator returns "floor".
+func (FloorParams) Indicator() string {
+	return "floor"
+}
+
+// Params returns the set parameters.
+func (p FloorParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	return params
+}
+
+// HeikinAshiCandlesParams are the parameters of the Heikin-Ashi Candles indicator, heikinashicandles.
+type HeikinAshiCandlesParams struct{}
+
+// Indicator returns "heikinashicandles".
+func (HeikinAshiCandlesParams) Indicator() string {
+	return "heikinashicandles"
+}
+
+// Params returns the set parameters.
+func (HeikinAshiCandlesParams) Params() map[string]any {
+	return map[string]any{}
+}
+
+// HLC3Params are the parameters of the High, Low, Close Average indicator, hlc3.
+type HLC3Params struct{}
+
+// Indicator returns "hlc3".
+func (HLC3Params) Indicator() string {
+	return "hlc3"
+}
+
+// Params returns the set parameters.
+func (HLC3Params) Params() map[string]any {
+	return map[string]any{}
+}
+
+// HTDCPeriodParams are the parameters of the Hilbert Transform Dominant Cycle Period indicator, ht_dcperiod.
+type HTDCPeriodParams struct {
+	SeriesType SeriesType // series_type, close by default
+}
+
+// Indicator returns "ht_dcperiod".
+func (HTDCPeriodParams) Indicator() string {
+	return "ht_dcperiod"
+}
+
+// Params returns the set parameters.
+func (p HTDCPeriodParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	return params
+}
+
+// HTDCPhaseParams are the parameters of the Hilbert Transform Dominant Cycle Phase indicator, ht_dcphase.
+type HTDCPhaseParams struct {
+	SeriesType SeriesType // series_type, close by default
+}
+
+// Indicator returns "ht_dcphase".
+func (HTDCPhaseParams) Indicator() string {
+	return "ht_dcphase"
+}
+
+// Params returns the set parameters.
+func (p HTDCPhaseParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	return params
+}
+
+// HTPhasorParams are the parameters of the Hilbert Transform Phasor Components indicator, ht_phasor.
+type HTPhasorParams struct {
+	SeriesType SeriesType // series_type, close by default
+}
+
+// Indicator returns "ht_phasor".
+func (HTPhasorParams) Indicator() string {
+	return "ht_phasor"
+}
+
+// Params returns the set parameters.
+func (p HTPhasorParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	return params
+}
+
+// HTSineParams are the parameters of the Hilbert Transform SineWave indicator, ht_sine.
+type HTSineParams struct {
+	SeriesType SeriesType // series_type, close by default
+}
+
+// Indicator returns "ht_sine".
+func (HTSineParams) Indicator() string {
+	return "ht_sine"
+}
+
+// Params returns the set parameters.
+func (p HTSineParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	return params
+}
+
+// HTTrendlineParams are the parameters of the Hilbert Transform Instantaneous Trendline indicator, ht_trendline.
+type HTTrendlineParams struct {
+	SeriesType SeriesType // series_type, close by default
+}
+
+// Indicator returns "ht_trendline".
+func (HTTrendlineParams) Indicator() string {
+	return "ht_trendline"
+}
+
+// Params returns the set parameters.
+func (p HTTrendlineParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	return params
+}
+
+// HTTrendModeParams are the parameters of the Hilbert Transform Trend vs Cycle Mode indicator, ht_trendmode.
+type HTTrendModeParams struct {
+	SeriesType SeriesType // series_type, close by default
+}
+
+// Indicator returns "ht_trendmode".
+func (HTTrendModeParams) Indicator() string {
+	return "ht_trendmode"
+}
+
+// Params returns the set parameters.
+func (p HTTrendModeParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	return params
+}
+
+// IchimokuParams are the parameters of the Ichimoku Kinkō Hyō indicator, ichimoku.
+type IchimokuParams struct {
+	BaseLinePeriod         int  // base_line_period, 26 by default
+	ConversionLinePeriod   int  // conversion_line_period, 9 by default
+	IncludeAheadSpanPeriod bool // include_ahead_span_period, true by default
+	LaggingSpanPeriod      int  // lagging_span_period, 26 by default
+	LeadingSpanBPeriod     int  // leading_span_b_period, 52 by default
+}
+
+// Indicator returns "ichimoku".
+func (IchimokuParams) Indicator() string {
+	return "ichimoku"
+}
+
+// Params returns the set parameters.
+func (p IchimokuParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.BaseLinePeriod != 0 {
+		params["base_line_period"] = p.BaseLinePeriod
+	}
+
+	if p.ConversionLinePeriod != 0 {
+		params["conversion_line_period"] = p.ConversionLinePeriod
+	}
+
+	if p.IncludeAheadSpanPeriod {
+		params["include_ahead_span_period"] = p.IncludeAheadSpanPeriod
+	}
+
+	if p.LaggingSpanPeriod != 0 {
+		params["lagging_span_period"] = p.LaggingSpanPeriod
+	}
+
+	if p.LeadingSpanBPeriod != 0 {
+		params["leading_span_b_period"] = p.LeadingSpanBPeriod
+	}
+
+	return params
+}
+
+// KAMAParams are the parameters of the Kaufman Adaptive Moving Average indicator, kama.
+type KAMAParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "kama".
+func (KAMAParams) Indicator() string {
+	return "kama"
+}
+
+// Params returns the set parameters.
+func (p KAMAParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// KeltnerParams are the parameters of the Keltner Channels indicator, keltner.
+type KeltnerParams struct {
+	ATRTimePeriod int        // atr_time_period, 10 by default
+	MAType        MAType     // ma_type, EMA by default
+	Multiplier    int        // multiplier, 2 by default
+	SeriesType    SeriesType // series_type, close by default
+	TimePeriod    int        // time_period, 20 by default
+}
+
+// Indicator returns "keltner".
+func (KeltnerParams) Indicator() string {
+	return "keltner"
+}
+
+// Params returns the set parameters.
+func (p KeltnerParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.ATRTimePeriod != 0 {
+		params["atr_time_period"] = p.ATRTimePeriod
+	}
+
+	if p.MAType != "" {
+		params["ma_type"] = p.MAType
+	}
+
+	if p.Multiplier != 0 {
+		params["multiplier"] = p.Multiplier
+	}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// KSTParams are the parameters of the Know Sure Thing indicator, kst.
+type KSTParams struct {
+	ROCPeriod1   int // roc_period_1, 10 by default
+	ROCPeriod2   int // roc_period_2, 15 by default
+	ROCPeriod3   int // roc_period_3, 20 by default
+	ROCPeriod4   int // roc_period_4, 30 by default
+	SignalPeriod int // signal_period, 9 by default
+	SMAPeriod1   int // sma_period_1, 10 by default
+	SMAPeriod2   int // sma_period_2, 10 by default
+	SMAPeriod3   int // sma_period_3, 10 by default
+	SMAPeriod4   int // sma_period_4, 15 by default
+}
+
+// Indicator returns "kst".
+func (KSTParams) Indicator() string {
+	return "kst"
+}
+
+// Params returns the set parameters.
+func (p KSTParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.ROCPeriod1 != 0 {
+		params["roc_period_1"] = p.ROCPeriod1
+	}
+
+	if p.ROCPeriod2 != 0 {
+		params["roc_period_2"] = p.ROCPeriod2
+	}
+
+	if p.ROCPeriod3 != 0 {
+		params["roc_period_3"] = p.ROCPeriod3
+	}
+
+	if p.ROCPeriod4 != 0 {
+		params["roc_period_4"] = p.ROCPeriod4
+	}
+
+	if p.SignalPeriod != 0 {
+		params["signal_period"] = p.SignalPeriod
+	}
+
+	if p.SMAPeriod1 != 0 {
+		params["sma_period_1"] = p.SMAPeriod1
+	}
+
+	if p.SMAPeriod2 != 0 {
+		params["sma_period_2"] = p.SMAPeriod2
+	}
+
+	if p.SMAPeriod3 != 0 {
+		params["sma_period_3"] = p.SMAPeriod3
+	}
+
+	if p.SMAPeriod4 != 0 {
+		params["sma_period_4"] = p.SMAPeriod4
+	}
+
+	return params
+}
+
+// LinearRegParams are the parameters of the Linear Regression indicator, linearreg.
+type LinearRegParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "linearreg".
+func (LinearRegParams) Indicator() string {
+	return "linearreg"
+}
+
+// Params returns the set parameters.
+func (p LinearRegParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// LinearRegAngleParams are the parameters of the Linear Regression Angle indicator, linearregangle.
+type LinearRegAngleParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "linearregangle".
+func (LinearRegAngleParams) Indicator() string {
+	return "linearregangle"
+}
+
+// Params returns the set parameters.
+func (p LinearRegAngleParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// LinearRegInterceptParams are the parameters of the Linear Regression Intercept indicator, linearregintercept.
+type LinearRegInterceptParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "linearregintercept".
+func (LinearRegInterceptParams) Indicator() string {
+	return "linearregintercept"
+}
+
+// Params returns the set parameters.
+func (p LinearRegInterceptParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// LinearRegSlopeParams are the parameters of the Linear Regression Slope indicator, linearregslope.
+type LinearRegSlopeParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "linearregslope".
+func (LinearRegSlopeParams) Indicator() string {
+	return "linearregslope"
+}
+
+// Params returns the set parameters.
+func (p LinearRegSlopeParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// LnParams are the parameters of the Natural Logarithm indicator, ln.
+type LnParams struct {
+	SeriesType SeriesType // series_type, close by default
+}
+
+// Indicator returns "ln".
+func (LnParams) Indicator() string {
+	return "ln"
+}
+
+// Params returns the set parameters.
+func (p LnParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	return params
+}
+
+// Log10Params are the parameters of the Base-10 Logarithm indicator, log10.
+type Log10Params struct {
+	SeriesType SeriesType // series_type, close by default
+}
+
+// Indicator returns "log10".
+func (Log10Params) Indicator() string {
+	return "log10"
+}
+
+// Params returns the set parameters.
+func (p Log10Params) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	return params
+}
+
+// MAParams are the parameters of the Moving Average indicator, ma.
+type MAParams struct {
+	MAType     MAType     // ma_type, SMA by default
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "ma".
+func (MAParams) Indicator() string {
+	return "ma"
+}
+
+// Params returns the set parameters.
+func (p MAParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.MAType != "" {
+		params["ma_type"] = p.MAType
+	}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// MACDParams are the parameters of the Moving Average Convergence Divergence indicator, macd.
+type MACDParams struct {
+	FastPeriod   int        // fast_period, 12 by default
+	SeriesType   SeriesType // series_type, close by default
+	SignalPeriod int        // signal_period, 9 by default
+	SlowPeriod   int        // slow_period, 26 by default
+}
+
+// Indicator returns "macd".
+func (MACDParams) Indicator() string {
+	return "macd"
+}
+
+// Params returns the set parameters.
+func (p MACDParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.FastPeriod != 0 {
+		params["fast_period"] = p.FastPeriod
+	}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.SignalPeriod != 0 {
+		params["signal_period"] = p.SignalPeriod
+	}
+
+	if p.SlowPeriod != 0 {
+		params["slow_period"] = p.SlowPeriod
+	}
+
+	return params
+}
+
+// MACDSlopeParams are the parameters of the Moving Average Convergence Divergence Regression Slope indicator, macd_slope.
+type MACDSlopeParams struct {
+	FastPeriod   int        // fast_period, 12 by default
+	SeriesType   SeriesType // series_type, close by default
+	SignalPeriod int        // signal_period, 9 by default
+	SlowPeriod   int        // slow_period, 26 by default
+	TimePeriod   int        // time_period, 9 by default
+}
+
+// Indicator returns "macd_slope".
+func (MACDSlopeParams) Indicator() string {
+	return "macd_slope"
+}
+
+// Params returns the set parameters.
+func (p MACDSlopeParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.FastPeriod != 0 {
+		params["fast_period"] = p.FastPeriod
+	}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.SignalPeriod != 0 {
+		params["signal_period"] = p.SignalPeriod
+	}
+
+	if p.SlowPeriod != 0 {
+		params["slow_period"] = p.SlowPeriod
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// MACDExtParams are the parameters of the Moving Average Convergence Divergence Extended indicator, macdext.
+type MACDExtParams struct {
+	FastMAType   MAType     // fast_ma_type, SMA by default
+	FastPeriod   int        // fast_period, 12 by default
+	SeriesType   SeriesType // series_type, close by default
+	SignalMAType MAType     // signal_ma_type, SMA by default
+	SignalPeriod int        // signal_period, 9 by default
+	SlowMAType   MAType     // slow_ma_type, SMA by default
+	SlowPeriod   int        // slow_period, 26 by default
+}
+
+// Indicator returns "macdext".
+func (MACDExtParams) Indicator() string {
+	return "macdext"
+}
+
+// Params returns the set parameters.
+func (p MACDExtParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.FastMAType != "" {
+		params["fast_ma_type"] = p.FastMAType
+	}
+
+	if p.FastPeriod != 0 {
+		params["fast_period"] = p.FastPeriod
+	}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.SignalMAType != "" {
+		params["signal_ma_type"] = p.SignalMAType
+	}
+
+	if p.SignalPeriod != 0 {
+		params["signal_period"] = p.SignalPeriod
+	}
+
+	if p.SlowMAType != "" {
+		params["slow_ma_type"] = p.SlowMAType
+	}
+
+	if p.SlowPeriod != 0 {
+		params["slow_period"] = p.SlowPeriod
+	}
+
+	return params
+}
+
+// MAMAParams are the parameters of the MESA Adaptive Moving Average indicator, mama.
+type MAMAParams struct {
+	FastLimit  float64    // fast_limit, 0.5 by default
+	SeriesType SeriesType // series_type, close by default
+	SlowLimit  float64    // slow_limit, 0.05 by default
+}
+
+// Indicator returns "mama".
+func (MAMAParams) Indicator() string {
+	return "mama"
+}
+
+// Params returns the set parameters.
+func (p MAMAParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.FastLimit != 0 {
+		params["fast_limit"] = p.FastLimit
+	}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.SlowLimit != 0 {
+		params["slow_limit"] = p.SlowLimit
+	}
+
+	return params
+}
+
+// MaxParams are the parameters of the Highest Value Over Period indicator, max.
+type MaxParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "max".
+func (MaxParams) Indicator() string {
+	return "max"
+}
+
+// Params returns the set parameters.
+func (p MaxParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// MaxIndexParams are the parameters of the Index of Highest Value Over Period indicator, maxindex.
+type MaxIndexParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "maxindex".
+func (MaxIndexParams) Indicator() string {
+	return "maxindex"
+}
+
+// Params returns the set parameters.
+func (p MaxIndexParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// McGinleyDynamicParams are the parameters of the McGinley Dynamic indicator, mcginley_dynamic.
+type McGinleyDynamicParams struct {
+	TimePeriod int // time_period, 14 by default
+}
+
+// Indicator returns "mcginley_dynamic".
+func (McGinleyDynamicParams) Indicator() string {
+	return "mcginley_dynamic"
+}
+
+// Params returns the set parameters.
+func (p McGinleyDynamicParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// MedPriceParams are the parameters of the Median Price indicator, medprice.
+type MedPriceParams struct{}
+
+// Indicator returns "medprice".
+func (MedPriceParams) Indicator() string {
+	return "medprice"
+}
+
+// Params returns the set parameters.
+func (MedPriceParams) Params() map[string]any {
+	return map[string]any{}
+}
+
+// MFIParams are the parameters of the Money Flow Index indicator, mfi.
+type MFIParams struct {
+	TimePeriod int // time_period, 14 by default
+}
+
+// Indicator returns "mfi".
+func (MFIParams) Indicator() string {
+	return "mfi"
+}
+
+// Params returns the set parameters.
+func (p MFIParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// MidPointParams are the parameters of the MidPoint Over Period indicator, midpoint.
+type MidPointParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "midpoint".
+func (MidPointParams) Indicator() string {
+	return "midpoint"
+}
+
+// Params returns the set parameters.
+func (p MidPointParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// MidPriceParams are the parameters of the Midpoint Price Over Period indicator, midprice.
+type MidPriceParams struct {
+	TimePeriod int // time_period, 9 by default
+}
+
+// Indicator returns "midprice".
+func (MidPriceParams) Indicator() string {
+	return "midprice"
+}
+
+// Params returns the set parameters.
+func (p MidPriceParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// MinParams are the parameters of the Lowest Value Over Period indicator, min.
+type MinParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "min".
+func (MinParams) Indicator() string {
+	return "min"
+}
+
+// Params returns the set parameters.
+func (p MinParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// MinIndexParams are the parameters of the Index of Lowest Value Over Period indicator, minindex.
+type MinIndexParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "minindex".
+func (MinIndexParams) Indicator() string {
+	return "minindex"
+}
+
+// Params returns the set parameters.
+func (p MinIndexParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// MinMaxParams are the parameters of the Lowest and Highest Values Over Period indicator, minmax.
+type MinMaxParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "minmax".
+func (MinMaxParams) Indicator() string {
+	return "minmax"
+}
+
+// Params returns the set parameters.
+func (p MinMaxParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// MinMaxIndexParams are the parameters of the Indexes of Lowest and Highest Values Over Period indicator, minmaxindex.
+type MinMaxIndexParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "minmaxindex".
+func (MinMaxIndexParams) Indicator() string {
+	return "minmaxindex"
+}
+
+// Params returns the set parameters.
+func (p MinMaxIndexParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// MinusDIParams are the parameters of the Minus Directional Indicator indicator, minus_di.
+type MinusDIParams struct {
+	TimePeriod int // time_period, 9 by default
+}
+
+// Indicator returns "minus_di".
+func (MinusDIParams) Indicator() string {
+	return "minus_di"
+}
+
+// Params returns the set parameters.
+func (p MinusDIParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// MinusDMParams are the parameters of the Minus Directional Movement indicator, minus_dm.
+type MinusDMParams struct {
+	TimePeriod int // time_period, 9 by default
+}
+
+// Indicator returns "minus_dm".
+func (MinusDMParams) Indicator() string {
+	return "minus_dm"
+}
+
+// Params returns the set parameters.
+func (p MinusDMParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// MOMParams are the parameters of the Momentum indicator, mom.
+type MOMParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "mom".
+func (MOMParams) Indicator() string {
+	return "mom"
+}
+
+// Params returns the set parameters.
+func (p MOMParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// MultParams are the parameters of the Multiplication indicator, mult.
+type MultParams struct {
+	SeriesType1 SeriesType // series_type_1, open by default
+	SeriesType2 SeriesType // series_type_2, close by default
+}
+
+// Indicator returns "mult".
+func (MultParams) Indicator() string {
+	return "mult"
+}
+
+// Params returns the set parameters.
+func (p MultParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType1 != "" {
+		params["series_type_1"] = p.SeriesType1
+	}
+
+	if p.SeriesType2 != "" {
+		params["series_type_2"] = p.SeriesType2
+	}
+
+	return params
+}
+
+// NATRParams are the parameters of the Normalized Average True Range indicator, natr.
+type NATRParams struct {
+	TimePeriod int // time_period, 14 by default
+}
+
+// Indicator returns "natr".
+func (NATRParams) Indicator() string {
+	return "natr"
+}
+
+// Params returns the set parameters.
+func (p NATRParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// OBVParams are the parameters of the On Balance Volume indicator, obv.
+type OBVParams struct {
+	SeriesType SeriesType // series_type, close by default
+}
+
+// Indicator returns "obv".
+func (OBVParams) Indicator() string {
+	return "obv"
+}
+
+// Params returns the set parameters.
+func (p OBVParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	return params
+}
+
+// PercentBParams are the parameters of the %B Indicator indicator, percent_b.
+type PercentBParams struct {
+	MAType     MAType     // ma_type, SMA by default
+	SD         float64    // sd, 2 by default
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 20 by default
+}
+
+// Indicator returns "percent_b".
+func (PercentBParams) Indicator() string {
+	return "percent_b"
+}
+
+// Params returns the set parameters.
+func (p PercentBParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.MAType != "" {
+		params["ma_type"] = p.MAType
+	}
+
+	if p.SD != 0 {
+		params["sd"] = p.SD
+	}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// PivotPointsHLParams are the parameters of the Pivot Points (High/Low) indicator, pivot_points_hl.
+type PivotPointsHLParams struct {
+	TimePeriod int // time_period, 10 by default
+}
+
+// Indicator returns "pivot_points_hl".
+func (PivotPointsHLParams) Indicator() string {
+	return "pivot_points_hl"
+}
+
+// Params returns the set parameters.
+func (p PivotPointsHLParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// PlusDIParams are the parameters of the Plus Directional Indicator indicator, plus_di.
+type PlusDIParams struct {
+	TimePeriod int // time_period, 9 by default
+}
+
+// Indicator returns "plus_di".
+func (PlusDIParams) Indicator() string {
+	return "plus_di"
+}
+
+// Params returns the set parameters.
+func (p PlusDIParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// PlusDMParams are the parameters of the Plus Directional Movement indicator, plus_dm.
+type PlusDMParams struct {
+	TimePeriod int // time_period, 9 by default
+}
+
+// Indicator returns "plus_dm".
+func (PlusDMParams) Indicator() string {
+	return "plus_dm"
+}
+
+// Params returns the set parameters.
+func (p PlusDMParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// PPOParams are the parameters of the Percentage Price Oscillator indicator, ppo.
+type PPOParams struct {
+	FastPeriod int        // fast_period, 12 by default
+	MAType     MAType     // ma_type, SMA by default
+	SeriesType SeriesType // series_type, close by default
+	SlowPeriod int        // slow_period, 26 by default
+}
+
+// Indicator returns "ppo".
+func (PPOParams) Indicator() string {
+	return "ppo"
+}
+
+// Params returns the set parameters.
+func (p PPOParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.FastPeriod != 0 {
+		params["fast_period"] = p.FastPeriod
+	}
+
+	if p.MAType != "" {
+		params["ma_type"] = p.MAType
+	}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.SlowPeriod != 0 {
+		params["slow_period"] = p.SlowPeriod
+	}
+
+	return params
+}
+
+// ROCParams are the parameters of the Rate of Change indicator, roc.
+type ROCParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "roc".
+func (ROCParams) Indicator() string {
+	return "roc"
+}
+
+// Params returns the set parameters.
+func (p ROCParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// ROCPParams are the parameters of the Rate of Change Percentage indicator, rocp.
+type ROCPParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "rocp".
+func (ROCPParams) Indicator() string {
+	return "rocp"
+}
+
+// Params returns the set parameters.
+func (p ROCPParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// ROCRParams are the parameters of the Rate of Change Ratio indicator, rocr.
+type ROCRParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "rocr".
+func (ROCRParams) Indicator() string {
+	return "rocr"
+}
+
+// Params returns the set parameters.
+func (p ROCRParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// ROCR100Params are the parameters of the Rate of Change Ratio 100 Scale indicator, rocr100.
+type ROCR100Params struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "rocr100".
+func (ROCR100Params) Indicator() string {
+	return "rocr100"
+}
+
+// Params returns the set parameters.
+func (p ROCR100Params) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// RSIParams are the parameters of the Relative Strength Index indicator, rsi.
+type RSIParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 14 by default
+}
+
+// Indicator returns "rsi".
+func (RSIParams) Indicator() string {
+	return "rsi"
+}
+
+// Params returns the set parameters.
+func (p RSIParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// RVOLParams are the parameters of the Relative Volume Indicator indicator, rvol.
+type RVOLParams struct {
+	TimePeriod int // time_period, 14 by default
+}
+
+// Indicator returns "rvol".
+func (RVOLParams) Indicator() string {
+	return "rvol"
+}
+
+// Params returns the set parameters.
+func (p RVOLParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// SARParams are the parameters of the Parabolic SAR indicator, sar.
+type SARParams struct {
+	Acceleration float64 // acceleration, 0.02 by default
+	Maximum      float64 // maximum, 0.2 by default
+}
+
+// Indicator returns "sar".
+func (SARParams) Indicator() string {
+	return "sar"
+}
+
+// Params returns the set parameters.
+func (p SARParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.Acceleration != 0 {
+		params["acceleration"] = p.Acceleration
+	}
+
+	if p.Maximum != 0 {
+		params["maximum"] = p.Maximum
+	}
+
+	return params
+}
+
+// SARExtParams are the parameters of the Parabolic SAR Extended indicator, sarext.
+type SARExtParams struct {
+	AccelerationLimitLong  float64 // acceleration_limit_long, 0.02 by default
+	AccelerationLimitShort float64 // acceleration_limit_short, 0.02 by default
+	AccelerationLong       float64 // acceleration_long, 0.02 by default
+	AccelerationMaxLong    float64 // acceleration_max_long, 0.2 by default
+	AccelerationMaxShort   float64 // acceleration_max_short, 0.2 by default
+	AccelerationShort      float64 // acceleration_short, 0.02 by default
+	OffsetOnReverse        float64 // offset_on_reverse, 0 by default
+	StartValue             float64 // start_value, 0 by default
+}
+
+// Indicator returns "sarext".
+func (SARExtParams) Indicator() string {
+	return "sarext"
+}
+
+// Params returns the set parameters.
+func (p SARExtParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.AccelerationLimitLong != 0 {
+		params["acceleration_limit_long"] = p.AccelerationLimitLong
+	}
+
+	if p.AccelerationLimitShort != 0 {
+		params["acceleration_limit_short"] = p.AccelerationLimitShort
+	}
+
+	if p.AccelerationLong != 0 {
+		params["acceleration_long"] = p.AccelerationLong
+	}
+
+	if p.AccelerationMaxLong != 0 {
+		params["acceleration_max_long"] = p.AccelerationMaxLong
+	}
+
+	if p.AccelerationMaxShort != 0 {
+		params["acceleration_max_short"] = p.AccelerationMaxShort
+	}
+
+	if p.AccelerationShort != 0 {
+		params["acceleration_short"] = p.AccelerationShort
+	}
+
+	if p.OffsetOnReverse != 0 {
+		params["offset_on_reverse"] = p.OffsetOnReverse
+	}
+
+	if p.StartValue != 0 {
+		params["start_value"] = p.StartValue
+	}
+
+	return params
+}
+
+// SMAParams are the parameters of the Simple Moving Average indicator, sma.
+type SMAParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "sma".
+func (SMAParams) Indicator() string {
+	return "sma"
+}
+
+// Params returns the set parameters.
+func (p SMAParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// SqrtParams are the parameters of the Square Root indicator, sqrt.
+type SqrtParams struct {
+	SeriesType SeriesType // series_type, close by default
+}
+
+// Indicator returns "sqrt".
+func (SqrtParams) Indicator() string {
+	return "sqrt"
+}
+
+// Params returns the set parameters.
+func (p SqrtParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	return params
+}
+
+// StdDevParams are the parameters of the Standard Deviation indicator, stddev.
+type StdDevParams struct {
+	SD         float64    // sd, 2 by default
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "stddev".
+func (StdDevParams) Indicator() string {
+	return "stddev"
+}
+
+// Params returns the set parameters.
+func (p StdDevParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SD != 0 {
+		params["sd"] = p.SD
+	}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// StochParams are the parameters of the Stochastic Oscillator indicator, stoch.
+type StochParams struct {
+	FastKPeriod int    // fast_k_period, 14 by default
+	SlowDPeriod int    // slow_d_period, 3 by default
+	SlowDMAType MAType // slow_dma_type, SMA by default
+	SlowKPeriod int    // slow_k_period, 1 by default
+	SlowKMAType MAType // slow_kma_type, SMA by default
+}
+
+// Indicator returns "stoch".
+func (StochParams) Indicator() string {
+	return "stoch"
+}
+
+// Params returns the set parameters.
+func (p StochParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.FastKPeriod != 0 {
+		params["fast_k_period"] = p.FastKPeriod
+	}
+
+	if p.SlowDPeriod != 0 {
+		params["slow_d_period"] = p.SlowDPeriod
+	}
+
+	if p.SlowDMAType != "" {
+		params["slow_dma_type"] = p.SlowDMAType
+	}
+
+	if p.SlowKPeriod != 0 {
+		params["slow_k_period"] = p.SlowKPeriod
+	}
+
+	if p.SlowKMAType != "" {
+		params["slow_kma_type"] = p.SlowKMAType
+	}
+
+	return params
+}
+
+// StochFParams are the parameters of the Stochastic Fast indicator, stochf.
+type StochFParams struct {
+	FastDPeriod int    // fast_d_period, 3 by default
+	FastDMAType MAType // fast_dma_type, SMA by default
+	FastKPeriod int    // fast_k_period, 14 by default
+}
+
+// Indicator returns "stochf".
+func (StochFParams) Indicator() string {
+	return "stochf"
+}
+
+// Params returns the set parameters.
+func (p StochFParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.FastDPeriod != 0 {
+		params["fast_d_period"] = p.FastDPeriod
+	}
+
+	if p.FastDMAType != "" {
+		params["fast_dma_type"] = p.FastDMAType
+	}
+
+	if p.FastKPeriod != 0 {
+		params["fast_k_period"] = p.FastKPeriod
+	}
+
+	return params
+}
+
+// StochRSIParams are the parameters of the Stochastic RSI indicator, stochrsi.
+type StochRSIParams struct {
+	DPeriod     int        // d_period, 3 by default
+	KPeriod     int        // k_period, 3 by default
+	RSILength   int        // rsi_length, 14 by default
+	SeriesType  SeriesType // series_type, close by default
+	SlowDMAType MAType     // slow_d_ma_type, SMA by default
+	SlowKMAType MAType     // slow_k_ma_type, SMA by default
+	StochLength int        // stoch_length, 14 by default
+}
+
+// Indicator returns "stochrsi".
+func (StochRSIParams) Indicator() string {
+	return "stochrsi"
+}
+
+// Params returns the set parameters.
+func (p StochRSIParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.DPeriod != 0 {
+		params["d_period"] = p.DPeriod
+	}
+
+	if p.KPeriod != 0 {
+		params["k_period"] = p.KPeriod
+	}
+
+	if p.RSILength != 0 {
+		params["rsi_length"] = p.RSILength
+	}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.SlowDMAType != "" {
+		params["slow_d_ma_type"] = p.SlowDMAType
+	}
+
+	if p.SlowKMAType != "" {
+		params["slow_k_ma_type"] = p.SlowKMAType
+	}
+
+	if p.StochLength != 0 {
+		params["stoch_length"] = p.StochLength
+	}
+
+	return params
+}
+
+// SubParams are the parameters of the Subtraction indicator, sub.
+type SubParams struct {
+	SeriesType1 SeriesType // series_type_1, open by default
+	SeriesType2 SeriesType // series_type_2, close by default
+}
+
+// Indicator returns "sub".
+func (SubParams) Indicator() string {
+	return "sub"
+}
+
+// Params returns the set parameters.
+func (p SubParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType1 != "" {
+		params["series_type_1"] = p.SeriesType1
+	}
+
+	if p.SeriesType2 != "" {
+		params["series_type_2"] = p.SeriesType2
+	}
+
+	return params
+}
+
+// SumParams are the parameters of the Summation indicator, sum.
+type SumParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "sum".
+func (SumParams) Indicator() string {
+	return "sum"
+}
+
+// Params returns the set parameters.
+func (p SumParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// SuperTrendParams are the parameters of the SuperTrend indicator, supertrend.
+type SuperTrendParams struct {
+	Multiplier int // multiplier, 3 by default
+	Period     int // period, 10 by default
+}
+
+// Indicator returns "supertrend".
+func (SuperTrendParams) Indicator() string {
+	return "supertrend"
+}
+
+// Params returns the set parameters.
+func (p SuperTrendParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.Multiplier != 0 {
+		params["multiplier"] = p.Multiplier
+	}
+
+	if p.Period != 0 {
+		params["period"] = p.Period
+	}
+
+	return params
+}
+
+// SuperTrendHeikinAshiCandlesParams are the parameters of the SuperTrend Heikin-Ashi Candles indicator, supertrend_heikinashicandles.
+type SuperTrendHeikinAshiCandlesParams struct {
+	Multiplier int // multiplier, 3 by default
+	Period     int // period, 10 by default
+}
+
+// Indicator returns "supertrend_heikinashicandles".
+func (SuperTrendHeikinAshiCandlesParams) Indicator() string {
+	return "supertrend_heikinashicandles"
+}
+
+// Params returns the set parameters.
+func (p SuperTrendHeikinAshiCandlesParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.Multiplier != 0 {
+		params["multiplier"] = p.Multiplier
+	}
+
+	if p.Period != 0 {
+		params["period"] = p.Period
+	}
+
+	return params
+}
+
+// T3MAParams are the parameters of the Triple Exponential Moving Average (T3) indicator, t3ma.
+type T3MAParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+	VFactor    float64    // v_factor, 0.7 by default
+}
+
+// Indicator returns "t3ma".
+func (T3MAParams) Indicator() string {
+	return "t3ma"
+}
+
+// Params returns the set parameters.
+func (p T3MAParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	if p.VFactor != 0 {
+		params["v_factor"] = p.VFactor
+	}
+
+	return params
+}
+
+// TEMAParams are the parameters of the Triple Exponential Moving Average indicator, tema.
+type TEMAParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "tema".
+func (TEMAParams) Indicator() string {
+	return "tema"
+}
+
+// Params returns the set parameters.
+func (p TEMAParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// TRangeParams are the parameters of the True Range indicator, trange.
+type TRangeParams struct{}
+
+// Indicator returns "trange".
+func (TRangeParams) Indicator() string {
+	return "trange"
+}
+
+// Params returns the set parameters.
+func (TRangeParams) Params() map[string]any {
+	return map[string]any{}
+}
+
+// TRIMAParams are the parameters of the Triangular Moving Average indicator, trima.
+type TRIMAParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "trima".
+func (TRIMAParams) Indicator() string {
+	return "trima"
+}
+
+// Params returns the set parameters.
+func (p TRIMAParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// TSFParams are the parameters of the Time Series Forecast indicator, tsf.
+type TSFParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "tsf".
+func (TSFParams) Indicator() string {
+	return "tsf"
+}
+
+// Params returns the set parameters.
+func (p TSFParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// TypPriceParams are the parameters of the Typical Price indicator, typprice.
+type TypPriceParams struct{}
+
+// Indicator returns "typprice".
+func (TypPriceParams) Indicator() string {
+	return "typprice"
+}
+
+// Params returns the set parameters.
+func (TypPriceParams) Params() map[string]any {
+	return map[string]any{}
+}
+
+// UltOscParams are the parameters of the Ultimate Oscillator indicator, ultosc.
+type UltOscParams struct {
+	TimePeriod1 int // time_period_1, 7 by default
+	TimePeriod2 int // time_period_2, 14 by default
+	TimePeriod3 int // time_period_3, 28 by default
+}
+
+// Indicator returns "ultosc".
+func (UltOscParams) Indicator() string {
+	return "ultosc"
+}
+
+// Params returns the set parameters.
+func (p UltOscParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.TimePeriod1 != 0 {
+		params["time_period_1"] = p.TimePeriod1
+	}
+
+	if p.TimePeriod2 != 0 {
+		params["time_period_2"] = p.TimePeriod2
+	}
+
+	if p.TimePeriod3 != 0 {
+		params["time_period_3"] = p.TimePeriod3
+	}
+
+	return params
+}
+
+// VarParams are the parameters of the Variance indicator, var.
+type VarParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "var".
+func (VarParams) Indicator() string {
+	return "var"
+}
+
+// Params returns the set parameters.
+func (p VarParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// VWAPParams are the parameters of the Volume Weighted Average Price indicator, vwap.
+type VWAPParams struct {
+	SD           float64 // sd, 0 by default
+	SDTimePeriod int     // sd_time_period, 0 by default
+}
+
+// Indicator returns "vwap".
+func (VWAPParams) Indicator() string {
+	return "vwap"
+}
+
+// Params returns the set parameters.
+func (p VWAPParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SD != 0 {
+		params["sd"] = p.SD
+	}
+
+	if p.SDTimePeriod != 0 {
+		params["sd_time_period"] = p.SDTimePeriod
+	}
+
+	return params
+}
+
+// WCLPriceParams are the parameters of the Weighted Close Price indicator, wclprice.
+type WCLPriceParams struct{}
+
+// Indicator returns "wclprice".
+func (WCLPriceParams) Indicator() string {
+	return "wclprice"
+}
+
+// Params returns the set parameters.
+func (WCLPriceParams) Params() map[string]any {
+	return map[string]any{}
+}
+
+// WillRParams are the parameters of the Williams %R indicator, willr.
+type WillRParams struct {
+	TimePeriod int // time_period, 14 by default
+}
+
+// Indicator returns "willr".
+func (WillRParams) Indicator() string {
+	return "willr"
+}
+
+// Params returns the set parameters.
+func (p WillRParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
+
+// WMAParams are the parameters of the Weighted Moving Average indicator, wma.
+type WMAParams struct {
+	SeriesType SeriesType // series_type, close by default
+	TimePeriod int        // time_period, 9 by default
+}
+
+// Indicator returns "wma".
+func (WMAParams) Indicator() string {
+	return "wma"
+}
+
+// Params returns the set parameters.
+func (p WMAParams) Params() map[string]any {
+	params := map[string]any{}
+
+	if p.SeriesType != "" {
+		params["series_type"] = p.SeriesType
+	}
+
+	if p.TimePeriod != 0 {
+		params["time_period"] = p.TimePeriod
+	}
+
+	return params
+}
@@ -11,7 +11,7 @@ type GetPrice struct {
 	MicCode        string `schema:"mic_code,omitempty"`
 	Country        string `schema:"country,omitempty"`
 	InstrumentType string `schema:"type,omitempty"`
-	Format         string `schema:"format,omitempty"`
+	Format         Format `schema:"format,omitempty"`
 	Delimiter      string `schema:"delimiter,omitempty"`
 	PrePost        bool   `schema:"prepost,omitempty"`
 	DecimalPlaces  int    `schema:"dp,omitempty"`
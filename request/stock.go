@@ -12,7 +12,7 @@ type GetStock struct {
 	MicCode         string `schema:"mic_code,omitempty"`
 	Country         string `schema:"country,omitempty"`
 	InstrumentType  string `schema:"type,omitempty"`
-	Format          string `schema:"format,omitempty"`
+	Format          Format `schema:"format,omitempty"`
 	Delimiter       string `schema:"delimiter,omitempty"`
 	ShowPlan        bool   `schema:"show_plan,omitempty"`
 	IncludeDelisted bool   `schema:"include_delisted,omitempty"`
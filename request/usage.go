@@ -3,7 +3,7 @@ package request
 // GetUsage represents request parameters for usage data.
 type GetUsage struct {
 	APIKey
-	Format    string `schema:"format,omitempty"`
+	Format    Format `schema:"format,omitempty"`
 	Delimiter string `schema:"delimiter,omitempty"`
 	TimeZone  string `schema:"timezone,omitempty"`
 }
@@ -0,0 +1,240 @@
+package request
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// FieldError describes an invalid field of a request.
+type FieldError struct {
+	Field  string // Go field name, e.g. "StartDate"
+	Param  string // API parameter, e.g. "start_date"
+	Value  any
+	Reason string
+}
+
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Reason
+}
+
+// ValidationError lists the invalid fields of a request rejected before it is sent.
+type ValidationError struct {
+	Request string // request type, e.g. "GetTimeSeries"
+	Fields  []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	reasons := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		reasons = append(reasons, field.Error())
+	}
+
+	return fmt.Sprintf("invalid %s: %s", e.Request, strings.Join(reasons, "; "))
+}
+
+// Has reports whether field is one of the invalid fields.
+func (e *ValidationError) Has(field string) bool {
+	for _, fieldErr := range e.Fields {
+		if fieldErr.Field == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// identifierParams are the parameters identifying an instrument, at most one of them can be set.
+var identifierParams = []string{"symbol", "figi", "isin", "cusip"}
+
+// dateParams are the query parameters holding a date or a datetime.
+var dateParams = map[string]bool{
+	"date":        true,
+	"start_date":  true,
+	"end_date":    true,
+	"filled_from": true,
+	"filled_to":   true,
+}
+
+// validator collects the invalid fields of a request.
+type validator struct {
+	request string
+	fields  []FieldError
+}
+
+// validateFields checks the fields of req by their schema parameter: enumerations must hold one of their values,
+// dates must be well-formed and numbers must not be negative. required lists the parameters that must be set,
+// "symbol" standing for any of the identifiers. StartDate must not be after EndDate.
+func validateFields(req any, required ...string) *validator {
+	return checkFields(req, false, required)
+}
+
+// validateInstrument is validateFields for the lookup of a single instrument, whose identifiers are exclusive.
+// Catalogs and calendars accept them together as filters and use validateFields.
+func validateInstrument(req any, required ...string) *validator {
+	return checkFields(req, true, required)
+}
+
+func checkFields(req any, exclusive bool, required []string) *validator {
+	value := reflect.ValueOf(req)
+	v := &validator{request: value.Type().Name()}
+
+	params := map[string]reflect.StructField{}
+	for _, field := range reflect.VisibleFields(value.Type()) {
+		if param := schemaParam(field); param != "" && !field.Anonymous {
+			params[param] = field
+		}
+	}
+
+	var identifiers []reflect.StructField
+
+	for _, param := range identifierParams {
+		if field, ok := params[param]; ok && !value.FieldByIndex(field.Index).IsZero() {
+			identifiers = append(identifiers, field)
+		}
+	}
+
+	if exclusive && len(identifiers) > 1 {
+		names := make([]string, 0, len(identifiers))
+		for _, field := range identifiers {
+			names = append(names, field.Name)
+		}
+
+		for _, field := range identifiers[1:] {
+			v.add(field.Name, schemaParam(field), value.FieldByIndex(field.Index).Interface(),
+				"only one of "+strings.Join(names, ", ")+" can be set")
+		}
+	}
+
+	for _, param := range required {
+		field, ok := params[param]
+		if !ok || (param == "symbol" && len(identifiers) > 0) {
+			continue
+		}
+
+		if value.FieldByIndex(field.Index).IsZero() {
+			v.add(field.Name, param, "", "is required")
+		}
+	}
+
+	for _, field := range reflect.VisibleFields(value.Type()) {
+		if param := schemaParam(field); param != "" && !field.Anonymous {
+			v.checkField(field.Name, param, value.FieldByIndex(field.Index))
+		}
+	}
+
+	if start, ok := params["start_date"]; ok {
+		if end, ok := params["end_date"]; ok {
+			v.dateRange(value.FieldByIndex(start.Index).String(), value.FieldByIndex(end.Index).String())
+		}
+	}
+
+	return v
+}
+
+// checkField applies the rules of param to a set field.
+func (v *validator) checkField(name, param string, value reflect.Value) {
+	if value.IsZero() {
+		return
+	}
+
+	switch value.Kind() { //nolint:exhaustive // only numbers and strings have rules
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if value.Int() < 0 {
+			v.add(name, param, value.Int(), "must not be negative")
+		}
+	case reflect.Float32, reflect.Float64:
+		if value.Float() < 0 {
+			v.add(name, param, value.Float(), "must not be negative")
+		}
+	case reflect.String:
+		if value, ok := value.Interface().(enum); ok {
+			v.enum(name, param, value)
+
+			return
+		}
+
+		if dateParams[param] && !isDate(value.String()) && (param != "date" || !isRelativeDate(value.String())) {
+			v.add(name, param, value.String(), "must be a date formatted as 2006-01-02 or 2006-01-02 15:04:05")
+		}
+	}
+}
+
+// enum checks that value is one of the values of its enumeration.
+func (v *validator) enum(name, param string, value enum) {
+	if !value.IsValid() {
+		v.add(name, param, value, fmt.Sprintf("unknown value %q, expected one of %s", value,
+			strings.Join(value.allowed(), ", ")))
+	}
+}
+
+// pathParam checks a required path parameter.
+func (v *validator) pathParam(name, param string, value enum) {
+	if reflect.ValueOf(value).IsZero() {
+		v.add(name, param, value, "is required")
+
+		return
+	}
+
+	v.enum(name, param, value)
+}
+
+// dateRange checks that start is not after end when both are valid dates.
+func (v *validator) dateRange(start, end string) {
+	if !isDate(start) || !isDate(end) {
+		return
+	}
+
+	if parseDate(start).After(parseDate(end)) {
+		v.add("EndDate", "end_date", end, "must not be before StartDate")
+	}
+}
+
+func (v *validator) add(name, param string, value any, reason string) {
+	v.fields = append(v.fields, FieldError{Field: name, Param: param, Value: value, Reason: reason})
+}
+
+// err returns the collected fields as a *ValidationError, nil when every field is valid.
+func (v *validator) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Request: v.request, Fields: v.fields}
+}
+
+// schemaParam returns the parameter name of field, empty when it is not encoded.
+func schemaParam(field reflect.StructField) string {
+	param, _, _ := strings.Cut(field.Tag.Get("schema"), ",")
+	if param == "-" {
+		return ""
+	}
+
+	return param
+}
+
+const (
+	dateLayout        = "2006-01-02"
+	dateTimeLayout    = "2006-01-02 15:04:05"
+	isoDateTimeLayout = "2006-01-02T15:04:05"
+)
+
+func isDate(value string) bool {
+	return !parseDate(value).IsZero()
+}
+
+func parseDate(value string) time.Time {
+	for _, layout := range []string{dateTimeLayout, isoDateTimeLayout, dateLayout} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+// isRelativeDate reports whether value is one of the relative dates the date parameter accepts.
+func isRelativeDate(value string) bool {
+	return strings.EqualFold(value, "today") || strings.EqualFold(value, "yesterday")
+}
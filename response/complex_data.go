@@ -0,0 +1,186 @@
+package response
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ComplexData represents the response of the complex data endpoint, its series grouped by symbol in the order
+// of the response.
+type ComplexData struct {
+	Symbols []ComplexDataSymbol
+	Errors  []Error // failed methods that do not name their symbol and interval
+	Status  string
+}
+
+// ComplexDataSymbol holds the series of one symbol at every requested interval.
+type ComplexDataSymbol struct {
+	Symbol    string
+	Intervals []ComplexDataInterval
+}
+
+// ComplexDataInterval holds the time series and the indicators of a symbol at one interval.
+type ComplexDataInterval struct {
+	Interval   string
+	TimeSeries *ComplexDataTimeSeries // nil unless the time series was requested
+	Indicators []IndicatorSeries
+	Errors     []Error // failed methods of the symbol at this interval
+}
+
+// ComplexDataTimeSeries represents the OHLCV values of a symbol at one interval.
+type ComplexDataTimeSeries struct {
+	Meta   TimeSeriesMeta
+	Values []ComplexDataValue
+}
+
+// ComplexDataValue represents one OHLCV data point of a complex data time series.
+type ComplexDataValue struct {
+	Datetime string      `json:"datetime"`
+	Open     FloatString `json:"open"`
+	High     FloatString `json:"high"`
+	Low      FloatString `json:"low"`
+	Close    FloatString `json:"close"`
+	Volume   FloatString `json:"volume"`
+}
+
+// UnmarshalJSON groups the series of the response data by symbol and interval, telling the time series from
+// the indicators by the indicator of their meta.
+func (d *ComplexData) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Data   []json.RawMessage `json:"data"`
+		Status string            `json:"status"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*d = ComplexData{Status: raw.Status}
+
+	for i, element := range raw.Data {
+		if err := d.add(element); err != nil {
+			return fmt.Errorf("data %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// add adds one element of the response data, the result of one method for a symbol and an interval.
+func (d *ComplexData) add(element json.RawMessage) error {
+	var head struct {
+		Meta   IndicatorSeriesMeta `json:"meta"`
+		Status string              `json:"status"`
+	}
+
+	if err := json.Unmarshal(element, &head); err != nil {
+		return err
+	}
+
+	if head.Status == "error" {
+		var apiErr Error
+		if err := json.Unmarshal(element, &apiErr); err != nil {
+			return err
+		}
+
+		if head.Meta.Symbol == "" {
+			d.Errors = append(d.Errors, apiErr)
+		} else {
+			interval := d.interval(head.Meta.Symbol, head.Meta.Interval)
+			interval.Errors = append(interval.Errors, apiErr)
+		}
+
+		return nil
+	}
+
+	interval := d.interval(head.Meta.Symbol, head.Meta.Interval)
+
+	if head.Meta.Indicator != nil {
+		var series IndicatorSeries
+		if err := json.Unmarshal(element, &series); err != nil {
+			return err
+		}
+
+		interval.Indicators = append(interval.Indicators, series)
+
+		return nil
+	}
+
+	var series struct {
+		Meta   TimeSeriesMeta     `json:"meta"`
+		Values []ComplexDataValue `json:"values"`
+	}
+
+	if err := json.Unmarshal(element, &series); err != nil {
+		return err
+	}
+
+	interval.TimeSeries = &ComplexDataTimeSeries{Meta: series.Meta, Values: series.Values}
+
+	return nil
+}
+
+// interval returns the series of symbol at interval, added when missing.
+func (d *ComplexData) interval(symbol, interval string) *ComplexDataInterval {
+	i := len(d.Symbols)
+	for j := range d.Symbols {
+		if d.Symbols[j].Symbol == symbol {
+			i = j
+
+			break
+		}
+	}
+
+	if i == len(d.Symbols) {
+		d.Symbols = append(d.Symbols, ComplexDataSymbol{Symbol: symbol})
+	}
+
+	intervals := &d.Symbols[i].Intervals
+	for j := range *intervals {
+		if (*intervals)[j].Interval == interval {
+			return &(*intervals)[j]
+		}
+	}
+
+	*intervals = append(*intervals, ComplexDataInterval{Interval: interval})
+
+	return &(*intervals)[len(*intervals)-1]
+}
+
+// Symbol returns the series of symbol.
+func (d ComplexData) Symbol(symbol string) (ComplexDataSymbol, bool) {
+	for _, series := range d.Symbols {
+		if strings.EqualFold(series.Symbol, symbol) {
+			return series, true
+		}
+	}
+
+	return ComplexDataSymbol{}, false
+}
+
+// Interval returns the series of the symbol at interval, such as "1day".
+func (s ComplexDataSymbol) Interval(interval string) (ComplexDataInterval, bool) {
+	for _, series := range s.Intervals {
+		if series.Interval == interval {
+			return series, true
+		}
+	}
+
+	return ComplexDataInterval{}, false
+}
+
+// Indicator returns the first series of the indicator name, such as "rsi", matched against the name of the
+// indicator meta, such as "RSI - Relative Strength Index".
+func (i ComplexDataInterval) Indicator(name string) (IndicatorSeries, bool) {
+	for _, series := range i.Indicators {
+		fullName, _ := series.Meta.Indicator["name"].(string)
+		short, _, _ := strings.Cut(fullName, " - ")
+
+		if strings.EqualFold(strings.TrimSpace(short), name) {
+			return series, true
+		}
+	}
+
+	return IndicatorSeries{}, false
+}
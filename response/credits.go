@@ -12,6 +12,9 @@ type Credits interface {
 type CreditsImpl struct {
 	CreditsLeft int64
 	CreditsUsed int64
+	// CreditsRequest is the cost of the request reported by the Api-credits-request header,
+	// or the estimated cost for a dry run. Zero when unknown.
+	CreditsRequest int64
 }
 
 // NewCreditsImpl creates a new CreditsImpl instance with the specified credits left and used values.
@@ -38,3 +41,8 @@ func (c *CreditsImpl) GetCreditsLeft() int64 {
 func (c *CreditsImpl) GetCreditsUsed() int64 {
 	return c.CreditsUsed
 }
+
+// GetCreditsRequest returns the number of API credits the request costs.
+func (c *CreditsImpl) GetCreditsRequest() int64 {
+	return c.CreditsRequest
+}
@@ -1,5 +1,11 @@
 package response
 
+// EODs represents end-of-day prices of several symbols keyed by the requested symbol.
+type EODs struct {
+	Data   map[string]EOD
+	Errors map[string]error // error of every symbol without an end-of-day price
+}
+
 // EOD represents end-of-day price data for a financial instrument.
 type EOD struct {
 	Symbol   string `json:"symbol"`
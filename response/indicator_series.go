@@ -0,0 +1,77 @@
+package response
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// IndicatorSeries represents the response of any technical indicator endpoint in a uniform shape.
+type IndicatorSeries struct {
+	Meta   IndicatorSeriesMeta    `json:"meta"`
+	Values []IndicatorSeriesValue `json:"values"`
+	Status string                 `json:"status"`
+}
+
+// IndicatorSeriesMeta contains metadata information about the instrument and the indicator calculation.
+type IndicatorSeriesMeta struct {
+	Symbol           string `json:"symbol"`
+	Interval         string `json:"interval"`
+	Currency         string `json:"currency"`
+	ExchangeTimezone string `json:"exchange_timezone"`
+	Exchange         string `json:"exchange"`
+	MicCode          string `json:"mic_code"`
+	Type             string `json:"type"`
+	// Indicator holds the name of the indicator and the parameters it was calculated with.
+	Indicator map[string]any `json:"indicator"`
+}
+
+// IndicatorSeriesValue represents one data point of an indicator, with every output value keyed by its name,
+// such as "aroon_up" and "aroon_down". OHLC values requested with include_ohlc are keyed by "open", "high",
+// "low", "close" and "volume". Null outputs are left out.
+type IndicatorSeriesValue struct {
+	Datetime string
+	Values   map[string]float64
+}
+
+// UnmarshalJSON reads the datetime and parses every other field as a number or a numeric string.
+func (v *IndicatorSeriesValue) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	v.Datetime, v.Values = "", make(map[string]float64, len(fields))
+
+	for name, raw := range fields {
+		if name == "datetime" {
+			if err := json.Unmarshal(raw, &v.Datetime); err != nil {
+				return fmt.Errorf("datetime: %w", err)
+			}
+
+			continue
+		}
+
+		var value FloatString
+		if err := value.UnmarshalJSON(raw); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		if value.Valid {
+			v.Values[name] = value.Float64
+		}
+	}
+
+	return nil
+}
+
+// MarshalJSON writes the datetime and the output values as fields of one object.
+func (v IndicatorSeriesValue) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]any, len(v.Values)+1)
+	for name, value := range v.Values {
+		fields[name] = value
+	}
+
+	fields["datetime"] = v.Datetime
+
+	return json.Marshal(fields)
+}
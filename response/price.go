@@ -1,5 +1,11 @@
 package response
 
+// Prices represents prices of several symbols keyed by the requested symbol.
+type Prices struct {
+	Data   map[string]Price
+	Errors map[string]error // error of every symbol without a price
+}
+
 // Price represents a simple price response.
 type Price struct {
 	Price string `json:"price"`
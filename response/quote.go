@@ -2,10 +2,11 @@ package response
 
 import "github.com/guregu/null/v6"
 
-// Quotes represents a collection of quote data and any associated errors.
+// Quotes represents quotes of several symbols keyed by the requested symbol.
 type Quotes struct {
-	Data   []Quote
-	Errors []QuoteError
+	Data map[string]Quote
+	// Errors holds the error of every symbol without data, e.g. a SymbolNotFoundError or a QuoteError.
+	Errors map[string]error
 }
 
 // Quote represents detailed market quote information for a financial instrument.
@@ -57,6 +58,10 @@ type QuoteError struct {
 	Meta    *QuoteErrorMeta `json:"meta"`
 }
 
+func (e QuoteError) Error() string {
+	return Error{Code: e.Code, Message: e.Message, Status: e.Status}.Error()
+}
+
 // QuoteErrorMeta contains metadata about the symbol that caused a quote error.
 type QuoteErrorMeta struct {
 	Symbol   string `json:"symbol"`
@@ -1,5 +1,11 @@
 package response
 
+// TimeSeriesMulti represents time series of several symbols keyed by the requested symbol.
+type TimeSeriesMulti struct {
+	Data   map[string]TimeSeries
+	Errors map[string]error // error of every symbol without a series
+}
+
 // TimeSeries represents time series data response with metadata and values.
 type TimeSeries struct {
 	Meta   TimeSeriesMeta    `json:"meta"`
@@ -0,0 +1,218 @@
+package twelvedata
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/soulgarden/twelvedata/response"
+	"github.com/valyala/fasthttp"
+)
+
+// RetryPolicy controls how HTTPCli retries failed requests.
+// Only idempotent requests (GET, HEAD) are retried unless RetryNonIdempotent is set,
+// so GetBatches POST requests are sent exactly once by default.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one. Values below 1 mean 1.
+	MaxAttempts int
+	// BaseDelay is the backoff before the second attempt, doubled for every following attempt.
+	BaseDelay time.Duration
+	// MaxDelay caps the exponential backoff and the Retry-After delay. Zero means no cap.
+	MaxDelay time.Duration
+	// Jitter is the fraction (0..1) of every delay that is randomized to spread retries out.
+	Jitter float64
+	// RetryOn decides whether an error class is retryable. Nil means DefaultRetryOn.
+	RetryOn func(err error) bool
+	// RespectRetryAfter makes the Retry-After response header override the computed backoff.
+	RespectRetryAfter bool
+	// RetryNonIdempotent allows retrying non-idempotent requests such as GetBatches POSTs.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a policy with three attempts, exponential backoff starting at 500ms
+// capped at 10s, 20% jitter and Retry-After support.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:       3,
+		BaseDelay:         500 * time.Millisecond,
+		MaxDelay:          10 * time.Second,
+		Jitter:            0.2,
+		RetryOn:           DefaultRetryOn,
+		RespectRetryAfter: true,
+	}
+}
+
+// DefaultRetryOn retries rate limit errors, 5xx server errors, network errors and timeouts.
+func DefaultRetryOn(err error) bool {
+	return IsRateLimitError(err) ||
+		IsServerError(err) ||
+		IsNetworkError(err) ||
+		IsTimeoutError(err) ||
+		errors.Is(err, fasthttp.ErrDialTimeout)
+}
+
+// legacyRetryPolicy mirrors the behaviour of HTTPCli without a configured policy:
+// a single immediate retry after a dial timeout.
+var legacyRetryPolicy = RetryPolicy{
+	MaxAttempts: 2,
+	RetryOn: func(err error) bool {
+		return errors.Is(err, fasthttp.ErrDialTimeout)
+	},
+}
+
+func (p RetryPolicy) attempts() int {
+	if p.MaxAttempts < 1 {
+		return 1
+	}
+
+	return p.MaxAttempts
+}
+
+func (p RetryPolicy) shouldRetry(method string, err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	// A dial timeout means the request never reached the server, so it is safe for any method.
+	if !errors.Is(err, fasthttp.ErrDialTimeout) && !p.RetryNonIdempotent && !isIdempotent(method) {
+		return false
+	}
+
+	retryOn := p.RetryOn
+	if retryOn == nil {
+		retryOn = DefaultRetryOn
+	}
+
+	return retryOn(err)
+}
+
+// backoff returns the delay before the given retry (1 for the first retry).
+func (p RetryPolicy) backoff(retry int, retryAfter time.Duration) time.Duration {
+	if p.RespectRetryAfter && retryAfter > 0 {
+		if p.MaxDelay > 0 {
+			return min(retryAfter, p.MaxDelay)
+		}
+
+		return retryAfter
+	}
+
+	if p.BaseDelay <= 0 {
+		return 0
+	}
+
+	delay := p.BaseDelay
+	for i := 1; i < retry; i++ {
+		delay *= 2
+
+		if p.MaxDelay > 0 && delay >= p.MaxDelay {
+			break
+		}
+	}
+
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	if p.Jitter > 0 {
+		jitter := min(p.Jitter, 1)
+		delay -= time.Duration(float64(delay) * jitter * rand.Float64())
+	}
+
+	return delay
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+// classifyTransportError converts a transport failure into the typed errors used by Endpoint.
+func classifyTransportError(err error) error {
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return err
+	case errors.Is(err, fasthttp.ErrDialTimeout):
+		return err
+	case isTimeoutError(err):
+		return &TimeoutError{Message: err.Error(), Cause: err}
+	case isNetworkError(err):
+		return &NetworkError{Message: err.Error(), Cause: err}
+	default:
+		return err
+	}
+}
+
+var errorStatusMarker = []byte(`"status":"error"`)
+
+// classifyResponse returns the typed HTTP error of a response, or nil for a successful one.
+// Twelve Data may report failures with HTTP 200 and an error code in the body, those are
+// classified by the body code.
+func classifyResponse(statusCode int, body []byte, uri string) error {
+	if statusCode < http.StatusBadRequest && !bytes.Contains(body, errorStatusMarker) {
+		return nil
+	}
+
+	var apiError *response.Error
+
+	var parsed response.Error
+	if err := json.Unmarshal(body, &parsed); err == nil && parsed.Status == "error" {
+		apiError = &parsed
+	}
+
+	if statusCode >= http.StatusBadRequest {
+		return NewHTTPError(statusCode, body, uri, apiError, nil)
+	}
+
+	if apiError != nil && apiError.Code.Valid && apiError.Code.Int64 >= http.StatusBadRequest {
+		return NewHTTPError(int(apiError.Code.Int64), body, uri, apiError, nil)
+	}
+
+	return nil
+}
+
+// parseRetryAfter parses a Retry-After header holding either seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+
+	return 0
+}
+
+// sleepCtx waits for d or until ctx is done.
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,351 @@
+package twelvedata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/soulgarden/twelvedata/dictionary"
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+	"github.com/valyala/fasthttp"
+)
+
+// newSequenceServer replies with the given status codes in order, repeating the last one.
+func newSequenceServer(t *testing.T, calls *atomic.Int32, headers map[string]string, statuses ...int) string {
+	t.Helper()
+
+	return newTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		call := int(calls.Add(1))
+
+		status := statuses[len(statuses)-1]
+		if call <= len(statuses) {
+			status = statuses[call-1]
+		}
+
+		for key, val := range headers {
+			w.Header().Set(key, val)
+		}
+
+		w.Header().Set("Api-credits-left", "100")
+		w.Header().Set("Api-credits-used", "1")
+		w.WriteHeader(status)
+
+		body := `{"status":"ok"}`
+		if status != http.StatusOK {
+			body = `{"code":` + strconv.Itoa(status) + `,"message":"failure","status":"error"}`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func newRetryHTTPCli(serverURL string, policy RetryPolicy) *HTTPCli {
+	httpCli := newTestHTTPCli(serverURL)
+	WithRetryPolicy(policy)(httpCli)
+
+	return httpCli
+}
+
+func fastRetryPolicy(attempts int) RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.MaxAttempts = attempts
+	policy.BaseDelay = time.Millisecond
+	policy.MaxDelay = 5 * time.Millisecond
+
+	return policy
+}
+
+func TestHTTPCli_Retry_ServerErrors(t *testing.T) {
+	var calls atomic.Int32
+
+	serverURL := newSequenceServer(t, &calls, nil, http.StatusServiceUnavailable, http.StatusInternalServerError, http.StatusOK)
+	endpoint := NewEndpoint[headerRequest, testResponse, response.Credits, error](newRetryHTTPCli(serverURL, fastRetryPolicy(3)), serverURL)
+
+	resp, _, err := endpoint.Call(headerRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.Status != "ok" {
+		t.Fatalf("unexpected status: %s", resp.Status)
+	}
+
+	if got := calls.Load(); got != 3 {
+		t.Fatalf("expected 3 attempts, got %d", got)
+	}
+}
+
+func TestHTTPCli_Retry_GivesUpAfterMaxAttempts(t *testing.T) {
+	var calls atomic.Int32
+
+	serverURL := newSequenceServer(t, &calls, nil, http.StatusTooManyRequests)
+	endpoint := NewEndpoint[headerRequest, testResponse, response.Credits, error](newRetryHTTPCli(serverURL, fastRetryPolicy(2)), serverURL)
+
+	_, _, err := endpoint.Call(headerRequest{})
+	if !IsRateLimitError(err) {
+		t.Fatalf("expected rate limit error, got %v", err)
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected 2 attempts, got %d", got)
+	}
+}
+
+func TestHTTPCli_Retry_ChargesEveryAttempt(t *testing.T) {
+	var calls atomic.Int32
+
+	serverURL := newSequenceServer(t, &calls, nil, http.StatusServiceUnavailable, http.StatusInternalServerError, http.StatusOK)
+
+	ledger := NewCreditLedger()
+	limiter := NewCreditLimiter(100, LimiterFailFast)
+
+	cli := newTestClient(t, serverURL, profileConf,
+		WithRetryPolicy(fastRetryPolicy(3)),
+		WithCreditLedger(ledger),
+		WithCreditLimiter(limiter),
+	)
+
+	if _, _, err := cli.GetProfile(request.GetProfile{Symbol: "AAPL"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := calls.Load(); got != 3 {
+		t.Fatalf("expected 3 attempts, got %d", got)
+	}
+
+	if got := ledger.Snapshot().Total; got != (CreditUsage{Calls: 3, Credits: 3}) {
+		t.Errorf("expected the ledger to record every attempt, got %+v", got)
+	}
+
+	if got := limiter.Available(); got != 100-3*dictionary.Profile {
+		t.Errorf("expected the limiter to charge every attempt, got %d credits available", got)
+	}
+}
+
+func TestHTTPCli_Retry_ErrorCodeInOKBody(t *testing.T) {
+	var calls atomic.Int32
+
+	serverURL := newTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		body := `{"status":"ok"}`
+		if calls.Add(1) == 1 {
+			body = `{"code":429,"message":"You have run out of API credits for the current minute.","status":"error"}`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Error(err)
+		}
+	})
+
+	endpoint := NewEndpoint[headerRequest, testResponse, response.Credits, error](newRetryHTTPCli(serverURL, fastRetryPolicy(3)), serverURL)
+
+	if _, _, err := endpoint.Call(headerRequest{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected 2 attempts, got %d", got)
+	}
+}
+
+func TestHTTPCli_Retry_DoesNotRetryClientErrors(t *testing.T) {
+	var calls atomic.Int32
+
+	serverURL := newSequenceServer(t, &calls, nil, http.StatusBadRequest, http.StatusOK)
+	endpoint := NewEndpoint[headerRequest, testResponse, response.Credits, error](newRetryHTTPCli(serverURL, fastRetryPolicy(3)), serverURL)
+
+	if _, _, err := endpoint.Call(headerRequest{}); !IsBadRequestError(err) {
+		t.Fatalf("expected bad request error, got %v", err)
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("expected 1 attempt, got %d", got)
+	}
+}
+
+func TestHTTPCli_Retry_BatchesPOST(t *testing.T) {
+	tests := []struct {
+		name         string
+		optIn        bool
+		wantAttempts int32
+	}{
+		{name: "not retried by default", optIn: false, wantAttempts: 1},
+		{name: "retried when opted in", optIn: true, wantAttempts: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+
+			serverURL := newSequenceServer(t, &calls, nil, http.StatusServiceUnavailable, http.StatusOK)
+
+			policy := fastRetryPolicy(3)
+			policy.RetryNonIdempotent = tt.optIn
+
+			endpoint := NewEndpoint[request.GetBatches, response.Batches, response.Credits, error](newRetryHTTPCli(serverURL, policy), serverURL)
+
+			_, _, _ = endpoint.Call(request.GetBatches{})
+
+			if got := calls.Load(); got != tt.wantAttempts {
+				t.Fatalf("expected %d attempts, got %d", tt.wantAttempts, got)
+			}
+		})
+	}
+}
+
+func TestHTTPCli_Retry_RetryOn(t *testing.T) {
+	var calls atomic.Int32
+
+	serverURL := newSequenceServer(t, &calls, nil, http.StatusTooManyRequests, http.StatusOK)
+
+	policy := fastRetryPolicy(3)
+	policy.RetryOn = IsServerError
+
+	endpoint := NewEndpoint[headerRequest, testResponse, response.Credits, error](newRetryHTTPCli(serverURL, policy), serverURL)
+
+	if _, _, err := endpoint.Call(headerRequest{}); !IsRateLimitError(err) {
+		t.Fatalf("expected rate limit error, got %v", err)
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("expected 1 attempt, got %d", got)
+	}
+}
+
+func TestHTTPCli_Retry_RetryAfter(t *testing.T) {
+	var calls atomic.Int32
+
+	serverURL := newSequenceServer(t, &calls, map[string]string{"Retry-After": "1"}, http.StatusTooManyRequests, http.StatusOK)
+
+	policy := fastRetryPolicy(2)
+	policy.Jitter = 0
+	policy.MaxDelay = 2 * time.Second
+
+	endpoint := NewEndpoint[headerRequest, testResponse, response.Credits, error](newRetryHTTPCli(serverURL, policy), serverURL)
+
+	start := time.Now()
+
+	if _, _, err := endpoint.Call(headerRequest{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("Retry-After was not respected, retried after %s", elapsed)
+	}
+}
+
+func TestHTTPCli_Retry_ContextCancelledDuringBackoff(t *testing.T) {
+	var calls atomic.Int32
+
+	serverURL := newSequenceServer(t, &calls, nil, http.StatusServiceUnavailable)
+
+	policy := fastRetryPolicy(5)
+	policy.BaseDelay = time.Minute
+	policy.MaxDelay = time.Minute
+
+	endpoint := NewEndpoint[headerRequest, testResponse, response.Credits, error](newRetryHTTPCli(serverURL, policy), serverURL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	if _, _, err := endpoint.CallCtx(ctx, headerRequest{}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("expected 1 attempt, got %d", got)
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second, RespectRetryAfter: true}
+
+	tests := []struct {
+		name       string
+		retry      int
+		retryAfter time.Duration
+		want       time.Duration
+	}{
+		{name: "first retry", retry: 1, want: 100 * time.Millisecond},
+		{name: "third retry", retry: 3, want: 400 * time.Millisecond},
+		{name: "capped", retry: 10, want: time.Second},
+		{name: "retry after wins", retry: 1, retryAfter: 700 * time.Millisecond, want: 700 * time.Millisecond},
+		{name: "retry after capped", retry: 1, retryAfter: 3 * time.Second, want: time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.backoff(tt.retry, tt.retryAfter); got != tt.want {
+				t.Errorf("backoff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	policy.Jitter = 0.5
+	for range 100 {
+		if got := policy.backoff(1, 0); got < 50*time.Millisecond || got > 100*time.Millisecond {
+			t.Fatalf("jittered backoff out of range: %v", got)
+		}
+	}
+
+	policy.MaxDelay = 0
+	if got := policy.backoff(1, time.Minute); got != time.Minute {
+		t.Errorf("uncapped Retry-After backoff() = %v, want %v", got, time.Minute)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{name: "empty", value: "", want: 0},
+		{name: "seconds", value: "7", want: 7 * time.Second},
+		{name: "negative", value: "-1", want: 0},
+		{name: "http date", value: now.Add(30 * time.Second).Format(http.TimeFormat), want: 30 * time.Second},
+		{name: "past date", value: now.Add(-time.Minute).Format(http.TimeFormat), want: 0},
+		{name: "garbage", value: "soon", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRetryAfter(tt.value, now); got != tt.want {
+				t.Errorf("parseRetryAfter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultRetryOn(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "rate limit", err: NewHTTPError(http.StatusTooManyRequests, nil, "", nil, nil), want: true},
+		{name: "internal server error", err: NewHTTPError(http.StatusInternalServerError, nil, "", nil, nil), want: true},
+		{name: "bad gateway", err: NewHTTPError(http.StatusBadGateway, nil, "", nil, nil), want: true},
+		{name: "network", err: &NetworkError{Message: "connection reset"}, want: true},
+		{name: "timeout", err: &TimeoutError{Message: "timeout"}, want: true},
+		{name: "dial timeout", err: fasthttp.ErrDialTimeout, want: true},
+		{name: "bad request", err: NewHTTPError(http.StatusBadRequest, nil, "", nil, nil), want: false},
+		{name: "insufficient credits", err: &InsufficientCreditsError{Message: "insufficient credits"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DefaultRetryOn(tt.err); got != tt.want {
+				t.Errorf("DefaultRetryOn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
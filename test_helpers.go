@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"reflect"
 	"strconv"
 	"testing"
@@ -14,6 +15,28 @@ import (
 	"github.com/valyala/fasthttp"
 )
 
+// testTransportEnv selects the transport of the test clients, "nethttp" runs the suite over net/http.
+const testTransportEnv = "TWELVEDATA_TEST_TRANSPORT"
+
+// withTestTransport switches c to the net/http transport when selected by testTransportEnv.
+func withTestTransport(c *HTTPCli) *HTTPCli {
+	if os.Getenv(testTransportEnv) == "nethttp" {
+		WithTransport(NewNetHTTPDoer(&http.Client{}))(c)
+	}
+
+	return c
+}
+
+// newTestServer starts a test HTTP server of handler, closed when the test ends, and returns its URL.
+func newTestServer(t *testing.T, handler http.HandlerFunc) string {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return server.URL
+}
+
 // mockServerWithURL creates a test HTTP server with the specified response parameters
 // and validates that the request URL matches the expected URL pattern.
 func mockServerWithURL(t *testing.T, responseCode int, wantCreditsLeft, wantCreditsUsed int64, responseBody string, expectedURL string) string {
@@ -140,14 +163,14 @@ func testEndpointCall[Req any, Resp any](
 ) {
 	t.Helper()
 
-	endpoint := createEndpoint(&HTTPCli{
+	endpoint := createEndpoint(withTestTransport(&HTTPCli{
 		transport: &fasthttp.Client{},
 		cfg: &Conf{
 			Timeout: 1,
 			BaseURL: args.url,
 		},
 		logger: &zerolog.Logger{},
-	}, args.url)
+	}), args.url)
 
 	got, gotCredits, err := callEndpoint(endpoint, args.req)
 
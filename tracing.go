@@ -0,0 +1,102 @@
+package twelvedata
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/soulgarden/twelvedata/response"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// instrumentationName is the OpenTelemetry instrumentation scope of this package.
+const instrumentationName = "github.com/soulgarden/twelvedata"
+
+// Span attribute keys.
+const (
+	attrEndpoint    = attribute.Key("twelvedata.endpoint")
+	attrSymbol      = attribute.Key("twelvedata.symbol")
+	attrCreditsUsed = attribute.Key("twelvedata.credits.used")
+	attrCreditsLeft = attribute.Key("twelvedata.credits.left")
+	attrDryRun      = attribute.Key("twelvedata.dry_run")
+	attrCacheHit    = attribute.Key("twelvedata.cache_hit")
+	attrCoalesced   = attribute.Key("twelvedata.coalesced")
+	attrSymbolCount = attribute.Key("twelvedata.ws.symbols")
+	attrMethod      = attribute.Key("http.request.method")
+	attrStatusCode  = attribute.Key("http.response.status_code")
+	attrServerHost  = attribute.Key("server.address")
+	attrErrorType   = attribute.Key("error.type")
+	attrAttempt     = attribute.Key("twelvedata.retry.attempt")
+)
+
+// WithTracerProvider creates a client span for every endpoint call using provider.
+func WithTracerProvider(provider trace.TracerProvider) HTTPCliOption {
+	return func(c *HTTPCli) {
+		c.tracer = provider.Tracer(instrumentationName)
+	}
+}
+
+// WSOption configures optional WS behaviour.
+type WSOption func(*WS)
+
+// WithWSTracerProvider creates spans for WS connect, subscribe, unsubscribe and reset operations using provider.
+func WithWSTracerProvider(provider trace.TracerProvider) WSOption {
+	return func(ws *WS) {
+		ws.tracer = provider.Tracer(instrumentationName)
+	}
+}
+
+// startSpan starts a span with tracer, or returns a no-op span when tracing is disabled.
+func startSpan(ctx context.Context, tracer trace.Tracer, name string, kind trace.SpanKind, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if tracer == nil {
+		return ctx, noop.Span{}
+	}
+
+	return tracer.Start(ctx, name, trace.WithSpanKind(kind), trace.WithAttributes(attrs...))
+}
+
+// endCallSpan records the outcome of an endpoint call and ends its span.
+func endCallSpan(span trace.Span, statusCode int, creds response.Credits, err error) {
+	if span.IsRecording() {
+		if statusCode > 0 {
+			span.SetAttributes(attrStatusCode.Int(statusCode))
+		}
+
+		if creds != nil {
+			span.SetAttributes(attrCreditsUsed.Int64(creds.GetCreditsUsed()), attrCreditsLeft.Int64(creds.GetCreditsLeft()))
+		}
+	}
+
+	endSpan(span, err)
+}
+
+// endSpan marks span as failed when err is set and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil && span.IsRecording() {
+		span.SetAttributes(attrErrorType.String(ErrorClass(err)))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// wsSpan starts a span for a WS operation.
+func (ws *WS) wsSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if ws.url != nil {
+		attrs = append(attrs, attrServerHost.String(ws.url.Host))
+	}
+
+	return startSpan(ctx, ws.tracer, name, trace.SpanKindClient, attrs...)
+}
+
+// symbolAttribute returns the symbol query parameter of a call, if any.
+func symbolAttribute(values url.Values) []attribute.KeyValue {
+	if symbol := values.Get("symbol"); symbol != "" {
+		return []attribute.KeyValue{attrSymbol.String(symbol)}
+	}
+
+	return nil
+}
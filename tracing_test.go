@@ -0,0 +1,282 @@
+package twelvedata
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fasthttp/websocket"
+	"github.com/rs/zerolog"
+	"github.com/soulgarden/twelvedata/request"
+	"github.com/soulgarden/twelvedata/response"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newTestTracerProvider(t *testing.T) (*sdktrace.TracerProvider, *tracetest.InMemoryExporter) {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+
+	return provider, exporter
+}
+
+func spanAttributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes {
+		attrs[kv.Key] = kv.Value
+	}
+
+	return attrs
+}
+
+func TestEndpoint_Tracing_Success(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusOK, 97, 3, `{"symbol":"AAPL","name":"Apple Inc"}`, "/quote?symbol=AAPL")
+
+	provider, exporter := newTestTracerProvider(t)
+
+	cli := newTestClient(t, serverURL, quoteConf, WithTracerProvider(provider))
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "job")
+
+	if _, _, err := cli.GetQuoteCtx(ctx, request.GetQuote{Symbol: "AAPL"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	parent.End()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+
+	span := spans[0]
+	if span.Name != "/quote" || span.Status.Code == codes.Error {
+		t.Fatalf("unexpected span: %+v", span)
+	}
+
+	if span.Parent.SpanID() != spans[1].SpanContext.SpanID() {
+		t.Error("endpoint span is not a child of the caller span")
+	}
+
+	attrs := spanAttributes(span)
+
+	checks := map[attribute.Key]string{
+		attrEndpoint:    "/quote",
+		attrSymbol:      "AAPL",
+		attrMethod:      http.MethodGet,
+		attrStatusCode:  "200",
+		attrCreditsUsed: "3",
+		attrCreditsLeft: "97",
+	}
+
+	for key, want := range checks {
+		if got := attrs[key].Emit(); got != want {
+			t.Errorf("attribute %s = %q, want %q", key, got, want)
+		}
+	}
+
+	if _, ok := attrs[attrErrorType]; ok {
+		t.Error("unexpected error.type attribute on a successful call")
+	}
+}
+
+func TestEndpoint_Tracing_Error(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusOK, 0, 0,
+		`{"code":404,"message":"**symbol** not found: NOPE. Please specify it correctly","status":"error"}`, "/quote?symbol=NOPE")
+
+	provider, exporter := newTestTracerProvider(t)
+
+	cli := newTestClient(t, serverURL, quoteConf, WithTracerProvider(provider))
+
+	if _, _, err := cli.GetQuote(request.GetQuote{Symbol: "NOPE"}); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+
+	if spans[0].Status.Code != codes.Error {
+		t.Errorf("expected error status, got %+v", spans[0].Status)
+	}
+
+	if got := spanAttributes(spans[0])[attrErrorType].AsString(); got != "SymbolNotFoundError" {
+		t.Errorf("unexpected error.type: %q", got)
+	}
+}
+
+func TestEndpoint_Tracing_RetryEvents(t *testing.T) {
+	var calls atomic.Int32
+
+	serverURL := newSequenceServer(t, &calls, nil, http.StatusServiceUnavailable, http.StatusOK)
+
+	provider, exporter := newTestTracerProvider(t)
+
+	httpCli := newRetryHTTPCli(serverURL, fastRetryPolicy(2))
+	WithTracerProvider(provider)(httpCli)
+
+	endpoint := NewEndpoint[headerRequest, testResponse, response.Credits, error](httpCli, serverURL)
+	if _, _, err := endpoint.Call(headerRequest{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 || len(spans[0].Events) != 1 || spans[0].Events[0].Name != "retry" {
+		t.Fatalf("expected one span with a retry event, got %+v", spans)
+	}
+}
+
+func TestEndpoint_Tracing_Disabled(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusOK, 100, 1, `{"status":"ok"}`, "/")
+
+	provider, exporter := newTestTracerProvider(t)
+
+	endpoint := NewEndpoint[headerRequest, testResponse, response.Credits, error](newTestHTTPCli(serverURL), serverURL)
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "job")
+
+	if _, _, err := endpoint.CallCtx(ctx, headerRequest{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if spans := exporter.GetSpans(); len(spans) != 0 {
+		t.Fatalf("expected no spans before the caller span ends, got %d", len(spans))
+	}
+
+	parent.End()
+
+	if spans := exporter.GetSpans(); len(spans) != 1 {
+		t.Fatalf("expected only the caller span, got %d", len(spans))
+	}
+}
+
+func TestWS_Tracing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{CheckOrigin: func(_ *http.Request) bool { return true }}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("WebSocket upgrade failed: %v", err)
+
+			return
+		}
+		defer func() { _ = conn.Close() }()
+
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	provider, exporter := newTestTracerProvider(t)
+
+	logger := zerolog.Nop()
+	cfg := &Conf{
+		BaseWSURL: strings.TrimPrefix(server.URL, "http://"),
+		APIKey:    "test-key",
+		WebSocket: WebSocket{PriceURL: "/quotes/price"},
+	}
+
+	ws := NewWS(cfg, &logger, nil, WithWSTracerProvider(provider))
+	ws.url.Scheme = "ws"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if err := ws.Connect(ctx); err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+
+	if err := ws.SubscribeCtx(ctx, []string{"AAPL", "MSFT"}); err != nil {
+		t.Fatalf("SubscribeCtx() failed: %v", err)
+	}
+
+	if err := ws.Reset(); err != nil {
+		t.Fatalf("Reset() failed: %v", err)
+	}
+
+	_ = ws.Close()
+
+	if err := ws.Unsubscribe([]string{"AAPL"}); err == nil {
+		t.Fatal("expected error after close")
+	}
+
+	spans := exporter.GetSpans()
+
+	var names []string
+	for _, span := range spans {
+		names = append(names, span.Name)
+	}
+
+	if strings.Join(names, ",") != "ws.connect,ws.subscribe,ws.reset,ws.unsubscribe" {
+		t.Fatalf("unexpected spans: %v", names)
+	}
+
+	if got := spanAttributes(spans[1])[attrSymbolCount].AsInt64(); got != 2 {
+		t.Errorf("unexpected symbol count: %d", got)
+	}
+
+	if spans[3].Status.Code != codes.Error {
+		t.Errorf("expected failed unsubscribe span, got %+v", spans[3].Status)
+	}
+}
+
+func TestWS_Tracing_ConnectError(t *testing.T) {
+	provider, exporter := newTestTracerProvider(t)
+
+	logger := zerolog.Nop()
+	cfg := &Conf{BaseWSURL: "127.0.0.1:1", APIKey: "test-key", WebSocket: WebSocket{PriceURL: "/quotes/price"}}
+
+	ws := NewWS(cfg, &logger, nil, WithWSTracerProvider(provider))
+	ws.url.Scheme = "ws"
+
+	if err := ws.Connect(context.Background()); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 || spans[0].Status.Code != codes.Error {
+		t.Fatalf("expected a failed ws.connect span, got %+v", spans)
+	}
+
+	if got := spanAttributes(spans[0])[attrErrorType].AsString(); got != "WSConnectionError" {
+		t.Errorf("unexpected error.type: %q", got)
+	}
+}
+
+func TestErrorClass(t *testing.T) {
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{err: nil, want: ""},
+		{err: context.Canceled, want: "Canceled"},
+		{err: NewHTTPError(http.StatusTooManyRequests, nil, "", nil, nil), want: "TooManyRequestsError"},
+		{err: NewHTTPError(http.StatusInternalServerError, nil, "", nil, nil), want: "InternalServerError"},
+		{err: NewHTTPError(http.StatusBadGateway, nil, "", nil, nil), want: "HTTPError"},
+		{err: &TimeoutError{Message: "timeout"}, want: "TimeoutError"},
+		{err: &CreditCapExceededError{Cap: 1}, want: "CreditCapExceededError"},
+		{err: &InsufficientCreditsError{Message: "x"}, want: "InsufficientCreditsError"},
+		{err: &request.ValidationError{Request: "GetQuote"}, want: "ValidationError"},
+		{err: context.DeadlineExceeded, want: "Error"},
+	}
+
+	for _, tt := range tests {
+		if got := ErrorClass(tt.err); got != tt.want {
+			t.Errorf("ErrorClass(%v) = %q, want %q", tt.err, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package twelvedata
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+// WithTransport sends requests through doer instead of the *fasthttp.Client passed to NewHTTPCli.
+// Retries, interceptors, timeouts and credit parsing behave the same for every transport.
+func WithTransport(doer Doer) HTTPCliOption {
+	return func(c *HTTPCli) {
+		c.doer = doer
+	}
+}
+
+// NewFastHTTPDoer returns a Doer sending requests with client, bounded by the context deadline.
+func NewFastHTTPDoer(client *fasthttp.Client) Doer {
+	return fastHTTPDoer{client: client}
+}
+
+// NewNetHTTPDoer returns a Doer sending requests with client, e.g. to use proxies, custom TLS roots,
+// HTTP/2 or existing RoundTripper instrumentation. A nil client means http.DefaultClient.
+func NewNetHTTPDoer(client *http.Client) Doer {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return netHTTPDoer{client: client}
+}
+
+type fastHTTPDoer struct {
+	client *fasthttp.Client
+}
+
+// Do implements Doer.
+func (d fastHTTPDoer) Do(ctx context.Context, httpReq *HTTPRequest) (*HTTPResponse, error) {
+	req := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(req)
+
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseResponse(resp)
+
+	toFastHTTPRequest(httpReq, req)
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Time{}
+	}
+
+	if err := doFastHTTP(ctx, d.client, req, resp, deadline); err != nil {
+		return nil, err
+	}
+
+	return fromFastHTTPResponse(resp), nil
+}
+
+type netHTTPDoer struct {
+	client *http.Client
+}
+
+// Do implements Doer.
+func (d netHTTPDoer) Do(ctx context.Context, httpReq *HTTPRequest) (*HTTPResponse, error) {
+	var body io.Reader
+	if httpReq.Body != nil {
+		body = bytes.NewReader(httpReq.Body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, httpReq.Method, httpReq.URL, body)
+	if err != nil {
+		return nil, fmt.Errorf("new request: %w", err)
+	}
+
+	for key, values := range httpReq.Header {
+		if key == fasthttp.HeaderContentLength || key == fasthttp.HeaderHost {
+			continue
+		}
+
+		req.Header[key] = values
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, redactURLError(err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read body: %w", err)
+	}
+
+	return &HTTPResponse{StatusCode: resp.StatusCode, Header: resp.Header, Body: respBody}, nil
+}
+
+// sendDoer sends req through the configured Doer with deadline applied to the context.
+// Reaching the Conf.Timeout deadline yields a TimeoutError, like fasthttp.ErrTimeout does for the default transport.
+func (c *HTTPCli) sendDoer(ctx context.Context, req *fasthttp.Request, resp *fasthttp.Response, deadline time.Time) error {
+	doerCtx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	httpResp, err := c.doer.Do(doerCtx, fromFastHTTPRequest(req))
+	if err != nil {
+		if ctx.Err() == nil && errors.Is(doerCtx.Err(), context.DeadlineExceeded) {
+			return &TimeoutError{Message: err.Error(), Cause: err}
+		}
+
+		return err
+	}
+
+	toFastHTTPResponse(httpResp, resp)
+
+	return nil
+}
+
+// doFastHTTP sends req with client until deadline, a zero deadline means no deadline.
+// fasthttp has no native context support, so when the context can be cancelled the request runs
+// on copies in a separate goroutine and the caller is released as soon as the context is done.
+func doFastHTTP(ctx context.Context, client *fasthttp.Client, req *fasthttp.Request, resp *fasthttp.Response, deadline time.Time) error {
+	do := func(req *fasthttp.Request, resp *fasthttp.Response) error {
+		if deadline.IsZero() {
+			return client.Do(req, resp)
+		}
+
+		return client.DoDeadline(req, resp, deadline)
+	}
+
+	if ctx.Done() == nil {
+		return do(req, resp)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	inflightReq := fasthttp.AcquireRequest()
+	inflightResp := fasthttp.AcquireResponse()
+
+	req.CopyTo(inflightReq)
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- do(inflightReq, inflightResp)
+	}()
+
+	release := func() {
+		fasthttp.ReleaseRequest(inflightReq)
+		fasthttp.ReleaseResponse(inflightResp)
+	}
+
+	select {
+	case err := <-done:
+		if err == nil {
+			inflightResp.CopyTo(resp)
+		}
+
+		release()
+
+		return err
+	case <-ctx.Done():
+		// The transport keeps the copies until the request ends, release them afterwards.
+		go func() {
+			<-done
+			release()
+		}()
+
+		return ctx.Err()
+	}
+}
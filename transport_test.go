@@ -0,0 +1,121 @@
+package twelvedata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/soulgarden/twelvedata/response"
+	"github.com/valyala/fasthttp"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func testTransports() map[string]Doer {
+	return map[string]Doer{
+		"fasthttp": NewFastHTTPDoer(&fasthttp.Client{}),
+		"net/http": NewNetHTTPDoer(&http.Client{}),
+	}
+}
+
+func TestTransport_GetCredits(t *testing.T) {
+	serverURL := mockServerWithURL(t, http.StatusOK, 97, 3, `{"status":"ok"}`, "/")
+
+	for name, doer := range testTransports() {
+		t.Run(name, func(t *testing.T) {
+			logger := zerolog.Nop()
+			httpCli := NewHTTPCli(nil, &Conf{Timeout: 1}, &logger, WithTransport(doer))
+
+			resp := fasthttp.AcquireResponse()
+			defer fasthttp.ReleaseResponse(resp)
+
+			creditsLeft, creditsUsed, err := httpCli.makeRequest(serverURL, resp)
+			if err != nil {
+				t.Fatalf("makeRequest() error = %v", err)
+			}
+
+			if creditsLeft != 97 || creditsUsed != 3 {
+				t.Fatalf("credits = %d/%d, want 97/3", creditsLeft, creditsUsed)
+			}
+
+			if string(resp.Body()) != `{"status":"ok"}` {
+				t.Fatalf("unexpected body %q", resp.Body())
+			}
+		})
+	}
+}
+
+func TestTransport_NetHTTPRoundTripper(t *testing.T) {
+	serverURL := mockServerWithRequest(t, http.StatusOK, 100, 1, `{"status":"ok"}`, expectedRequest{
+		Method:  http.MethodGet,
+		URL:     "/",
+		Headers: map[string]string{"X-Test": "value"},
+	})
+
+	var calls atomic.Int32
+
+	client := &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		calls.Add(1)
+
+		return http.DefaultTransport.RoundTrip(req)
+	})}
+
+	httpCli := newTestHTTPCli(serverURL)
+	WithTransport(NewNetHTTPDoer(client))(httpCli)
+
+	endpoint := NewEndpoint[headerRequest, testResponse, response.Credits, error](httpCli, serverURL)
+
+	resp, creds, err := endpoint.Call(headerRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.Status != "ok" || creds.GetCreditsLeft() != 100 || creds.GetCreditsUsed() != 1 {
+		t.Fatalf("unexpected result: %+v, %+v", resp, creds)
+	}
+
+	if calls.Load() != 1 {
+		t.Fatalf("expected the request to pass the custom RoundTripper once, got %d", calls.Load())
+	}
+}
+
+func TestTransport_Timeouts(t *testing.T) {
+	serverURL := newSlowServer(t, 2*time.Second)
+
+	for name, doer := range testTransports() {
+		t.Run(name+"/conf timeout", func(t *testing.T) {
+			httpCli := newTestHTTPCli(serverURL)
+			WithTransport(doer)(httpCli)
+
+			endpoint := NewEndpoint[headerRequest, testResponse, response.Credits, error](httpCli, serverURL)
+
+			_, _, err := endpoint.Call(headerRequest{})
+			if !IsTimeoutError(err) {
+				t.Fatalf("expected TimeoutError, got %v", err)
+			}
+		})
+
+		t.Run(name+"/cancel", func(t *testing.T) {
+			httpCli := newTestHTTPCli(serverURL)
+			WithTransport(doer)(httpCli)
+
+			endpoint := NewEndpoint[headerRequest, testResponse, response.Credits, error](httpCli, serverURL)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(50*time.Millisecond, cancel)
+
+			_, _, err := endpoint.CallCtx(ctx, headerRequest{})
+			if !errors.Is(err, context.Canceled) {
+				t.Fatalf("expected context.Canceled, got %v", err)
+			}
+		})
+	}
+}
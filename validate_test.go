@@ -0,0 +1,116 @@
+package twelvedata
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	"github.com/soulgarden/twelvedata/request"
+)
+
+func TestRequestValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        RequestValidator
+		wantFields []string
+	}{
+		{
+			name: "valid time series",
+			req: request.GetTimeSeries{
+				Symbol: "AAPL", Interval: "1day", Order: "DESC", Format: "csv",
+				StartDate: "2024-01-01", EndDate: "2024-02-01 16:00:00", Adjust: "splits",
+			},
+		},
+		{name: "identifier other than symbol", req: request.GetTimeSeries{ISIN: "US0378331005", Interval: "1h"}},
+		{name: "several symbols", req: request.GetQuote{Symbol: "AAPL,MSFT"}},
+		{name: "relative date", req: request.GetEOD{Symbol: "AAPL", Date: "yesterday"}},
+		{name: "missing identifier and interval", req: request.GetTimeSeries{}, wantFields: []string{"Symbol", "Interval"}},
+		{
+			name:       "several identifiers",
+			req:        request.GetRSI{Symbol: "AAPL", FIGI: "BBG000B9XRY4", CUSIP: "037833100", Interval: "1day"},
+			wantFields: []string{"FIGI", "CUSIP"},
+		},
+		{
+			name: "identifiers as catalog filters",
+			req:  request.GetStock{Symbol: "AAPL", Figi: "BBG000B9Y5X2", Isin: "US0378331005", Cusip: "037833100"},
+		},
+		{name: "identifiers as calendar filters", req: request.GetDividendsCalendar{Symbol: "AAPL", FIGI: "BBG000B9Y5X2"}},
+		{name: "unknown interval", req: request.GetSMA{Symbol: "AAPL", Interval: "2day"}, wantFields: []string{"Interval"}},
+		{
+			name:       "malformed dates",
+			req:        request.GetTimeSeries{Symbol: "AAPL", Interval: "1day", StartDate: "01/02/2024", Date: "tomorrow"},
+			wantFields: []string{"Date", "StartDate"},
+		},
+		{
+			name:       "end before start",
+			req:        request.GetDividends{Symbol: "AAPL", StartDate: "2024-02-01", EndDate: "2024-01-01"},
+			wantFields: []string{"EndDate"},
+		},
+		{name: "negative output size", req: request.GetBonds{OutputSize: -1}, wantFields: []string{"OutputSize"}},
+		{
+			name:       "enumerations",
+			req:        request.GetBBands{Symbol: "AAPL", Interval: "1day", MAType: "XMA", SeriesType: "median", Order: "up"},
+			wantFields: []string{"MAType", "SeriesType", "Order"},
+		},
+		{name: "statement period", req: request.GetIncomeStatement{Symbol: "AAPL", Period: "monthly"}, wantFields: []string{"Period"}},
+		{name: "missing market", req: request.GetMarketMovers{}, wantFields: []string{"Market"}},
+		{name: "unknown market", req: request.GetMarketMovers{Market: "bonds", Direction: "up"}, wantFields: []string{"Direction", "Market"}},
+		{name: "sanctions source", req: request.GetSanctionedEntities{Source: "un"}, wantFields: []string{"Source"}},
+		{name: "missing last change endpoint", req: request.GetLastChange{}, wantFields: []string{"Endpoint"}},
+		{name: "cross pair", req: request.GetTimeSeriesCross{Base: "JPY", Interval: "1day"}, wantFields: []string{"Quote"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			var validationErr *request.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("expected a ValidationError, got %v", err)
+			}
+
+			fields := make([]string, 0, len(validationErr.Fields))
+			for _, field := range validationErr.Fields {
+				fields = append(fields, field.Field)
+			}
+
+			if !slices.Equal(fields, tt.wantFields) {
+				t.Errorf("invalid fields = %v, want %v (%v)", fields, tt.wantFields, err)
+			}
+		})
+	}
+}
+
+func TestCall_ValidationErrorNotSent(t *testing.T) {
+	var requests atomic.Int32
+
+	serverURL := newTestServer(t, func(http.ResponseWriter, *http.Request) {
+		requests.Add(1)
+	})
+
+	cli := newTestClient(t, serverURL, Conf{CoreData: CoreData{TimeSeriesURL: "/time_series"}})
+
+	_, creds, err := cli.GetTimeSeries(request.GetTimeSeries{Symbol: "AAPL", Interval: "2min", OutputSize: -5})
+	if !IsValidationError(err) || IsBadRequestError(err) {
+		t.Fatalf("expected a ValidationError, got %v", err)
+	}
+
+	want := "invalid GetTimeSeries: Interval: unknown value \"2min\", expected one of " +
+		"1min, 5min, 15min, 30min, 45min, 1h, 2h, 4h, 8h, 1day, 1week, 1month; OutputSize: must not be negative"
+	if err.Error() != want {
+		t.Errorf("error = %q, want %q", err.Error(), want)
+	}
+
+	if requests.Load() != 0 || creds != nil {
+		t.Errorf("expected no request and no credits, got %d requests, %v", requests.Load(), creds)
+	}
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/fasthttp/websocket"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/soulgarden/twelvedata/dictionary"
@@ -47,12 +48,16 @@ type WS struct {
 	cancel   context.CancelFunc
 	shutdown chan struct{}
 	closed   atomic.Bool
+
+	tracer  trace.Tracer
+	metrics *Metrics
+	up      atomic.Bool // connection is counted in metrics
 }
 
 // NewWS creates a new WebSocket client instance configured for the Twelve Data API.
 // If dialer is nil, the default WebSocket dialer will be used.
 // The client uses generic event channels for type-safe event handling.
-func NewWS(cfg *Conf, logger *zerolog.Logger, dialer *websocket.Dialer) *WS {
+func NewWS(cfg *Conf, logger *zerolog.Logger, dialer *websocket.Dialer, opts ...WSOption) *WS {
 	if dialer == nil {
 		dialer = websocket.DefaultDialer
 	}
@@ -77,12 +82,19 @@ func NewWS(cfg *Conf, logger *zerolog.Logger, dialer *websocket.Dialer) *WS {
 		parser: newWSMessageParser(),
 	}
 
+	for _, opt := range opts {
+		opt(ws)
+	}
+
 	return ws
 }
 
 // Connect establishes a WebSocket connection and starts message handling.
 // It uses errgroup for proper goroutine lifecycle management and graceful shutdown.
-func (ws *WS) Connect(ctx context.Context) error {
+func (ws *WS) Connect(ctx context.Context) (err error) {
+	spanCtx, span := ws.wsSpan(ctx, "ws.connect")
+	defer func() { endSpan(span, err) }()
+
 	ws.connMu.Lock()
 	defer ws.connMu.Unlock()
 
@@ -90,12 +102,14 @@ func (ws *WS) Connect(ctx context.Context) error {
 		return fmt.Errorf("WebSocket connection already established")
 	}
 
-	conn, resp, err := ws.dialer.DialContext(ctx, ws.url.String(), nil)
+	conn, resp, err := ws.dialer.DialContext(spanCtx, ws.url.String(), nil)
 	if err != nil {
-		ws.logger.Err(err).Str("url", ws.url.String()).Msg("dial")
+		safeURL := redactSecrets(ws.url.String())
+
+		ws.logger.Err(err).Str("url", safeURL).Msg("dial")
 
 		return &WSConnectionError{
-			URL:     ws.url.String(),
+			URL:     safeURL,
 			Message: "Failed to establish WebSocket connection",
 			Cause:   err,
 		}
@@ -104,6 +118,11 @@ func (ws *WS) Connect(ctx context.Context) error {
 	ws.conn = conn
 	ws.connected.Store(true)
 
+	if ws.metrics != nil {
+		ws.up.Store(true)
+		ws.metrics.wsConnect()
+	}
+
 	// Initialize shutdown mechanism
 	ws.shutdown = make(chan struct{})
 
@@ -133,26 +152,66 @@ func (ws *WS) Connect(ctx context.Context) error {
 // Subscribe subscribes to price events for the specified symbols.
 // Supports both simple string format and extended format with exchange parameters.
 func (ws *WS) Subscribe(symbols []string) error {
+	return ws.SubscribeCtx(context.Background(), symbols)
+}
+
+// SubscribeCtx is Subscribe with a context used for tracing.
+func (ws *WS) SubscribeCtx(ctx context.Context, symbols []string) (err error) {
+	_, span := ws.wsSpan(ctx, "ws.subscribe", attrSymbolCount.Int(len(symbols)))
+	defer func() { endSpan(span, err) }()
+
 	return ws.sendSubscribeMessage(symbols, false)
 }
 
 // SubscribeExtended subscribes to price events using extended symbol format.
 func (ws *WS) SubscribeExtended(symbols []request.WSSymbolExtended) error {
+	return ws.SubscribeExtendedCtx(context.Background(), symbols)
+}
+
+// SubscribeExtendedCtx is SubscribeExtended with a context used for tracing.
+func (ws *WS) SubscribeExtendedCtx(ctx context.Context, symbols []request.WSSymbolExtended) (err error) {
+	_, span := ws.wsSpan(ctx, "ws.subscribe", attrSymbolCount.Int(len(symbols)))
+	defer func() { endSpan(span, err) }()
+
 	return ws.sendSubscribeExtendedMessage(symbols, false)
 }
 
 // Unsubscribe removes subscriptions for the specified symbols.
 func (ws *WS) Unsubscribe(symbols []string) error {
+	return ws.UnsubscribeCtx(context.Background(), symbols)
+}
+
+// UnsubscribeCtx is Unsubscribe with a context used for tracing.
+func (ws *WS) UnsubscribeCtx(ctx context.Context, symbols []string) (err error) {
+	_, span := ws.wsSpan(ctx, "ws.unsubscribe", attrSymbolCount.Int(len(symbols)))
+	defer func() { endSpan(span, err) }()
+
 	return ws.sendSubscribeMessage(symbols, true)
 }
 
 // UnsubscribeExtended removes subscriptions using extended symbol format.
 func (ws *WS) UnsubscribeExtended(symbols []request.WSSymbolExtended) error {
+	return ws.UnsubscribeExtendedCtx(context.Background(), symbols)
+}
+
+// UnsubscribeExtendedCtx is UnsubscribeExtended with a context used for tracing.
+func (ws *WS) UnsubscribeExtendedCtx(ctx context.Context, symbols []request.WSSymbolExtended) (err error) {
+	_, span := ws.wsSpan(ctx, "ws.unsubscribe", attrSymbolCount.Int(len(symbols)))
+	defer func() { endSpan(span, err) }()
+
 	return ws.sendSubscribeExtendedMessage(symbols, true)
 }
 
 // Reset clears all current subscriptions.
 func (ws *WS) Reset() error {
+	return ws.ResetCtx(context.Background())
+}
+
+// ResetCtx is Reset with a context used for tracing.
+func (ws *WS) ResetCtx(ctx context.Context) (err error) {
+	_, span := ws.wsSpan(ctx, "ws.reset")
+	defer func() { endSpan(span, err) }()
+
 	resetMsg := request.WSResetRequest{
 		Action: request.WSActionReset,
 	}
@@ -240,6 +299,8 @@ func (ws *WS) Close() error {
 	}
 	ws.connMu.Unlock()
 
+	ws.markDisconnected()
+
 	// Step 4: Cancel context if not already cancelled
 	if ws.cancel != nil {
 		ws.cancel()
@@ -301,6 +362,8 @@ func (ws *WS) messageReader() error {
 		ws.statusEvents.Close()
 		ws.errorEvents.Close()
 		ws.logger.Debug().Msg("messageReader: event channels closed")
+
+		ws.markDisconnected()
 	}()
 
 	ws.connMu.RLock()
@@ -418,23 +481,30 @@ func (ws *WS) routeMessage(message []byte) {
 	switch eventType {
 	case response.WSEventPrice:
 		priceEvent := ws.parser.getPriceEvent()
-		if !ws.priceEvents.Send(ws.ctx, priceEvent) {
+		delivered := ws.priceEvents.Send(ws.ctx, priceEvent)
+		ws.metrics.wsEvent(eventType, delivered)
+		if !delivered {
 			ws.logger.Warn().Msg("failed to send price event (channel full or closed)")
 		}
 
 	case response.WSEventSubscribeStatus:
 		statusEvent := ws.parser.getSubscribeStatusEvent()
-		if !ws.statusEvents.Send(ws.ctx, statusEvent) {
+		delivered := ws.statusEvents.Send(ws.ctx, statusEvent)
+		ws.metrics.wsEvent(eventType, delivered)
+		if !delivered {
 			ws.logger.Warn().Msg("failed to send status event (channel full or closed)")
 		}
 
 	case response.WSEventError:
 		errorEvent := ws.parser.getErrorEvent()
-		if !ws.errorEvents.Send(ws.ctx, errorEvent) {
+		delivered := ws.errorEvents.Send(ws.ctx, errorEvent)
+		ws.metrics.wsEvent(eventType, delivered)
+		if !delivered {
 			ws.logger.Warn().Msg("failed to send error event (channel full or closed)")
 		}
 
 	default:
+		ws.metrics.wsEvent(eventType, true)
 		ws.logger.Warn().Str("event", string(eventType)).Bytes("message", message).Msg("unknown event type")
 	}
 
@@ -529,6 +599,13 @@ func (ws *WS) sendSubscribeExtendedMessage(symbols []request.WSSymbolExtended, i
 	return ws.sendJSONMessage(req)
 }
 
+// markDisconnected records the end of the connection in metrics once.
+func (ws *WS) markDisconnected() {
+	if ws.up.CompareAndSwap(true, false) {
+		ws.metrics.wsDisconnect()
+	}
+}
+
 // IsConnected returns true if the WebSocket is connected.
 func (ws *WS) IsConnected() bool {
 	return ws.connected.Load()